@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// CashShift holds the schema definition for the CashShift entity.
+type CashShift struct {
+	ent.Schema
+}
+
+// Fields of the CashShift.
+func (CashShift) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable().
+			Comment("Staff member working the drawer"),
+		field.Enum("status").
+			Values(
+				string(types.CashShiftStatusOpen),
+				string(types.CashShiftStatusClosed),
+			).
+			Default(string(types.CashShiftStatusOpen)),
+		field.Int64("opening_float").GoType(money.Money(0)).Default(0).Immutable().
+			Comment("Cash in the drawer when the shift opened"),
+		field.String("opening_note").Optional().Nillable(),
+
+		// Filled in when the shift closes
+		field.Int64("cash_sales").GoType(money.Money(0)).Default(0),
+		field.Int64("cash_refunds").GoType(money.Money(0)).Default(0),
+		field.Int64("expected_cash").GoType(money.Money(0)).Optional().Nillable(),
+		field.Int64("counted_cash").GoType(money.Money(0)).Optional().Nillable(),
+		field.Int64("discrepancy").GoType(money.Money(0)).Optional().Nillable().
+			Comment("Counted minus expected cash; negative when the drawer is short"),
+		field.JSON("denominations", []types.CashDenomination{}).Optional(),
+		field.String("closing_note").Optional().Nillable(),
+
+		field.Time("opened_at").Default(time.Now).Immutable(),
+		field.Time("closed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CashShift.
+func (CashShift) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("payments", Payment.Type),
+		edge.To("refunds", Refund.Type),
+	}
+}
+
+// Indexes of the CashShift.
+func (CashShift) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id", "status"),
+
+		// A staff member has at most one open drawer per tenant
+		index.Fields("tenant_id", "user_id").
+			Unique().
+			Annotations(
+				entsql.IndexWhere(fmt.Sprintf("status = '%s'", types.CashShiftStatusOpen)),
+			),
+		index.Fields("tenant_id", "opened_at"),
+	}
+}
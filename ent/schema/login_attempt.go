@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+// Only failed attempts are recorded.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Fields of the LoginAttempt.
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("email").Immutable().Comment("Email as submitted, normalized"),
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable().Immutable().
+			Comment("Matched user, empty when the email is unknown"),
+		field.String("scope").Optional().Immutable(),
+		field.String("ip_address").Optional().Immutable(),
+		field.String("user_agent").Optional().Immutable(),
+		field.Enum("reason").
+			Values(
+				string(types.LoginFailureInvalidCredentials),
+				string(types.LoginFailureAccountLocked),
+				string(types.LoginFailureInvalidMFACode),
+			).
+			Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the LoginAttempt.
+func (LoginAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("login_attempts").
+			Field("user_id").
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the LoginAttempt.
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email", "created_at"),
+		index.Fields("user_id", "created_at"),
+	}
+}
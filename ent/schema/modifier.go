@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Modifier holds the schema definition for the Modifier entity.
+// It is an add-on customers can choose for an order item, such as stain
+// treatment or premium fragrance.
+type Modifier struct {
+	ent.Schema
+}
+
+// Fields of the Modifier.
+func (Modifier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("description").Optional().Nillable(),
+
+		field.Enum("type").
+			Values(string(types.ModifierTypeFixed), string(types.ModifierTypePercent)),
+		field.Int64("price").GoType(money.Money(0)).Default(0).
+			Comment("Used by fixed modifiers"),
+		field.Bool("per_unit").Default(false).
+			Comment("Fixed price is charged per billed unit instead of once per item"),
+		field.Float("percent").Default(0).
+			Comment("Used by percent modifiers, a percentage of the item's service price"),
+
+		field.Enum("scope").
+			Values(
+				string(types.ModifierScopeAll),
+				string(types.ModifierScopeService),
+				string(types.ModifierScopeCategory),
+			).
+			Default(string(types.ModifierScopeAll)),
+		field.UUID("service_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("service_category_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.Bool("active").Default(true),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("deleted_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Modifier.
+func (Modifier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("order_item_modifiers", OrderItemModifier.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Restrict),
+			),
+	}
+}
+
+// Indexes of the Modifier.
+func (Modifier) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -43,7 +46,38 @@ func (Order) Fields() []ent.Field {
 			).
 			Default(string(types.OrderStatusPending)),
 
-		field.Float("total_amount").Default(0.0),
+		field.Int64("total_amount").GoType(money.Money(0)).Default(0),
+		field.Int64("discount_amount").GoType(money.Money(0)).Default(0).
+			Comment("All promotion discounts, spread over the items"),
+		field.Int64("service_charge_amount").GoType(money.Money(0)).Default(0),
+		field.Int64("tax_amount").GoType(money.Money(0)).Default(0),
+
+		// Tax rules the order was priced with, copied from the tenant
+		field.Float("tax_rate").Default(0).Immutable().Comment("PPN in percent"),
+		field.Bool("tax_inclusive").Default(false).Immutable().Comment("Prices include service charge and PPN"),
+		field.Float("service_charge_rate").Default(0).Immutable().Comment("In percent"),
+
+		field.String("code").Optional().Nillable().Immutable().
+			Comment("Human-readable number, sequential per tenant, e.g. LDR-2610-000123"),
+
+		field.String("tracking_token").Optional().Nillable().Unique().Immutable().
+			Comment("Unguessable token of the public tracking link"),
+
+		// Turnaround the customer picked and when the order is promised to be ready
+		field.Enum("turnaround").
+			Values(
+				string(turnaround.SpeedRegular),
+				string(turnaround.SpeedExpress),
+				string(turnaround.SpeedSameDay),
+			).
+			Default(string(turnaround.SpeedRegular)).
+			Immutable(),
+		field.Time("due_at").Optional().Nillable().
+			Comment("Worked out from the items' turnarounds and the tenant's business hours"),
+		field.Time("sla_breached_at").Optional().Nillable().
+			Comment("When the order was found not ready by its due time"),
+
+		field.String("currency").GoType(money.Currency("")).Default(string(money.DefaultCurrency)).Immutable(),
 		field.String("notes").Optional().Nillable(),
 
 		// Guest info (only if user_id is null)
@@ -79,8 +113,7 @@ func (Order) Edges() []ent.Edge {
 				entsql.OnDelete(entsql.Cascade),
 			),
 
-		edge.To("payment", Payment.Type).
-			Unique().
+		edge.To("payments", Payment.Type).
 			Annotations(
 				entsql.OnDelete(entsql.Restrict),
 			),
@@ -89,5 +122,26 @@ func (Order) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+
+		edge.To("promotion_redemptions", PromotionRedemption.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+
+		edge.To("events", OrderEvent.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+// Indexes of the Order.
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		// Overdue and at-risk orders are looked up by due time
+		index.Fields("status", "due_at"),
+
+		// Order codes are unique per tenant
+		index.Fields("tenant_id", "code").Unique(),
 	}
 }
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrderCodeSequence holds the schema definition for the OrderCodeSequence entity.
+// It counts a tenant's orders in a code period, so order codes are handed out
+// without gaps.
+type OrderCodeSequence struct {
+	ent.Schema
+}
+
+// Fields of the OrderCodeSequence.
+func (OrderCodeSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.String("period").NotEmpty().Immutable().
+			Comment("YYMM or YY, depending on how often the tenant's codes reset"),
+		field.Int("last_number").Default(0).
+			Comment("Number of the latest order code handed out in the period"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the OrderCodeSequence.
+func (OrderCodeSequence) Indexes() []ent.Index {
+	return []ent.Index{
+		// One counter per tenant per period
+		index.Fields("tenant_id", "period").Unique(),
+	}
+}
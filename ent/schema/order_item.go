@@ -2,9 +2,12 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
 )
 
 // OrderItem holds the schema definition for the OrderItem entity.
@@ -19,9 +22,21 @@ func (OrderItem) Fields() []ent.Field {
 		field.UUID("order_id", uuid.UUID{}).Immutable(),
 		field.UUID("service_id", uuid.UUID{}).Immutable(),
 		field.Float("quantity").Default(1),
-		field.Float("price").Default(0.0),
-		field.Float("subtotal").Default(0.0),
-		field.Float("total_amount").Default(0.0),
+		field.Float("billed_quantity").Optional().Nillable().
+			Comment("Quantity charged after the service's pricing rules, nil for older items"),
+		field.JSON("pricing_applied", []pricing.Applied{}).Optional().
+			Comment("Pricing rules that changed the subtotal"),
+		field.Int64("price").GoType(money.Money(0)).Default(0),
+		field.Int64("surcharge_amount").GoType(money.Money(0)).Default(0).
+			Comment("Part of the subtotal charged for an express or same-day turnaround"),
+		field.Int64("modifier_amount").GoType(money.Money(0)).Default(0).
+			Comment("Part of the subtotal charged for the chosen modifiers"),
+		field.Int64("subtotal").GoType(money.Money(0)).Default(0),
+		field.Int64("discount_amount").GoType(money.Money(0)).Default(0).
+			Comment("Share of the promotion discounts, order-wide ones included"),
+		field.Int64("service_charge_amount").GoType(money.Money(0)).Default(0),
+		field.Int64("tax_amount").GoType(money.Money(0)).Default(0),
+		field.Int64("total_amount").GoType(money.Money(0)).Default(0),
 	}
 }
 
@@ -41,5 +56,10 @@ func (OrderItem) Edges() []ent.Edge {
 			Immutable().
 			Unique().
 			Required(),
+
+		edge.To("modifiers", OrderItemModifier.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
 	}
 }
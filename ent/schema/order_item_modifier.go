@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// OrderItemModifier holds the schema definition for the OrderItemModifier entity.
+// It records a modifier chosen for an order item, keeping its name and price as
+// they were so receipts do not change when the modifier is edited.
+type OrderItemModifier struct {
+	ent.Schema
+}
+
+// Fields of the OrderItemModifier.
+func (OrderItemModifier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("order_item_id", uuid.UUID{}).Immutable(),
+		field.UUID("modifier_id", uuid.UUID{}).Immutable(),
+		field.String("name").Immutable(),
+		field.Enum("type").
+			Values(string(types.ModifierTypeFixed), string(types.ModifierTypePercent)).
+			Immutable(),
+		field.Int64("price").GoType(money.Money(0)).Default(0).Immutable(),
+		field.Bool("per_unit").Default(false).Immutable(),
+		field.Float("percent").Default(0).Immutable(),
+		field.Int64("amount").GoType(money.Money(0)).Immutable().
+			Comment("What the modifier added to the item's subtotal"),
+	}
+}
+
+// Edges of the OrderItemModifier.
+func (OrderItemModifier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order_item", OrderItem.Type).
+			Ref("modifiers").
+			Field("order_item_id").
+			Immutable().
+			Unique().
+			Required(),
+
+		edge.From("modifier", Modifier.Type).
+			Ref("order_item_modifiers").
+			Field("modifier_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
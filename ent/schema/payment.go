@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -25,10 +26,10 @@ func (Payment) Fields() []ent.Field {
 		field.Enum("ref_type").
 			Values(string(types.PaymentTypeOrder), string(types.PaymentTypeSubscription)).Immutable(),
 		field.UUID("payment_method_id", uuid.UUID{}).Optional(),
-		field.Float("amount").Default(0.0),
-		field.Float("received_amount").Default(0.0).Optional().
+		field.Int64("amount").GoType(money.Money(0)).Default(0),
+		field.Int64("received_amount").GoType(money.Money(0)).Default(0).Optional().
 			Comment("Used for cash payments"),
-		field.Float("change_amount").Default(0.0).Optional().
+		field.Int64("change_amount").GoType(money.Money(0)).Default(0).Optional().
 			Comment("Used for cash payments"),
 		field.String("notes").Optional(),
 		field.Enum("status").
@@ -43,7 +44,21 @@ func (Payment) Fields() []ent.Field {
 			).
 			Default(string(types.PaymentStatusPending)),
 
+		field.Enum("channel").
+			Values(paymentChannelValues()...).
+			Optional().Nillable().
+			Comment("Gateway channel for online payments"),
+		field.String("gateway_charge_id").Optional().Nillable().Unique(),
+		field.String("va_number").Optional().Nillable().
+			Comment("Virtual account to transfer to"),
+		field.String("payment_url").Optional().Nillable().
+			Comment("E-wallet deeplink or hosted card page"),
+		field.Time("expires_at").Optional().Nillable().
+			Comment("When the gateway charge can no longer be paid"),
+
 		field.Time("paid_at").Optional().Nillable(),
+		field.UUID("cash_shift_id", uuid.UUID{}).Optional().Nillable().
+			Comment("Drawer shift that took the cash, for cash payments"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("deleted_at").Optional().Nillable(),
@@ -65,7 +80,7 @@ func (Payment) Edges() []ent.Edge {
 			Unique(),
 
 		edge.From("order", Order.Type).
-			Ref("payment").
+			Ref("payments").
 			Unique(),
 
 		edge.From("tenant", Tenant.Type).
@@ -73,5 +88,21 @@ func (Payment) Edges() []ent.Edge {
 			Field("tenant_id").
 			Unique().
 			Immutable(),
+
+		edge.To("refunds", Refund.Type),
+
+		edge.From("cash_shift", CashShift.Type).
+			Ref("payments").
+			Field("cash_shift_id").
+			Unique(),
+	}
+}
+
+// paymentChannelValues returns the gateway channels as enum values.
+func paymentChannelValues() []string {
+	values := make([]string, len(types.PaymentChannels))
+	for i, c := range types.PaymentChannels {
+		values[i] = string(c)
 	}
+	return values
 }
@@ -23,7 +23,7 @@ func (PaymentMethod) Fields() []ent.Field {
 		field.String("name").NotEmpty().Unique(),
 		field.String("description").Optional().Nillable(),
 		field.Enum("type").
-			Values(string(types.PaymentMethodCash), string(types.PaymentMethodCard), string(types.PaymentMethodTransfer)),
+			Values(string(types.PaymentMethodCash), string(types.PaymentMethodCard), string(types.PaymentMethodTransfer), string(types.PaymentMethodWallet)),
 
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PaymentWebhookEvent holds the schema definition for the PaymentWebhookEvent entity.
+// Each processed gateway notification is recorded once so retries are ignored.
+type PaymentWebhookEvent struct {
+	ent.Schema
+}
+
+// Fields of the PaymentWebhookEvent.
+func (PaymentWebhookEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("event_id").Unique().Immutable().Comment("Event ID assigned by the gateway"),
+		field.String("charge_id").Immutable(),
+		field.String("status").Immutable().Comment("Charge status reported by the event"),
+		field.UUID("payment_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PaymentWebhookEvent.
+func (PaymentWebhookEvent) Edges() []ent.Edge {
+	return nil
+}
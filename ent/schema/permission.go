@@ -39,5 +39,8 @@ func (Permission) Edges() []ent.Edge {
 			Unique(),
 
 		edge.To("plans", Plan.Type),
+
+		edge.From("roles", Role.Type).
+			Ref("permissions"),
 	}
 }
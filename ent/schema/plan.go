@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -25,7 +26,8 @@ func (Plan) Fields() []ent.Field {
 			Comment("Name of the plan e.g. 'Basic', 'Premium'"),
 		field.String("description").Optional().Nillable(),
 
-		field.Float("price").Default(0),
+		field.Int64("price").GoType(money.Money(0)).Default(0),
+		field.String("currency").GoType(money.Currency("")).Default(string(money.DefaultCurrency)),
 		field.Enum("billing_interval").
 			Values(
 				string(types.BillingIntervalMonthly),
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Promotion holds the schema definition for the Promotion entity.
+type Promotion struct {
+	ent.Schema
+}
+
+// Fields of the Promotion.
+func (Promotion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.String("code").Optional().Nillable().
+			Comment("Promo code customers enter; promotions without one apply automatically"),
+		field.String("name").NotEmpty(),
+		field.String("description").Optional().Nillable(),
+
+		field.Enum("type").
+			Values(string(types.PromotionTypePercent), string(types.PromotionTypeFixed)),
+		field.Float("percent_off").Default(0).
+			Comment("Used by percent promotions, 0-100"),
+		field.Int64("amount_off").GoType(money.Money(0)).Default(0).
+			Comment("Used by fixed promotions"),
+
+		field.Enum("scope").
+			Values(
+				string(types.PromotionScopeOrder),
+				string(types.PromotionScopeService),
+				string(types.PromotionScopeCategory),
+			).
+			Default(string(types.PromotionScopeOrder)),
+		field.UUID("service_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("service_category_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.Int64("min_spend").GoType(money.Money(0)).Default(0).
+			Comment("Order subtotal needed before the promotion applies"),
+		field.Int("max_uses").Optional().Nillable(),
+		field.Int("max_uses_per_customer").Optional().Nillable(),
+		field.Int("used_count").Default(0),
+		field.Bool("members_only").Default(false).
+			Comment("Only for orders of registered customers"),
+		field.Bool("stackable").Default(false).
+			Comment("Combines with other stackable promotions; otherwise it applies alone"),
+
+		field.Time("starts_at").Optional().Nillable(),
+		field.Time("ends_at").Optional().Nillable(),
+		field.Bool("active").Default(true),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("deleted_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Promotion.
+func (Promotion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("redemptions", PromotionRedemption.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Restrict),
+			),
+	}
+}
+
+// Indexes of the Promotion.
+func (Promotion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "code"),
+	}
+}
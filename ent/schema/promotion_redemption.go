@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// PromotionRedemption holds the schema definition for the PromotionRedemption entity.
+// It records a promotion used on an order, keeping its code and name as they
+// were so receipts do not change when the promotion is edited.
+type PromotionRedemption struct {
+	ent.Schema
+}
+
+// Fields of the PromotionRedemption.
+func (PromotionRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.UUID("promotion_id", uuid.UUID{}).Immutable(),
+		field.UUID("order_id", uuid.UUID{}).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.String("code").Optional().Nillable().Immutable(),
+		field.String("name").Immutable(),
+		field.Int64("amount").GoType(money.Money(0)).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PromotionRedemption.
+func (PromotionRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("promotion", Promotion.Type).
+			Ref("redemptions").
+			Field("promotion_id").
+			Immutable().
+			Unique().
+			Required(),
+
+		edge.From("order", Order.Type).
+			Ref("promotion_redemptions").
+			Field("order_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PromotionRedemption.
+func (PromotionRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		// Per-customer usage limits count these
+		index.Fields("promotion_id", "user_id"),
+	}
+}
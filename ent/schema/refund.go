@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Refund holds the schema definition for the Refund entity.
+type Refund struct {
+	ent.Schema
+}
+
+// Fields of the Refund.
+func (Refund) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("payment_id", uuid.UUID{}).Immutable(),
+		field.Int64("requested_amount").GoType(money.Money(0)).Immutable(),
+		field.Int64("approved_amount").GoType(money.Money(0)).Optional().Nillable(),
+		field.String("reason").Immutable(),
+		field.Enum("method").
+			Values(
+				string(types.RefundMethodOriginal),
+				string(types.RefundMethodCash),
+				string(types.RefundMethodTransfer),
+				string(types.RefundMethodWallet),
+			),
+		field.Enum("status").
+			Values(
+				string(types.RefundStatusPending),
+				string(types.RefundStatusApproved),
+				string(types.RefundStatusRejected),
+				string(types.RefundStatusRefunded),
+			).
+			Default(string(types.RefundStatusPending)),
+		field.String("review_note").Optional().Nillable().
+			Comment("Approver's note on approval or rejection"),
+		field.String("gateway_refund_id").Optional().Nillable(),
+		field.UUID("cash_shift_id", uuid.UUID{}).Optional().Nillable().
+			Comment("Drawer shift that paid out the cash, for cash refunds"),
+
+		field.UUID("requested_by", uuid.UUID{}).Immutable(),
+		field.UUID("approved_by", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("rejected_by", uuid.UUID{}).Optional().Nillable(),
+
+		field.Time("approved_at").Optional().Nillable(),
+		field.Time("rejected_at").Optional().Nillable(),
+		field.Time("refunded_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Refund.
+func (Refund) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment", Payment.Type).
+			Ref("refunds").
+			Field("payment_id").
+			Immutable().
+			Unique().
+			Required(),
+
+		edge.From("cash_shift", CashShift.Type).
+			Ref("refunds").
+			Field("cash_shift_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Refund.
+func (Refund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("payment_id", "status"),
+	}
+}
@@ -24,6 +24,8 @@ func (Role) Fields() []ent.Field {
 			Comment("Needed if role is associated with a tenant"),
 		field.String("name").NotEmpty(),
 		field.String("description").Optional(),
+		field.Bool("mfa_required").Default(false).
+			Comment("Members with this role must pass a second factor to sign in"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("deleted_at").Optional().Nillable(),
@@ -38,6 +40,13 @@ func (Role) Edges() []ent.Edge {
 				entsql.OnDelete(entsql.Cascade),
 			),
 
+		edge.To("tenant_users", TenantUser.Type).
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
+
+		edge.To("permissions", Permission.Type),
+
 		edge.From("tenant", Tenant.Type).
 			Ref("roles").
 			Field("tenant_id").
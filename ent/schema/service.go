@@ -8,6 +8,9 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 // Service holds the schema definition for the Service entity.
@@ -24,8 +27,12 @@ func (Service) Fields() []ent.Field {
 		field.UUID("service_category_id", uuid.UUID{}).Optional().Nillable(),
 
 		field.String("name").NotEmpty().Unique(),
-		field.Float("base_price").Default(0.0).
+		field.Int64("base_price").GoType(money.Money(0)).Default(0).
 			Comment("Price of the service"),
+		field.JSON("pricing", &pricing.Rules{}).Optional().
+			Comment("Minimum quantity, rounding, volume tiers and minimum charge"),
+		field.JSON("turnarounds", turnaround.Options{}).Optional().
+			Comment("Regular, express and same-day turnarounds with their surcharges"),
 		field.String("description").Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
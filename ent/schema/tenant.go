@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/ordercode"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -26,6 +28,29 @@ func (Tenant) Fields() []ent.Field {
 		field.Enum("status").
 			Values(string(types.TenantStatusActive), string(types.TenantStatusSuspended), string(types.TenantStatusDeleted)).
 			Default(string(types.TenantStatusActive)).Nillable(),
+
+		// QRIS merchant registration, used to generate payment QR codes
+		field.String("qris_merchant_name").Optional().Nillable(),
+		field.String("qris_merchant_city").Optional().Nillable(),
+		field.String("qris_postal_code").Optional().Nillable(),
+		field.String("qris_nmid").Optional().Nillable().Comment("National Merchant ID"),
+		field.String("qris_mcc").Optional().Nillable().Comment("Merchant category code"),
+		field.String("qris_criteria").Optional().Nillable().Comment("UMI, UKE, UME or UBE"),
+
+		// Tax rules applied to orders
+		field.Float("tax_rate").Default(0).Comment("PPN in percent, 0 when not registered for PPN"),
+		field.Bool("tax_inclusive").Default(false).Comment("Prices include service charge and PPN"),
+		field.Float("service_charge_rate").Default(0).Comment("In percent"),
+
+		// Business hours and holidays order due dates are worked out with
+		field.JSON("business_calendar", turnaround.Calendar{}).Optional(),
+
+		// How order codes look, e.g. LDR-2610-000123
+		field.String("order_code_prefix").Default(ordercode.DefaultPrefix),
+		field.Enum("order_code_reset").
+			Values(string(ordercode.ResetMonthly), string(ordercode.ResetYearly)).
+			Default(string(ordercode.ResetMonthly)),
+
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("deleted_at").Optional().Nillable(),
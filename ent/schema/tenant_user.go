@@ -22,6 +22,8 @@ func (TenantUser) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
 		field.UUID("user_id", uuid.UUID{}).Immutable(),
 		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.UUID("role_id", uuid.UUID{}).Optional().Nillable().
+			Comment("Tenant role that grants this member its permissions"),
 		field.Enum("status").
 			Values(string(types.TenantUserStatusActive), string(types.TenantUserStatusSuspended), string(types.TenantUserStatusDeleted)).
 			Default(string(types.TenantUserStatusActive)).Nillable(),
@@ -47,6 +49,11 @@ func (TenantUser) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Immutable(),
+
+		edge.From("role", Role.Type).
+			Ref("tenant_users").
+			Field("role_id").
+			Unique(),
 	}
 }
 
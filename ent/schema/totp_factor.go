@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TotpFactor holds the schema definition for the TotpFactor entity.
+type TotpFactor struct {
+	ent.Schema
+}
+
+// Fields of the TotpFactor.
+func (TotpFactor) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Unique().Immutable(),
+		field.String("secret").Sensitive().NotEmpty(),
+		field.Time("confirmed_at").Optional().Nillable().
+			Comment("Set once the user proves the authenticator works; unconfirmed factors are not enforced"),
+		field.Int64("last_used_step").Default(0).
+			Comment("Last accepted TOTP time step, to reject replayed codes"),
+		field.JSON("recovery_codes", []string{}).Sensitive().Optional().
+			Comment("SHA-256 hashes of unused recovery codes"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TotpFactor.
+func (TotpFactor) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("totp_factor").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -23,8 +23,16 @@ func (User) Fields() []ent.Field {
 		field.String("email").Unique().NotEmpty(),
 		field.String("password").Sensitive().NotEmpty(),
 		field.Enum("status").
-			Values(string(types.UserStatusActive), string(types.UserStatusSuspended), string(types.UserStatusDeleted)).
+			Values(
+				string(types.UserStatusPending),
+				string(types.UserStatusActive),
+				string(types.UserStatusSuspended),
+				string(types.UserStatusLocked),
+				string(types.UserStatusDeleted),
+			).
 			Default(string(types.UserStatusActive)).Nillable(),
+		field.Time("locked_until").Optional().Nillable().
+			Comment("End of a temporary lockout after repeated failed logins"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("deleted_at").Optional().Nillable(),
@@ -60,5 +68,16 @@ func (User) Edges() []ent.Edge {
 			),
 
 		edge.To("addresses", Addresses.Type),
+
+		edge.To("totp_factor", TotpFactor.Type).
+			Unique().
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+
+		edge.To("login_attempts", LoginAttempt.Type).
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// Wallet holds the schema definition for the Wallet entity.
+type Wallet struct {
+	ent.Schema
+}
+
+// Fields of the Wallet.
+func (Wallet) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Int64("balance").GoType(money.Money(0)).Default(0).
+			Comment("Running total of the ledger, kept for atomic debits"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Wallet.
+func (Wallet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("transactions", WalletTransaction.Type),
+	}
+}
+
+// Indexes of the Wallet.
+func (Wallet) Indexes() []ent.Index {
+	return []ent.Index{
+		// One wallet per customer per tenant
+		index.Fields("tenant_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// WalletTransaction holds the schema definition for the WalletTransaction
+// entity. The ledger is append-only, so every field is immutable.
+type WalletTransaction struct {
+	ent.Schema
+}
+
+// Fields of the WalletTransaction.
+func (WalletTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("wallet_id", uuid.UUID{}).Immutable(),
+		field.Enum("type").
+			Values(
+				string(types.WalletTransactionTopUp),
+				string(types.WalletTransactionSpend),
+				string(types.WalletTransactionRefund),
+				string(types.WalletTransactionAdjustment),
+			).
+			Immutable(),
+		field.Int64("amount").GoType(money.Money(0)).Immutable().
+			Comment("Signed change to the balance; debits are negative"),
+		field.Int64("balance_after").GoType(money.Money(0)).Immutable(),
+		field.UUID("payment_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("refund_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.String("note").Optional().Nillable().Immutable(),
+		field.UUID("created_by", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WalletTransaction.
+func (WalletTransaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("transactions").
+			Field("wallet_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WalletTransaction.
+func (WalletTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "created_at"),
+	}
+}
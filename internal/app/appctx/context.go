@@ -63,3 +63,33 @@ func (c *Context) Scope() Scope {
 func (c *Context) WithScope(s Scope) *Context {
 	return &Context{context.WithValue(c.Context, ContextKeyScope, s)}
 }
+
+// --- Session ---
+func (c *Context) SessionID() *uuid.UUID {
+	val := c.Value(ContextKeySession)
+	if val != nil {
+		if id, ok := val.(*uuid.UUID); ok {
+			return id
+		}
+	}
+	return nil
+}
+
+func (c *Context) WithSessionID(id *uuid.UUID) *Context {
+	return &Context{context.WithValue(c.Context, ContextKeySession, id)}
+}
+
+// --- Token ---
+func (c *Context) TokenID() string {
+	val := c.Value(ContextKeyTokenID)
+	if val != nil {
+		if id, ok := val.(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+func (c *Context) WithTokenID(id string) *Context {
+	return &Context{context.WithValue(c.Context, ContextKeyTokenID, id)}
+}
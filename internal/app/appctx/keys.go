@@ -6,4 +6,6 @@ const (
 	ContextKeyTenantID ContextKey = "tenant_id"
 	ContextKeyUserID   ContextKey = "user_id"
 	ContextKeyScope    ContextKey = "scope"
+	ContextKeySession  ContextKey = "sid"
+	ContextKeyTokenID  ContextKey = "jti"
 )
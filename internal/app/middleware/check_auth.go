@@ -6,15 +6,18 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
-	"github.com/lestrrat-go/jwx/v3/jwa"
-	"github.com/lestrrat-go/jwx/v3/jwt"
 	"github.com/umardev500/laundry/internal/app/appctx"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
 	"github.com/umardev500/laundry/pkg/httpx"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
 )
 
-func CheckAuth(config *config.Config) fiber.Handler {
+// CheckAuth verifies the bearer token against the key named by its kid and
+// rejects tokens revoked through logout or session revocation, as well as
+// tokens whose session no longer exists.
+func CheckAuth(keys *jwtkeys.KeySet, sessions authContract.SessionService) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
@@ -26,7 +29,7 @@ func CheckAuth(config *config.Config) fiber.Handler {
 			return httpx.Unauthorized(c, "invalid authorization header format")
 		}
 
-		token, err := jwt.Parse([]byte(parts[1]), jwt.WithKey(jwa.HS256(), []byte(config.JWT.Secret)))
+		token, err := keys.Parse([]byte(parts[1]))
 		if err != nil {
 			return httpx.Unauthorized(c, "invalid token")
 		}
@@ -37,6 +40,37 @@ func CheckAuth(config *config.Config) fiber.Handler {
 			return httpx.Unauthorized(c, "invalid token")
 		}
 
+		tokenID, ok := token.JwtID()
+		if !ok || tokenID == "" {
+			return httpx.Unauthorized(c, "invalid token")
+		}
+
+		var sessionIDStr string
+		if err := token.Get(string(appctx.ContextKeySession), &sessionIDStr); err != nil {
+			return httpx.Unauthorized(c, "invalid token")
+		}
+		sessionID, err := uuid.Parse(sessionIDStr)
+		if err != nil {
+			return httpx.Unauthorized(c, "invalid token")
+		}
+
+		revoked, err := sessions.IsTokenRevoked(appctx.New(c.UserContext()), tokenID)
+		if err != nil {
+			return httpx.InternalServerError(c, err.Error())
+		}
+		if revoked {
+			return httpx.Unauthorized(c, "token has been revoked")
+		}
+
+		// Logging out or revoking the session ends every token issued for it.
+		active, err := sessions.IsSessionActive(appctx.New(c.UserContext()), sessionID)
+		if err != nil {
+			return httpx.InternalServerError(c, err.Error())
+		}
+		if !active {
+			return httpx.Unauthorized(c, "session has been revoked")
+		}
+
 		var scope string
 		if err := token.Get(string(appctx.ContextKeyScope), &scope); err != nil {
 			return httpx.Unauthorized(c, "invalid token")
@@ -55,12 +89,16 @@ func CheckAuth(config *config.Config) fiber.Handler {
 			UserID:   uuid.MustParse(sub),
 			Scope:    appctx.Scope(scope),
 			TenantID: tenantID,
+			Session:  &sessionID,
+			TokenID:  tokenID,
 		}
 
 		ctx := context.Background()
 		ctx = context.WithValue(ctx, appctx.ContextKeyUserID, &claims.UserID)
 		ctx = context.WithValue(ctx, appctx.ContextKeyScope, claims.Scope)
 		ctx = context.WithValue(ctx, appctx.ContextKeyTenantID, claims.TenantID)
+		ctx = context.WithValue(ctx, appctx.ContextKeySession, claims.Session)
+		ctx = context.WithValue(ctx, appctx.ContextKeyTokenID, claims.TokenID)
 		c.SetUserContext(ctx)
 
 		return c.Next()
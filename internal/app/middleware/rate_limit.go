@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+	"github.com/umardev500/laundry/pkg/httpx"
+)
+
+// RateLimit allows each client IP at most max requests per window on the
+// routes it guards. Requests are counted in Redis, so every instance of the
+// API shares the limit; name keeps the counters of different limits apart.
+func RateLimit(client *redis.RedisClient, name string, max int64, window time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		key := fmt.Sprintf("rate_limit:%s:%s", name, c.IP())
+
+		var incr *goredis.IntCmd
+		_, err := client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+			incr = pipe.Incr(ctx, key)
+			pipe.ExpireNX(ctx, key, window)
+			return nil
+		})
+		if err != nil {
+			return httpx.InternalServerError(c, err.Error())
+		}
+
+		if incr.Val() > max {
+			if ttl, err := client.TTL(ctx, key).Result(); err == nil && ttl > 0 {
+				c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(ttl.Seconds()))))
+			}
+			return httpx.TooManyRequests(c, "too many requests, try again later")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+	rbacDomain "github.com/umardev500/laundry/internal/feature/rbac/domain"
+	"github.com/umardev500/laundry/pkg/httpx"
+)
+
+type permissionsLocalsKey struct{}
+
+// RequirePermission allows the request through only when the authenticated caller
+// holds every one of the given permissions. It must run after CheckAuth.
+func RequirePermission(authz rbacContract.AuthorizationService, permissions ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		granted, err := resolvePermissions(c, authz)
+		if err != nil {
+			switch {
+			case errors.Is(err, rbacDomain.ErrMissingUserID),
+				errors.Is(err, rbacDomain.ErrMissingTenantID):
+				return httpx.Unauthorized(c, err.Error())
+			default:
+				return httpx.InternalServerError(c, err.Error())
+			}
+		}
+
+		if missing := granted.Missing(permissions...); len(missing) > 0 {
+			return httpx.Forbidden(c, rbacDomain.ErrPermissionDenied.Error())
+		}
+
+		return c.Next()
+	}
+}
+
+// resolvePermissions loads the caller's permissions once per request and caches
+// them in the request locals so stacked guards don't hit the database again.
+func resolvePermissions(c *fiber.Ctx, authz rbacContract.AuthorizationService) (rbacDomain.PermissionSet, error) {
+	if cached, ok := c.Locals(permissionsLocalsKey{}).(rbacDomain.PermissionSet); ok {
+		return cached, nil
+	}
+
+	ctx := appctx.New(c.UserContext())
+	granted, err := authz.ResolvePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Locals(permissionsLocalsKey{}, granted)
+	return granted, nil
+}
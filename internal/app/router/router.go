@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"sync"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog/log"
@@ -13,26 +14,66 @@ type RouteRegistrar interface {
 	RegisterRoutes(router fiber.Router)
 }
 
+// RootRouteRegistrar is implemented by registrars that also expose routes
+// outside the /api prefix, such as /.well-known endpoints.
+type RootRouteRegistrar interface {
+	RegisterRootRoutes(router fiber.Router)
+}
+
+// BackgroundWorker is implemented by registrars that also run work in the
+// background while the server is up, such as periodic checks. RunBackground
+// must return once ctx is done.
+type BackgroundWorker interface {
+	RunBackground(ctx context.Context)
+}
+
 type Router struct {
 	App    *fiber.App
 	Client *entdb.Client
 	Config *config.Config
+
+	workers       []BackgroundWorker
+	stopWorkers   context.CancelFunc
+	workersCtx    context.Context
+	workersActive sync.WaitGroup
 }
 
 func NewRouter(app *fiber.App, cfg *config.Config, client *entdb.Client, registrars []RouteRegistrar) *Router {
 	api := app.Group("api")
 
+	var workers []BackgroundWorker
 	for _, r := range registrars {
 		r.RegisterRoutes(api)
+
+		if root, ok := r.(RootRouteRegistrar); ok {
+			root.RegisterRootRoutes(app)
+		}
+
+		if w, ok := r.(BackgroundWorker); ok {
+			workers = append(workers, w)
+		}
 	}
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
 	return &Router{
-		App:    app,
-		Client: client,
-		Config: cfg,
+		App:         app,
+		Client:      client,
+		Config:      cfg,
+		workers:     workers,
+		stopWorkers: stopWorkers,
+		workersCtx:  workersCtx,
 	}
 }
 
 func (a *Router) Run() error {
+	for _, w := range a.workers {
+		a.workersActive.Add(1)
+		go func(w BackgroundWorker) {
+			defer a.workersActive.Done()
+			w.RunBackground(a.workersCtx)
+		}(w)
+	}
+
 	addr := ":" + a.Config.Server.Port
 	log.Printf("🚀 Server running on %s", addr)
 	return a.App.Listen(addr)
@@ -40,6 +81,11 @@ func (a *Router) Run() error {
 
 func (a *Router) Shutdown(ctx context.Context) error {
 	log.Info().Msg("Shutting down server")
+
+	// Stop the background workers before the database goes away
+	a.stopWorkers()
+	a.workersActive.Wait()
+
 	if err := a.Client.Client.Close(); err != nil {
 		return err
 	}
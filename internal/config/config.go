@@ -1,12 +1,17 @@
 package config
 
 import (
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 )
 
 type App struct {
 	Env string `mapstructure:"env"`
+	// BaseURL is the public URL of the API, used to build links sent by email.
+	BaseURL string `mapstructure:"base_url"`
 }
 
 type ServerConfig struct {
@@ -28,6 +33,19 @@ type JWT struct {
 	Secret                    string `mapstructure:"secret"`
 	ExpirySeconds             int64  `mapstructure:"expiry_seconds"`
 	RefreshTokenExpirySeconds int64  `mapstructure:"refresh_token_expiry_seconds"`
+	// Keys are asymmetric signing keys. When empty, tokens are signed with Secret using HS256.
+	Keys []JWTKey `mapstructure:"keys"`
+}
+
+// JWTKey is an asymmetric signing key identified by its kid. During rotation a
+// new key starts signing at NotBefore while the previous key keeps verifying
+// until NotAfter, which should leave at least one access token lifetime of overlap.
+type JWTKey struct {
+	ID             string    `mapstructure:"id"`
+	Algorithm      string    `mapstructure:"algorithm"` // RS256 or EdDSA
+	PrivateKeyFile string    `mapstructure:"private_key_file"`
+	NotBefore      time.Time `mapstructure:"not_before"`
+	NotAfter       time.Time `mapstructure:"not_after"`
 }
 
 type Email struct {
@@ -35,6 +53,55 @@ type Email struct {
 	AppPassword string `mapstructure:"app_password"`
 	SmtpHost    string `mapstructure:"smtp_host"`
 	SmtpPort    string `mapstructure:"smtp_port"`
+	// VerificationExpirySeconds bounds how long an email verification link stays valid.
+	VerificationExpirySeconds int64 `mapstructure:"verification_expiry_seconds"`
+	// PasswordResetURL is the client page that collects a new password; the reset token is appended as ?token=.
+	PasswordResetURL string `mapstructure:"password_reset_url"`
+}
+
+// LoginThrottle limits failed logins. Zero values fall back to defaults.
+type LoginThrottle struct {
+	// MaxAttempts is the number of failures per email within the window that locks the account.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// MaxAttemptsPerIP is the number of failures per IP within the window before the IP is blocked.
+	MaxAttemptsPerIP   int   `mapstructure:"max_attempts_per_ip"`
+	WindowSeconds      int64 `mapstructure:"window_seconds"`
+	LockoutSeconds     int64 `mapstructure:"lockout_seconds"`
+	BackoffBaseSeconds int64 `mapstructure:"backoff_base_seconds"`
+	BackoffMaxSeconds  int64 `mapstructure:"backoff_max_seconds"`
+}
+
+type MFA struct {
+	// Issuer is the account label shown in authenticator apps.
+	Issuer string `mapstructure:"issuer"`
+	// RequiredScopes lists login scopes (user, tenant, admin) that must pass a second factor.
+	// Tenant roles can require it individually as well.
+	RequiredScopes []string `mapstructure:"required_scopes"`
+}
+
+// PaymentGateway configures the online payment processor.
+type PaymentGateway struct {
+	// BaseURL is the gateway API. When empty, a local fake gateway is started instead.
+	BaseURL   string `mapstructure:"base_url"`
+	ServerKey string `mapstructure:"server_key"`
+	// WebhookSecret is the HMAC-SHA256 key the gateway signs notifications with.
+	WebhookSecret string `mapstructure:"webhook_secret"`
+	// ChargeExpirySeconds bounds how long a virtual account or e-wallet charge can be paid.
+	ChargeExpirySeconds int64 `mapstructure:"charge_expiry_seconds"`
+	TimeoutSeconds      int64 `mapstructure:"timeout_seconds"`
+}
+
+// SLA configures the monitor that flags orders not ready by their due time.
+type SLA struct {
+	// CheckIntervalSeconds is how often overdue orders are looked for. Zero falls back to the default.
+	CheckIntervalSeconds int64 `mapstructure:"check_interval_seconds"`
+}
+
+// Tracking limits the public order tracking endpoint against token guessing.
+// Zero values fall back to defaults.
+type Tracking struct {
+	MaxRequestsPerIP int64 `mapstructure:"max_requests_per_ip"`
+	WindowSeconds    int64 `mapstructure:"window_seconds"`
 }
 
 type Redis struct {
@@ -51,6 +118,11 @@ type Config struct {
 	JWT      JWT            `mapstructure:"jwt"`
 	Email    Email          `mapstructure:"email"`
 	Redis    Redis          `mapstructure:"redis"`
+	Login    LoginThrottle  `mapstructure:"login"`
+	MFA      MFA            `mapstructure:"mfa"`
+	Payment  PaymentGateway `mapstructure:"payment_gateway"`
+	SLA      SLA            `mapstructure:"sla"`
+	Tracking Tracking       `mapstructure:"tracking"`
 }
 
 func LoadConfig(path string) *Config {
@@ -61,7 +133,13 @@ func LoadConfig(path string) *Config {
 	}
 
 	var cfg Config
-	if err := viper.Unmarshal(&cfg); err != nil {
+	decodeHook := viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
+	))
+
+	if err := viper.Unmarshal(&cfg, decodeHook); err != nil {
 		log.Fatal().Err(err).Msg("Failed to unmarshal config")
 	}
 
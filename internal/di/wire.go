@@ -11,8 +11,10 @@ import (
 	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/address"
 	"github.com/umardev500/laundry/internal/feature/auth"
+	"github.com/umardev500/laundry/internal/feature/cashshift"
 	"github.com/umardev500/laundry/internal/feature/machine"
 	"github.com/umardev500/laundry/internal/feature/machinetype"
+	"github.com/umardev500/laundry/internal/feature/modifier"
 	"github.com/umardev500/laundry/internal/feature/order"
 	"github.com/umardev500/laundry/internal/feature/orderitem"
 	"github.com/umardev500/laundry/internal/feature/orderstatushistory"
@@ -20,7 +22,9 @@ import (
 	"github.com/umardev500/laundry/internal/feature/paymentmethod"
 	"github.com/umardev500/laundry/internal/feature/plan"
 	"github.com/umardev500/laundry/internal/feature/platformuser"
+	"github.com/umardev500/laundry/internal/feature/promotion"
 	"github.com/umardev500/laundry/internal/feature/rbac"
+	"github.com/umardev500/laundry/internal/feature/refund"
 	"github.com/umardev500/laundry/internal/feature/region"
 	"github.com/umardev500/laundry/internal/feature/service"
 	"github.com/umardev500/laundry/internal/feature/servicecategory"
@@ -29,8 +33,12 @@ import (
 	"github.com/umardev500/laundry/internal/feature/tenant"
 	"github.com/umardev500/laundry/internal/feature/tenantuser"
 	"github.com/umardev500/laundry/internal/feature/user"
+	"github.com/umardev500/laundry/internal/feature/wallet"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
 	"github.com/umardev500/laundry/internal/infra/database/redis"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+	"github.com/umardev500/laundry/internal/infra/mailer"
+	"github.com/umardev500/laundry/internal/infra/paymentgateway"
 	"github.com/umardev500/laundry/pkg/validator"
 )
 
@@ -45,6 +53,9 @@ var AppSet = wire.NewSet(
 	tenantuser.ProviderSet,
 	rbac.ProviderSet,
 	redis.NewRedisClient,
+	mailer.NewMailer,
+	paymentgateway.NewPaymentGateway,
+	jwtkeys.NewKeySet,
 	machine.ProviderSet,
 	machinetype.ProviderSet,
 	serviceunit.ProviderSet,
@@ -52,6 +63,11 @@ var AppSet = wire.NewSet(
 	servicecategory.ProviderSet,
 	orderitem.ProviderSet,
 	payment.ProviderSet,
+	refund.ProviderSet,
+	cashshift.ProviderSet,
+	wallet.ProviderSet,
+	promotion.ProviderSet,
+	modifier.ProviderSet,
 	paymentmethod.ProviderSet,
 	order.ProviderSet,
 	orderstatushistory.ProviderSet,
@@ -83,6 +99,11 @@ func newRegistrars(
 	orderReg *order.Routes,
 	paymentMethodReg *paymentmethod.Routes,
 	paymentReg *payment.Routes,
+	refundReg *refund.Routes,
+	cashShiftReg *cashshift.Routes,
+	walletReg *wallet.Routes,
+	promotionReg *promotion.Routes,
+	modifierReg *modifier.Routes,
 	orderStatusHistoryReg *orderstatushistory.Routes,
 	planReg *plan.Routes,
 	subscriptionReg *subscription.Routes,
@@ -105,6 +126,11 @@ func newRegistrars(
 		orderReg,
 		paymentMethodReg,
 		paymentReg,
+		refundReg,
+		cashShiftReg,
+		walletReg,
+		promotionReg,
+		modifierReg,
 		orderStatusHistoryReg,
 		planReg,
 		subscriptionReg,
@@ -48,6 +48,7 @@ func (r *entAddressRepository) Create(ctx *appctx.Context, a *domain.Address) (*
 func (r *entAddressRepository) FindByID(ctx *appctx.Context, id uuid.UUID, q *query.FindAddressByIDQuery) (*domain.Address, error) {
 	conn := r.client.GetConn(ctx)
 	builder := conn.Addresses.Query().Where(addresses.IDEQ(id))
+	builder = r.applyScope(ctx, builder)
 
 	if q == nil {
 		q = &query.FindAddressByIDQuery{}
@@ -79,14 +80,16 @@ func (r *entAddressRepository) FindByID(ctx *appctx.Context, id uuid.UUID, q *qu
 // FindPrimaryByUserID retrieves the primary address for a given user.
 func (r *entAddressRepository) FindPrimaryByUserID(ctx *appctx.Context, userID uuid.UUID) (*domain.Address, error) {
 	conn := r.client.GetConn(ctx)
-	model, err := conn.Addresses.
+	builder := conn.Addresses.
 		Query().
 		Where(
 			addresses.HasUserWith(user.IDEQ(userID)),
 			addresses.IsPrimaryEQ(true),
 			addresses.DeletedAtIsNil(),
-		).
-		Only(ctx)
+		)
+	builder = r.applyScope(ctx, builder)
+
+	model, err := builder.Only(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +145,7 @@ func (r *entAddressRepository) List(ctx *appctx.Context, q *query.ListAddressQue
 	conn := r.client.GetConn(ctx)
 
 	builder := conn.Addresses.Query()
+	builder = r.applyScope(ctx, builder)
 
 	if q.WithUser {
 		builder.WithUser()
@@ -214,3 +218,13 @@ func (r *entAddressRepository) List(ctx *appctx.Context, q *query.ListAddressQue
 
 	return pagination.NewPageData(domainList, total), nil
 }
+
+// applyScope limits queries to the caller's own addresses. Platform admins see
+// every user's addresses.
+func (r *entAddressRepository) applyScope(ctx *appctx.Context, builder *ent.AddressesQuery) *ent.AddressesQuery {
+	if ctx.Scope() == appctx.ScopeAdmin {
+		return builder
+	}
+
+	return builder.Where(addresses.HasUserWith(user.IDEQ(*ctx.UserID())))
+}
@@ -4,13 +4,16 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/address/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -18,7 +21,9 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("addresses")
 
-	group.Use(middleware.CheckAuth(r.config))
+	// Addresses belong to the caller; the repository scopes every query to
+	// them, so no permission beyond being signed in is needed.
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
 
 	group.Post("/", r.handler.Create)
 	group.Get("/", r.handler.List)
@@ -33,9 +38,10 @@ func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group.Get("/primary/:user_id", r.handler.GetPrimary)
 }
 
-func NewRoutes(h *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService) *Routes {
 	return &Routes{
-		handler: h,
-		config:  config,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
 	}
 }
@@ -122,6 +122,11 @@ func (s *addressService) Restore(ctx *appctx.Context, id uuid.UUID) (*domain.Add
 
 // GetPrimaryByUserID fetches the primary address of a user.
 func (s *addressService) GetPrimaryByUserID(ctx *appctx.Context, userID uuid.UUID) (*domain.Address, error) {
+	// Only platform admins may look up another user's address
+	if ctx.Scope() != appctx.ScopeAdmin && *ctx.UserID() != userID {
+		return nil, domain.NewAddressError(domain.ErrUnauthorizedAddress)
+	}
+
 	addr, err := s.repo.FindPrimaryByUserID(ctx, userID)
 	if err != nil {
 		if ent.IsNotFound(err) {
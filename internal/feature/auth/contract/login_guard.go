@@ -0,0 +1,35 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// LoginGuard throttles failed logins per email and per IP with exponential
+// backoff, and locks accounts that keep failing.
+type LoginGuard interface {
+	// Check rejects the attempt with domain.ErrTooManyAttempts while the email
+	// or IP is backing off or blocked.
+	Check(ctx *appctx.Context, email string, ipAddress string) error
+
+	// Fail records a rejected attempt. Once the email reaches the limit, the
+	// matched user is locked and domain.ErrAccountLocked is returned.
+	Fail(ctx *appctx.Context, attempt *domain.LoginAttempt) error
+
+	// Succeed clears the failure history of the email.
+	Succeed(ctx *appctx.Context, email string) error
+
+	// CheckMFA rejects a second-factor attempt with domain.ErrTooManyAttempts
+	// while the user or IP is backing off or blocked.
+	CheckMFA(ctx *appctx.Context, userID uuid.UUID, ipAddress string) error
+
+	// FailMFA records a wrong second factor for attempt.UserID. These failures
+	// count against the account and survive a correct password, so starting a
+	// new challenge does not restore the budget. Once the user reaches the
+	// limit, the account is locked and domain.ErrAccountLocked is returned.
+	FailMFA(ctx *appctx.Context, attempt *domain.LoginAttempt) error
+
+	// SucceedMFA clears the second-factor failure history of the user.
+	SucceedMFA(ctx *appctx.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,29 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// MFAService manages TOTP second factors and the second login step.
+type MFAService interface {
+	// Enroll starts registering an authenticator for the signed-in user.
+	Enroll(ctx *appctx.Context) (*domain.TOTPEnrollment, error)
+
+	// Confirm enables the factor with a code from the authenticator and returns recovery codes.
+	Confirm(ctx *appctx.Context, code string) ([]string, error)
+
+	// RegenerateRecoveryCodes replaces the recovery codes after checking a TOTP code.
+	RegenerateRecoveryCodes(ctx *appctx.Context, code string) ([]string, error)
+
+	// Disable removes the factor after checking a TOTP or recovery code.
+	Disable(ctx *appctx.Context, code string) error
+
+	// Challenge returns an MFA challenge for the login, or nil when no second
+	// factor is needed. roleID is the tenant role the login is for, if any.
+	Challenge(ctx *appctx.Context, login *domain.PendingLogin, roleID *uuid.UUID) (*domain.MFAChallenge, error)
+
+	// Verify completes a challenged login with a TOTP or recovery code.
+	Verify(ctx *appctx.Context, challengeToken string, code string, client domain.ClientInfo) (*domain.LoginResponse, error)
+}
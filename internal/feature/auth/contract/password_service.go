@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+// PasswordService lets users recover and change their password. Every
+// successful change revokes the user's existing sessions.
+type PasswordService interface {
+	// Forgot emails a single-use reset token. Unknown or inactive accounts are
+	// ignored so the endpoint does not reveal which accounts exist.
+	Forgot(ctx *appctx.Context, email string) error
+
+	// Reset sets a new password using a token issued by Forgot.
+	Reset(ctx *appctx.Context, token string, password string) error
+
+	// Change sets a new password for the signed-in user after checking the current one.
+	Change(ctx *appctx.Context, currentPassword string, newPassword string) error
+}
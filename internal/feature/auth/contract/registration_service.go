@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"github.com/umardev500/laundry/internal/app/appctx"
+
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+// RegistrationService handles customer self-registration.
+type RegistrationService interface {
+	// Register creates a pending customer account and emails a verification link.
+	Register(ctx *appctx.Context, email string, password string) (*userDomain.User, error)
+
+	// Verify activates the account the verification token was issued for.
+	Verify(ctx *appctx.Context, token string) (*userDomain.User, error)
+
+	// ResendVerification emails a new link if the account is still pending.
+	// Unknown emails are ignored so the endpoint does not reveal which accounts exist.
+	ResendVerification(ctx *appctx.Context, email string) error
+}
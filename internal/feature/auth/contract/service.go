@@ -1,12 +1,19 @@
 package contract
 
 import (
+	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/auth/domain"
 )
 
 type Service interface {
-	Login(ctx *appctx.Context, email string, password string) (*domain.LoginResponse, error)
-	LoginTenant(ctx *appctx.Context, email string, password string) (*domain.LoginResponse, error)
-	LoginAdmin(ctx *appctx.Context, email string, password string) (*domain.LoginResponse, error)
+	Login(ctx *appctx.Context, email string, password string, client domain.ClientInfo) (*domain.LoginResponse, error)
+	LoginTenant(ctx *appctx.Context, email string, password string, client domain.ClientInfo) (*domain.LoginResponse, error)
+	LoginAdmin(ctx *appctx.Context, email string, password string, client domain.ClientInfo) (*domain.LoginResponse, error)
+
+	// ListTenants returns the caller's active memberships with a selection token for SwitchTenant.
+	ListTenants(ctx *appctx.Context) (*domain.TenantSelection, error)
+
+	// SwitchTenant exchanges a selection token for a session scoped to the chosen tenant.
+	SwitchTenant(ctx *appctx.Context, selectionToken string, tenantID uuid.UUID, client domain.ClientInfo) (*domain.LoginResponse, error)
 }
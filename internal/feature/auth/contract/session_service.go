@@ -0,0 +1,34 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// SessionService manages per-device sessions and the tokens issued for them.
+type SessionService interface {
+	// Start opens a new session for the user and issues its first token pair.
+	Start(ctx *appctx.Context, userID uuid.UUID, scope appctx.Scope, claims map[string]any, client domain.ClientInfo) (*domain.LoginResponse, error)
+
+	// Refresh rotates a refresh token. Presenting an already rotated token revokes the whole session.
+	Refresh(ctx *appctx.Context, refreshToken string, client domain.ClientInfo) (*domain.LoginResponse, error)
+
+	// Logout revokes the session bound to the access token in context.
+	Logout(ctx *appctx.Context) error
+
+	// LogoutAll revokes every session of the user in context.
+	LogoutAll(ctx *appctx.Context) error
+
+	// List returns the sessions of the user in context.
+	List(ctx *appctx.Context) ([]*domain.Session, error)
+
+	// Revoke revokes one of the sessions of the user in context.
+	Revoke(ctx *appctx.Context, sessionID uuid.UUID) error
+
+	// IsTokenRevoked reports whether the access token with the given jti has been revoked.
+	IsTokenRevoked(ctx *appctx.Context, tokenID string) (bool, error)
+
+	// IsSessionActive reports whether the session has not been logged out, revoked or expired.
+	IsSessionActive(ctx *appctx.Context, sessionID uuid.UUID) (bool, error)
+}
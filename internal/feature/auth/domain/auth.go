@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // Tokens holds both access and refresh tokens
 type Tokens struct {
@@ -9,7 +13,31 @@ type Tokens struct {
 	ExpiresAt    time.Time
 }
 
-// LoginResponse represents the result of a successful login
+// TenantMembership is a tenant the user can sign in to.
+type TenantMembership struct {
+	TenantID uuid.UUID
+	RoleID   *uuid.UUID
+}
+
+// TenantSelection is returned instead of tokens when the user has to pick a tenant.
+type TenantSelection struct {
+	Token     string
+	ExpiresAt time.Time
+	Tenants   []TenantMembership
+}
+
+// TenantSelectionGrant is what a selection token stands for while it is outstanding.
+type TenantSelectionGrant struct {
+	UserID    uuid.UUID
+	SessionID *uuid.UUID // session to retire once the switch succeeds, if any
+}
+
+// LoginResponse represents the result of a successful login. Exactly one of
+// Tokens, Selection or MFA is set. RecoveryCodes accompanies Tokens when the
+// login enrolled a second factor.
 type LoginResponse struct {
-	Tokens Tokens
+	Tokens        *Tokens
+	Selection     *TenantSelection
+	MFA           *MFAChallenge
+	RecoveryCodes []string
 }
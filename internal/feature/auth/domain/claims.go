@@ -9,4 +9,6 @@ type Claims struct {
 	UserID   uuid.UUID
 	TenantID *uuid.UUID
 	Scope    appctx.Scope
+	Session  *uuid.UUID
+	TokenID  string
 }
@@ -4,5 +4,30 @@ import "errors"
 
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrMultipleTenants    = errors.New("multiple tenants found for user")
+	ErrTenantNotAllowed   = errors.New("user is not an active member of the tenant")
+	ErrEmailNotVerified   = errors.New("email address has not been verified")
+	ErrUserInactive       = errors.New("account is not active")
+	ErrTooManyAttempts    = errors.New("too many failed login attempts, try again later")
+	ErrAccountLocked      = errors.New("account is temporarily locked")
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected, session revoked")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrMissingSession      = errors.New("token is not bound to a session")
+	ErrInvalidSelection    = errors.New("invalid or expired tenant selection token")
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	ErrInvalidResetToken        = errors.New("invalid or expired password reset token")
+	ErrIncorrectPassword        = errors.New("current password is incorrect")
+)
+
+var (
+	ErrInvalidMFAChallenge = errors.New("invalid or expired mfa challenge")
+	ErrInvalidMFACode      = errors.New("invalid mfa code")
+	ErrMFANotEnrolled      = errors.New("two-factor authentication is not enrolled")
+	ErrMFAAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
 )
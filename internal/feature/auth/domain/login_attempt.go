@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// LoginAttempt is a rejected login, kept for auditing.
+type LoginAttempt struct {
+	ID        uuid.UUID
+	Email     string
+	UserID    *uuid.UUID
+	Scope     appctx.Scope
+	IPAddress string
+	UserAgent string
+	Reason    types.LoginFailureReason
+	CreatedAt time.Time
+}
+
+// NewLoginAttempt returns a failed attempt for the email, rejected for invalid credentials.
+func NewLoginAttempt(email string, scope appctx.Scope, client ClientInfo) *LoginAttempt {
+	return &LoginAttempt{
+		Email:     email,
+		Scope:     scope,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Reason:    types.LoginFailureInvalidCredentials,
+	}
+}
+
+// RetryAfterError wraps an error with how long the caller should wait before trying again.
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
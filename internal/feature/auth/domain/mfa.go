@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/pkg/security"
+)
+
+// totpSkew is the number of 30 second steps accepted on either side of now.
+const totpSkew = 1
+
+// TOTPFactor is a user's authenticator app registration.
+type TOTPFactor struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Secret        string
+	ConfirmedAt   *time.Time
+	LastUsedStep  int64
+	RecoveryCodes []string // hashes of unused codes
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewTOTPFactor returns an unconfirmed factor with a fresh secret.
+func NewTOTPFactor(userID uuid.UUID) (*TOTPFactor, error) {
+	secret, err := security.NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPFactor{
+		UserID: userID,
+		Secret: secret,
+	}, nil
+}
+
+// IsEnabled reports whether the factor has been confirmed and is enforced at login.
+func (f *TOTPFactor) IsEnabled() bool {
+	return f.ConfirmedAt != nil
+}
+
+// Confirm enables the factor.
+func (f *TOTPFactor) Confirm(now time.Time) {
+	f.ConfirmedAt = &now
+	f.UpdatedAt = now
+}
+
+// VerifyCode checks a TOTP code. Each time step is accepted only once.
+func (f *TOTPFactor) VerifyCode(code string, now time.Time) bool {
+	step, ok := security.ValidateTOTP(f.Secret, code, now, totpSkew)
+	if !ok || step <= f.LastUsedStep {
+		return false
+	}
+
+	f.LastUsedStep = step
+	f.UpdatedAt = now
+	return true
+}
+
+// SetRecoveryCodes replaces the recovery codes with the hashes of the given codes.
+func (f *TOTPFactor) SetRecoveryCodes(codes []string) {
+	f.RecoveryCodes = make([]string, len(codes))
+	for i, code := range codes {
+		f.RecoveryCodes[i] = security.HashToken(normalizeRecoveryCode(code))
+	}
+}
+
+// UseRecoveryCode consumes a recovery code, reporting whether it was valid.
+func (f *TOTPFactor) UseRecoveryCode(code string) bool {
+	hash := security.HashToken(normalizeRecoveryCode(code))
+
+	i := slices.Index(f.RecoveryCodes, hash)
+	if i < 0 {
+		return false
+	}
+
+	f.RecoveryCodes = slices.Delete(f.RecoveryCodes, i, i+1)
+	return true
+}
+
+// normalizeRecoveryCode ignores case, spaces and dashes.
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+}
+
+// TOTPEnrollment is what an authenticator app needs to register the factor.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string // otpauth:// URI, usually rendered as a QR code
+}
+
+// MFAChallenge is returned instead of tokens when a second factor is needed.
+// Enrollment is set when the user must register an authenticator first.
+type MFAChallenge struct {
+	Token      string
+	ExpiresAt  time.Time
+	Enrollment *TOTPEnrollment
+}
+
+// PendingLogin is a login that passed the first factor and is waiting to start a session.
+type PendingLogin struct {
+	UserID          uuid.UUID
+	Scope           appctx.Scope
+	Claims          map[string]any
+	ReplacesSession *uuid.UUID // session to retire once the login completes, if any
+	Enroll          bool       // the factor is confirmed by this challenge
+}
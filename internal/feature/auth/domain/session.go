@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+// ClientInfo describes the device a session was opened from.
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Session is a refresh-token family: a single device login whose refresh
+// token is rotated on every use.
+type Session struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Scope         appctx.Scope
+	Claims        map[string]any // extra access-token claims, e.g. tenant_id
+	TokenHash     string         // hash of the current refresh token
+	AccessTokenID string         // jti of the latest access token issued for the session
+	UserAgent     string
+	IPAddress     string
+	CreatedAt     time.Time
+	LastUsedAt    time.Time
+	ExpiresAt     time.Time
+}
+
+// NewSession opens a new session for the user.
+func NewSession(userID uuid.UUID, scope appctx.Scope, claims map[string]any, client ClientInfo, ttl time.Duration) *Session {
+	now := time.Now().UTC()
+	return &Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Scope:      scope,
+		Claims:     claims,
+		UserAgent:  client.UserAgent,
+		IPAddress:  client.IPAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+}
+
+// Rotate records a newly issued token pair and extends the session lifetime.
+func (s *Session) Rotate(tokenHash, accessTokenID string, ttl time.Duration) {
+	now := time.Now().UTC()
+	s.TokenHash = tokenHash
+	s.AccessTokenID = accessTokenID
+	s.LastUsedAt = now
+	s.ExpiresAt = now.Add(ttl)
+}
+
+// TTL returns how long the session remains valid.
+func (s *Session) TTL() time.Duration {
+	return time.Until(s.ExpiresAt)
+}
+
+// BelongsTo reports whether the session is owned by the given user.
+func (s *Session) BelongsTo(userID uuid.UUID) bool {
+	return s.UserID == userID
+}
@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Tokens struct {
 	AccessToken  string    `json:"access_token"`
@@ -8,6 +12,31 @@ type Tokens struct {
 	ExpiresAt    time.Time `json:"expires_at"`
 }
 
+type TenantMembership struct {
+	TenantID uuid.UUID  `json:"tenant_id"`
+	RoleID   *uuid.UUID `json:"role_id,omitempty"`
+}
+
+type TenantSelection struct {
+	SelectionToken string             `json:"selection_token"`
+	ExpiresAt      time.Time          `json:"expires_at"`
+	Tenants        []TenantMembership `json:"tenants"`
+}
+
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type MFAChallenge struct {
+	ChallengeToken string          `json:"challenge_token"`
+	ExpiresAt      time.Time       `json:"expires_at"`
+	Enrollment     *TOTPEnrollment `json:"enrollment,omitempty"`
+}
+
 type LoginResponse struct {
-	Tokens Tokens `json:"tokens"`
+	Tokens          *Tokens          `json:"tokens,omitempty"`
+	TenantSelection *TenantSelection `json:"tenant_selection,omitempty"`
+	MFA             *MFAChallenge    `json:"mfa,omitempty"`
+	RecoveryCodes   []string         `json:"recovery_codes,omitempty"`
 }
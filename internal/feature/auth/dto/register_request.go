@@ -0,0 +1,10 @@
+package dto
+
+type RegisterRequest struct {
+	Email    string `json:"email" validate:"email,required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"email,required"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+type RegisterResponse struct {
+	ID     uuid.UUID        `json:"id"`
+	Email  string           `json:"email"`
+	Status types.UserStatus `json:"status"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+type SessionResponse struct {
+	ID         uuid.UUID    `json:"id"`
+	Scope      appctx.Scope `json:"scope"`
+	UserAgent  string       `json:"user_agent,omitempty"`
+	IPAddress  string       `json:"ip_address,omitempty"`
+	Current    bool         `json:"current"`
+	CreatedAt  time.Time    `json:"created_at"`
+	LastUsedAt time.Time    `json:"last_used_at"`
+	ExpiresAt  time.Time    `json:"expires_at"`
+}
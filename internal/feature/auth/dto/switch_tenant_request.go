@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/google/uuid"
+
+type SwitchTenantRequest struct {
+	SelectionToken string    `json:"selection_token" validate:"required"`
+	TenantID       uuid.UUID `json:"tenant_id" validate:"required"`
+}
@@ -1,28 +1,46 @@
 package handler
 
 import (
-	"errors"
-
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/auth/contract"
 	"github.com/umardev500/laundry/internal/feature/auth/domain"
 	"github.com/umardev500/laundry/internal/feature/auth/dto"
 	"github.com/umardev500/laundry/internal/feature/auth/mapper"
 	"github.com/umardev500/laundry/internal/feature/auth/query"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
 	"github.com/umardev500/laundry/pkg/httpx"
 	"github.com/umardev500/laundry/pkg/validator"
 )
 
 type Handler struct {
-	service   contract.Service
-	validator *validator.Validator
+	service             contract.Service
+	sessionService      contract.SessionService
+	registrationService contract.RegistrationService
+	passwordService     contract.PasswordService
+	mfaService          contract.MFAService
+	keys                *jwtkeys.KeySet
+	validator           *validator.Validator
 }
 
-func NewHandler(service contract.Service, validator *validator.Validator) *Handler {
+func NewHandler(
+	service contract.Service,
+	sessionService contract.SessionService,
+	registrationService contract.RegistrationService,
+	passwordService contract.PasswordService,
+	mfaService contract.MFAService,
+	keys *jwtkeys.KeySet,
+	validator *validator.Validator,
+) *Handler {
 	return &Handler{
-		service:   service,
-		validator: validator,
+		service:             service,
+		sessionService:      sessionService,
+		registrationService: registrationService,
+		passwordService:     passwordService,
+		mfaService:          mfaService,
+		keys:                keys,
+		validator:           validator,
 	}
 }
 
@@ -52,40 +70,25 @@ func (h *Handler) Login(c *fiber.Ctx) error {
 	}
 
 	ctx := appctx.New(c.UserContext())
+	client := clientInfo(c)
 
 	if query.Scope == appctx.ScopeUser {
-		result, err = h.service.Login(ctx, req.Email, req.Password)
+		result, err = h.service.Login(ctx, req.Email, req.Password, client)
 		if err != nil {
-			switch {
-			case errors.Is(err, domain.ErrInvalidCredentials):
-				return httpx.Unauthorized(c, err.Error())
-			default:
-				return httpx.InternalServerError(c, err.Error())
-			}
+			return handleSessionError(c, err)
 		}
 
 	}
 	if query.Scope == appctx.ScopeTenant {
-		result, err = h.service.LoginTenant(ctx, req.Email, req.Password)
+		result, err = h.service.LoginTenant(ctx, req.Email, req.Password, client)
 		if err != nil {
-			switch {
-			case errors.Is(err, domain.ErrInvalidCredentials):
-				return httpx.Unauthorized(c, err.Error())
-			default:
-				return httpx.InternalServerError(c, err.Error())
-			}
-
+			return handleSessionError(c, err)
 		}
 	}
 	if query.Scope == appctx.ScopeAdmin {
-		result, err = h.service.LoginAdmin(ctx, req.Email, req.Password)
+		result, err = h.service.LoginAdmin(ctx, req.Email, req.Password, client)
 		if err != nil {
-			switch {
-			case errors.Is(err, domain.ErrInvalidCredentials):
-				return httpx.Unauthorized(c, err.Error())
-			default:
-				return httpx.InternalServerError(c, err.Error())
-			}
+			return handleSessionError(c, err)
 		}
 	}
 
@@ -98,3 +101,326 @@ func (h *Handler) Login(c *fiber.Ctx) error {
 
 	return httpx.JSON(c, fiber.StatusOK, resp)
 }
+
+// Refresh handles POST /auth/refresh
+func (h *Handler) Refresh(c *fiber.Ctx) error {
+	var req dto.RefreshRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.sessionService.Refresh(ctx, req.RefreshToken, clientInfo(c))
+	if err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.FromDomain(result))
+}
+
+// Logout handles POST /auth/logout
+func (h *Handler) Logout(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+	if err := h.sessionService.Logout(ctx); err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// LogoutAll handles POST /auth/logout-all
+func (h *Handler) LogoutAll(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+	if err := h.sessionService.LogoutAll(ctx); err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// ListSessions handles GET /auth/sessions
+func (h *Handler) ListSessions(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+	sessions, err := h.sessionService.List(ctx)
+	if err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToSessionResponses(sessions, ctx.SessionID()))
+}
+
+// RevokeSession handles DELETE /auth/sessions/:id
+func (h *Handler) RevokeSession(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	ctx := appctx.New(c.UserContext())
+	if err := h.sessionService.Revoke(ctx, id); err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// ListTenants handles GET /auth/tenants
+func (h *Handler) ListTenants(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.ListTenants(ctx)
+	if err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantSelectionResponse(result))
+}
+
+// SwitchTenant handles POST /auth/switch-tenant
+func (h *Handler) SwitchTenant(c *fiber.Ctx) error {
+	var req dto.SwitchTenantRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.SwitchTenant(ctx, req.SelectionToken, req.TenantID, clientInfo(c))
+	if err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.FromDomain(result))
+}
+
+// Register handles POST /auth/register
+func (h *Handler) Register(c *fiber.Ctx) error {
+	var req dto.RegisterRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	user, err := h.registrationService.Register(ctx, req.Email, req.Password)
+	if err != nil {
+		return handleRegistrationError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToRegisterResponse(user))
+}
+
+// VerifyEmail handles GET /auth/verify
+func (h *Handler) VerifyEmail(c *fiber.Ctx) error {
+	var q query.VerifyEmailQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	user, err := h.registrationService.Verify(ctx, q.Token)
+	if err != nil {
+		return handleRegistrationError(c, err)
+	}
+
+	return httpx.JSONWithMessage(c, fiber.StatusOK, mapper.ToRegisterResponse(user), "email verified")
+}
+
+// ResendVerification handles POST /auth/verify/resend
+func (h *Handler) ResendVerification(c *fiber.Ctx) error {
+	var req dto.ResendVerificationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	if err := h.registrationService.ResendVerification(ctx, req.Email); err != nil {
+		return handleRegistrationError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// ForgotPassword handles POST /auth/password/forgot
+func (h *Handler) ForgotPassword(c *fiber.Ctx) error {
+	var req dto.ForgotPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	if err := h.passwordService.Forgot(ctx, req.Email); err != nil {
+		return handlePasswordError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// ResetPassword handles POST /auth/password/reset
+func (h *Handler) ResetPassword(c *fiber.Ctx) error {
+	var req dto.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	if err := h.passwordService.Reset(ctx, req.Token, req.Password); err != nil {
+		return handlePasswordError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// ChangePassword handles POST /auth/password/change
+func (h *Handler) ChangePassword(c *fiber.Ctx) error {
+	var req dto.ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	if err := h.passwordService.Change(ctx, req.CurrentPassword, req.NewPassword); err != nil {
+		return handlePasswordError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// VerifyMFA handles POST /auth/mfa/verify
+func (h *Handler) VerifyMFA(c *fiber.Ctx) error {
+	var req dto.MFAVerifyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.mfaService.Verify(ctx, req.ChallengeToken, req.Code, clientInfo(c))
+	if err != nil {
+		return handleSessionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.FromDomain(result))
+}
+
+// EnrollTOTP handles POST /auth/mfa/totp
+func (h *Handler) EnrollTOTP(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+	enrollment, err := h.mfaService.Enroll(ctx)
+	if err != nil {
+		return handleMFAError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToTOTPEnrollmentResponse(enrollment))
+}
+
+// ConfirmTOTP handles POST /auth/mfa/totp/confirm
+func (h *Handler) ConfirmTOTP(c *fiber.Ctx) error {
+	var req dto.MFACodeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	codes, err := h.mfaService.Confirm(ctx, req.Code)
+	if err != nil {
+		return handleMFAError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, &dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// RegenerateRecoveryCodes handles POST /auth/mfa/recovery-codes
+func (h *Handler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	var req dto.MFACodeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	codes, err := h.mfaService.RegenerateRecoveryCodes(ctx, req.Code)
+	if err != nil {
+		return handleMFAError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, &dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// DisableTOTP handles DELETE /auth/mfa/totp
+func (h *Handler) DisableTOTP(c *fiber.Ctx) error {
+	var req dto.MFACodeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	if err := h.mfaService.Disable(ctx, req.Code); err != nil {
+		return handleMFAError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
+// JWKS handles GET /.well-known/jwks.json
+func (h *Handler) JWKS(c *fiber.Ctx) error {
+	set, err := h.keys.PublicSet()
+	if err != nil {
+		return httpx.InternalServerError(c, err.Error())
+	}
+
+	// Served as a bare JWK Set so standard clients can consume it.
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Status(fiber.StatusOK).JSON(set)
+}
+
+// clientInfo extracts the device details recorded on a session.
+func clientInfo(c *fiber.Ctx) domain.ClientInfo {
+	return domain.ClientInfo{
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IPAddress: c.IP(),
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/pkg/httpx"
+
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+// handleSessionError maps session and token errors to HTTP responses.
+func handleSessionError(c *fiber.Ctx, err error) error {
+	var retry *domain.RetryAfterError
+	if errors.As(err, &retry) && retry.RetryAfter > 0 {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retry.RetryAfter.Seconds()))))
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return httpx.TooManyRequests(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidRefreshToken),
+		errors.Is(err, domain.ErrRefreshTokenReused),
+		errors.Is(err, domain.ErrInvalidCredentials),
+		errors.Is(err, domain.ErrMissingSession),
+		errors.Is(err, domain.ErrInvalidSelection),
+		errors.Is(err, domain.ErrInvalidMFAChallenge),
+		errors.Is(err, domain.ErrInvalidMFACode):
+		return httpx.Unauthorized(c, err.Error())
+
+	case errors.Is(err, domain.ErrTenantNotAllowed),
+		errors.Is(err, domain.ErrEmailNotVerified),
+		errors.Is(err, domain.ErrUserInactive),
+		errors.Is(err, domain.ErrAccountLocked):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, domain.ErrSessionNotFound):
+		return httpx.NotFound(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
+
+// handleRegistrationError maps sign-up and email verification errors to HTTP responses.
+func handleRegistrationError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, userDomain.ErrUserAlreadyExists):
+		return httpx.Conflict(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidVerificationToken):
+		return httpx.BadRequest(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
+
+// handlePasswordError maps password reset and change errors to HTTP responses.
+func handlePasswordError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidResetToken),
+		errors.Is(err, domain.ErrIncorrectPassword):
+		return httpx.BadRequest(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidCredentials),
+		errors.Is(err, userDomain.ErrUserNotFound),
+		errors.Is(err, userDomain.ErrUserDeleted):
+		return httpx.Unauthorized(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
+
+// handleMFAError maps authenticator enrollment errors to HTTP responses.
+func handleMFAError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidMFACode):
+		return httpx.BadRequest(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		return httpx.Unauthorized(c, err.Error())
+
+	case errors.Is(err, domain.ErrMFANotEnrolled):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrMFAAlreadyEnabled):
+		return httpx.Conflict(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
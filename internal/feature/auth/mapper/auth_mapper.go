@@ -1,8 +1,14 @@
 package mapper
 
 import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/auth/domain"
 	"github.com/umardev500/laundry/internal/feature/auth/dto"
+	"github.com/umardev500/laundry/pkg/types"
+
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
 )
 
 func FromDomain(d *domain.LoginResponse) *dto.LoginResponse {
@@ -10,11 +16,126 @@ func FromDomain(d *domain.LoginResponse) *dto.LoginResponse {
 		return nil
 	}
 
-	return &dto.LoginResponse{
-		Tokens: dto.Tokens{
+	resp := &dto.LoginResponse{
+		TenantSelection: ToTenantSelectionResponse(d.Selection),
+		MFA:             ToMFAChallengeResponse(d.MFA),
+		RecoveryCodes:   d.RecoveryCodes,
+	}
+
+	if d.Tokens != nil {
+		resp.Tokens = &dto.Tokens{
 			AccessToken:  d.Tokens.AccessToken,
 			RefreshToken: d.Tokens.RefreshToken,
 			ExpiresAt:    d.Tokens.ExpiresAt,
-		},
+		}
+	}
+
+	return resp
+}
+
+func ToTenantSelectionResponse(d *domain.TenantSelection) *dto.TenantSelection {
+	if d == nil {
+		return nil
+	}
+
+	tenants := make([]dto.TenantMembership, len(d.Tenants))
+	for i, t := range d.Tenants {
+		tenants[i] = dto.TenantMembership{
+			TenantID: t.TenantID,
+			RoleID:   t.RoleID,
+		}
+	}
+
+	return &dto.TenantSelection{
+		SelectionToken: d.Token,
+		ExpiresAt:      d.ExpiresAt,
+		Tenants:        tenants,
+	}
+}
+
+func ToMFAChallengeResponse(d *domain.MFAChallenge) *dto.MFAChallenge {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.MFAChallenge{
+		ChallengeToken: d.Token,
+		ExpiresAt:      d.ExpiresAt,
+		Enrollment:     ToTOTPEnrollmentResponse(d.Enrollment),
+	}
+}
+
+func ToTOTPEnrollmentResponse(d *domain.TOTPEnrollment) *dto.TOTPEnrollment {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.TOTPEnrollment{
+		Secret: d.Secret,
+		URI:    d.URI,
+	}
+}
+
+// ToSessionResponses maps sessions to DTOs, flagging the one the caller is using.
+func ToSessionResponses(sessions []*domain.Session, currentID *uuid.UUID) []*dto.SessionResponse {
+	res := make([]*dto.SessionResponse, len(sessions))
+	for i, s := range sessions {
+		res[i] = &dto.SessionResponse{
+			ID:         s.ID,
+			Scope:      s.Scope,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			Current:    currentID != nil && *currentID == s.ID,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+		}
+	}
+	return res
+}
+
+func ToRegisterResponse(u *userDomain.User) *dto.RegisterResponse {
+	if u == nil {
+		return nil
+	}
+
+	return &dto.RegisterResponse{
+		ID:     u.ID,
+		Email:  u.Email,
+		Status: u.Status,
+	}
+}
+
+func ToDomainLoginAttempt(e *ent.LoginAttempt) *domain.LoginAttempt {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.LoginAttempt{
+		ID:        e.ID,
+		Email:     e.Email,
+		UserID:    e.UserID,
+		Scope:     appctx.Scope(e.Scope),
+		IPAddress: e.IPAddress,
+		UserAgent: e.UserAgent,
+		Reason:    types.LoginFailureReason(e.Reason),
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func ToDomainTOTPFactor(e *ent.TotpFactor) *domain.TOTPFactor {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.TOTPFactor{
+		ID:            e.ID,
+		UserID:        e.UserID,
+		Secret:        e.Secret,
+		ConfirmedAt:   e.ConfirmedAt,
+		LastUsedStep:  e.LastUsedStep,
+		RecoveryCodes: e.RecoveryCodes,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
 	}
 }
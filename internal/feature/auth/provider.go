@@ -10,6 +10,18 @@ import (
 var ProviderSet = wire.NewSet(
 	handler.NewHandler,
 	service.NewService,
-	repository.NewRedisRefreshTokenRepository,
+	service.NewSessionService,
+	service.NewRegistrationService,
+	service.NewPasswordService,
+	service.NewLoginGuard,
+	service.NewMFAService,
+	repository.NewRedisSessionRepository,
+	repository.NewRedisTokenDenylistRepository,
+	repository.NewRedisTenantSelectionRepository,
+	repository.NewRedisPasswordResetRepository,
+	repository.NewRedisLoginThrottleRepository,
+	repository.NewLoginAttemptRepository,
+	repository.NewRedisMFAChallengeRepository,
+	repository.NewTOTPFactorRepository,
 	NewRoutes,
 )
@@ -0,0 +1,5 @@
+package query
+
+type VerifyEmailQuery struct {
+	Token string `query:"token" validate:"required"`
+}
@@ -1,14 +1,140 @@
 package repository
 
-import "fmt"
+import (
+	"fmt"
 
-// refreshTokenKey generates a Redis key for refresh tokens for a given userID.
+	"github.com/google/uuid"
+)
+
+// refreshTokenKey maps the hash of an active refresh token to its session.
 type refreshTokenKey string
 
-func newRefreshTokenKey(userID string) refreshTokenKey {
-	return refreshTokenKey(fmt.Sprintf("refresh_token:%s", userID))
+func newRefreshTokenKey(tokenHash string) refreshTokenKey {
+	return refreshTokenKey(fmt.Sprintf("refresh_token:%s", tokenHash))
 }
 
 func (k refreshTokenKey) String() string {
 	return string(k)
 }
+
+// rotatedRefreshTokenKey remembers refresh tokens that have already been
+// rotated so that a replay can be detected.
+type rotatedRefreshTokenKey string
+
+func newRotatedRefreshTokenKey(tokenHash string) rotatedRefreshTokenKey {
+	return rotatedRefreshTokenKey(fmt.Sprintf("refresh_token_rotated:%s", tokenHash))
+}
+
+func (k rotatedRefreshTokenKey) String() string {
+	return string(k)
+}
+
+// sessionKey holds a serialized session.
+type sessionKey string
+
+func newSessionKey(sessionID uuid.UUID) sessionKey {
+	return sessionKey(fmt.Sprintf("session:%s", sessionID))
+}
+
+func (k sessionKey) String() string {
+	return string(k)
+}
+
+// userSessionsKey indexes the session IDs owned by a user.
+type userSessionsKey string
+
+func newUserSessionsKey(userID uuid.UUID) userSessionsKey {
+	return userSessionsKey(fmt.Sprintf("user_sessions:%s", userID))
+}
+
+func (k userSessionsKey) String() string {
+	return string(k)
+}
+
+// accessDenylistKey marks a revoked access token by its jti.
+type accessDenylistKey string
+
+func newAccessDenylistKey(tokenID string) accessDenylistKey {
+	return accessDenylistKey(fmt.Sprintf("access_denylist:%s", tokenID))
+}
+
+func (k accessDenylistKey) String() string {
+	return string(k)
+}
+
+// tenantSelectionKey holds an outstanding tenant selection grant.
+type tenantSelectionKey string
+
+func newTenantSelectionKey(tokenHash string) tenantSelectionKey {
+	return tenantSelectionKey(fmt.Sprintf("tenant_selection:%s", tokenHash))
+}
+
+func (k tenantSelectionKey) String() string {
+	return string(k)
+}
+
+// passwordResetKey maps the hash of a password reset token to its user.
+type passwordResetKey string
+
+func newPasswordResetKey(tokenHash string) passwordResetKey {
+	return passwordResetKey(fmt.Sprintf("password_reset:%s", tokenHash))
+}
+
+func (k passwordResetKey) String() string {
+	return string(k)
+}
+
+// userPasswordResetKey points to the user's outstanding password reset token.
+type userPasswordResetKey string
+
+func newUserPasswordResetKey(userID uuid.UUID) userPasswordResetKey {
+	return userPasswordResetKey(fmt.Sprintf("user_password_reset:%s", userID))
+}
+
+func (k userPasswordResetKey) String() string {
+	return string(k)
+}
+
+// loginFailuresKey counts failed logins for a subject (email or IP) within the throttle window.
+type loginFailuresKey string
+
+func newLoginFailuresKey(subject string) loginFailuresKey {
+	return loginFailuresKey(fmt.Sprintf("login_failures:%s", subject))
+}
+
+func (k loginFailuresKey) String() string {
+	return string(k)
+}
+
+// loginBackoffKey blocks further logins for a subject until it expires.
+type loginBackoffKey string
+
+func newLoginBackoffKey(subject string) loginBackoffKey {
+	return loginBackoffKey(fmt.Sprintf("login_backoff:%s", subject))
+}
+
+func (k loginBackoffKey) String() string {
+	return string(k)
+}
+
+// mfaChallengeKey holds a login waiting for its second factor.
+type mfaChallengeKey string
+
+func newMFAChallengeKey(tokenHash string) mfaChallengeKey {
+	return mfaChallengeKey(fmt.Sprintf("mfa_challenge:%s", tokenHash))
+}
+
+func (k mfaChallengeKey) String() string {
+	return string(k)
+}
+
+// mfaAttemptsKey counts the codes tried against an MFA challenge.
+type mfaAttemptsKey string
+
+func newMFAAttemptsKey(tokenHash string) mfaAttemptsKey {
+	return mfaAttemptsKey(fmt.Sprintf("mfa_attempts:%s", tokenHash))
+}
+
+func (k mfaAttemptsKey) String() string {
+	return string(k)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/umardev500/laundry/ent/loginattempt"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/feature/auth/mapper"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+)
+
+type loginAttemptRepoEnt struct {
+	client *entdb.Client
+}
+
+// NewLoginAttemptRepository returns a new Ent-based login attempt repository.
+func NewLoginAttemptRepository(client *entdb.Client) LoginAttemptRepository {
+	return &loginAttemptRepoEnt{client: client}
+}
+
+// Create implements LoginAttemptRepository.
+func (r *loginAttemptRepoEnt) Create(ctx *appctx.Context, a *domain.LoginAttempt) (*domain.LoginAttempt, error) {
+	conn := r.client.GetConn(ctx)
+
+	entAttempt, err := conn.LoginAttempt.
+		Create().
+		SetEmail(a.Email).
+		SetNillableUserID(a.UserID).
+		SetScope(string(a.Scope)).
+		SetIPAddress(a.IPAddress).
+		SetUserAgent(a.UserAgent).
+		SetReason(loginattempt.Reason(a.Reason)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToDomainLoginAttempt(entAttempt), nil
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// LoginAttemptRepository persists failed login attempts.
+type LoginAttemptRepository interface {
+	Create(ctx *appctx.Context, attempt *domain.LoginAttempt) (*domain.LoginAttempt, error)
+}
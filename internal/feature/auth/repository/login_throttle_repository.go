@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+// LoginThrottleRepository tracks failed logins per subject, such as an email or an IP address.
+type LoginThrottleRepository interface {
+	// AddFailure counts a failure and returns the total within the window,
+	// which starts at the first failure.
+	AddFailure(ctx *appctx.Context, subject string, window time.Duration) (int64, error)
+
+	// Failures returns the failure count and the time left in the current window.
+	Failures(ctx *appctx.Context, subject string) (int64, time.Duration, error)
+
+	// SetBackoff blocks the subject for the given duration.
+	SetBackoff(ctx *appctx.Context, subject string, d time.Duration) error
+
+	// Backoff returns how long the subject remains blocked, or zero.
+	Backoff(ctx *appctx.Context, subject string) (time.Duration, error)
+
+	// Reset clears the failure count and backoff of the subject.
+	Reset(ctx *appctx.Context, subject string) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// MFAChallengeRepository stores logins waiting for their second factor, keyed by token hash.
+type MFAChallengeRepository interface {
+	Save(ctx *appctx.Context, tokenHash string, login *domain.PendingLogin, ttl time.Duration) error
+
+	// Find returns domain.ErrInvalidMFAChallenge when the token is unknown or expired.
+	Find(ctx *appctx.Context, tokenHash string) (*domain.PendingLogin, error)
+
+	// AddAttempt counts a code tried against the challenge and returns the
+	// total. The count is atomic, so concurrent guesses each use up an attempt.
+	AddAttempt(ctx *appctx.Context, tokenHash string, ttl time.Duration) (int64, error)
+
+	// Delete removes the challenge and its attempt count, and reports whether
+	// the challenge still existed, so that only one caller can complete it.
+	Delete(ctx *appctx.Context, tokenHash string) (bool, error)
+}
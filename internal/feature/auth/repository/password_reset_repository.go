@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+// PasswordResetRepository stores password reset tokens by hash. A user has at
+// most one outstanding token; saving a new one invalidates the previous.
+type PasswordResetRepository interface {
+	Save(ctx *appctx.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error
+
+	// Consume atomically removes the token so it can only be used once.
+	// It returns domain.ErrInvalidResetToken when the token is unknown or expired.
+	Consume(ctx *appctx.Context, tokenHash string) (uuid.UUID, error)
+}
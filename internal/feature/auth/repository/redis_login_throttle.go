@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+)
+
+type redisLoginThrottle struct {
+	client *redis.RedisClient
+}
+
+func NewRedisLoginThrottleRepository(client *redis.RedisClient) LoginThrottleRepository {
+	return &redisLoginThrottle{
+		client: client,
+	}
+}
+
+// AddFailure implements LoginThrottleRepository.
+func (r *redisLoginThrottle) AddFailure(ctx *appctx.Context, subject string, window time.Duration) (int64, error) {
+	key := newLoginFailuresKey(subject).String()
+
+	var incr *goredis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// Failures implements LoginThrottleRepository.
+func (r *redisLoginThrottle) Failures(ctx *appctx.Context, subject string) (int64, time.Duration, error) {
+	key := newLoginFailuresKey(subject).String()
+
+	count, err := r.client.Get(ctx, key).Int64()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+
+	ttl, err := r.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return count, max(ttl, 0), nil
+}
+
+// SetBackoff implements LoginThrottleRepository.
+func (r *redisLoginThrottle) SetBackoff(ctx *appctx.Context, subject string, d time.Duration) error {
+	return r.client.Set(ctx, newLoginBackoffKey(subject).String(), 1, d).Err()
+}
+
+// Backoff implements LoginThrottleRepository.
+func (r *redisLoginThrottle) Backoff(ctx *appctx.Context, subject string) (time.Duration, error) {
+	ttl, err := r.client.PTTL(ctx, newLoginBackoffKey(subject).String()).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	// Negative values mean the key is missing or has no expiry.
+	return max(ttl, 0), nil
+}
+
+// Reset implements LoginThrottleRepository.
+func (r *redisLoginThrottle) Reset(ctx *appctx.Context, subject string) error {
+	return r.client.Del(ctx,
+		newLoginFailuresKey(subject).String(),
+		newLoginBackoffKey(subject).String(),
+	).Err()
+}
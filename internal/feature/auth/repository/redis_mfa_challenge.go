@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+)
+
+type pendingLoginRecord struct {
+	UserID          uuid.UUID      `json:"user_id"`
+	Scope           appctx.Scope   `json:"scope"`
+	Claims          map[string]any `json:"claims,omitempty"`
+	ReplacesSession *uuid.UUID     `json:"replaces_session,omitempty"`
+	Enroll          bool           `json:"enroll,omitempty"`
+}
+
+type redisMFAChallenge struct {
+	client *redis.RedisClient
+}
+
+func NewRedisMFAChallengeRepository(client *redis.RedisClient) MFAChallengeRepository {
+	return &redisMFAChallenge{
+		client: client,
+	}
+}
+
+// Save implements MFAChallengeRepository.
+func (r *redisMFAChallenge) Save(ctx *appctx.Context, tokenHash string, login *domain.PendingLogin, ttl time.Duration) error {
+	payload, err := encodePendingLogin(login)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Set(ctx, newMFAChallengeKey(tokenHash).String(), payload, ttl).Err()
+}
+
+// Find implements MFAChallengeRepository.
+func (r *redisMFAChallenge) Find(ctx *appctx.Context, tokenHash string) (*domain.PendingLogin, error) {
+	payload, err := r.client.Get(ctx, newMFAChallengeKey(tokenHash).String()).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, domain.ErrInvalidMFAChallenge
+		}
+		return nil, err
+	}
+
+	var rec pendingLoginRecord
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return nil, err
+	}
+
+	return &domain.PendingLogin{
+		UserID:          rec.UserID,
+		Scope:           rec.Scope,
+		Claims:          rec.Claims,
+		ReplacesSession: rec.ReplacesSession,
+		Enroll:          rec.Enroll,
+	}, nil
+}
+
+// AddAttempt implements MFAChallengeRepository.
+func (r *redisMFAChallenge) AddAttempt(ctx *appctx.Context, tokenHash string, ttl time.Duration) (int64, error) {
+	key := newMFAAttemptsKey(tokenHash).String()
+
+	var incr *goredis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// Delete implements MFAChallengeRepository.
+func (r *redisMFAChallenge) Delete(ctx *appctx.Context, tokenHash string) (bool, error) {
+	var del *goredis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		del = pipe.Del(ctx, newMFAChallengeKey(tokenHash).String())
+		pipe.Del(ctx, newMFAAttemptsKey(tokenHash).String())
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return del.Val() > 0, nil
+}
+
+func encodePendingLogin(login *domain.PendingLogin) ([]byte, error) {
+	return json.Marshal(&pendingLoginRecord{
+		UserID:          login.UserID,
+		Scope:           login.Scope,
+		Claims:          login.Claims,
+		ReplacesSession: login.ReplacesSession,
+		Enroll:          login.Enroll,
+	})
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+)
+
+type redisPasswordReset struct {
+	client *redis.RedisClient
+}
+
+func NewRedisPasswordResetRepository(client *redis.RedisClient) PasswordResetRepository {
+	return &redisPasswordReset{
+		client: client,
+	}
+}
+
+// Save implements PasswordResetRepository.
+func (r *redisPasswordReset) Save(ctx *appctx.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error {
+	userKey := newUserPasswordResetKey(userID)
+
+	previous, err := r.client.Get(ctx, userKey.String()).Result()
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		if previous != "" {
+			pipe.Del(ctx, newPasswordResetKey(previous).String())
+		}
+		pipe.Set(ctx, newPasswordResetKey(tokenHash).String(), userID.String(), ttl)
+		pipe.Set(ctx, userKey.String(), tokenHash, ttl)
+		return nil
+	})
+
+	return err
+}
+
+// Consume implements PasswordResetRepository.
+func (r *redisPasswordReset) Consume(ctx *appctx.Context, tokenHash string) (uuid.UUID, error) {
+	key := newPasswordResetKey(tokenHash)
+
+	value, err := r.client.GetDel(ctx, key.String()).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return uuid.Nil, domain.ErrInvalidResetToken
+		}
+		return uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, domain.ErrInvalidResetToken
+	}
+
+	return userID, nil
+}
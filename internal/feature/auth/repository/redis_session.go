@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+)
+
+// sessionRecord is the JSON representation of a session stored in Redis.
+type sessionRecord struct {
+	ID            uuid.UUID      `json:"id"`
+	UserID        uuid.UUID      `json:"user_id"`
+	Scope         appctx.Scope   `json:"scope"`
+	Claims        map[string]any `json:"claims,omitempty"`
+	TokenHash     string         `json:"token_hash"`
+	AccessTokenID string         `json:"access_token_id"`
+	UserAgent     string         `json:"user_agent,omitempty"`
+	IPAddress     string         `json:"ip_address,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	LastUsedAt    time.Time      `json:"last_used_at"`
+	ExpiresAt     time.Time      `json:"expires_at"`
+}
+
+type redisSession struct {
+	client *redis.RedisClient
+}
+
+func NewRedisSessionRepository(client *redis.RedisClient) SessionRepository {
+	return &redisSession{
+		client: client,
+	}
+}
+
+// Save implements SessionRepository.
+func (r *redisSession) Save(ctx *appctx.Context, s *domain.Session) error {
+	payload, err := json.Marshal(toSessionRecord(s))
+	if err != nil {
+		return err
+	}
+
+	ttl := s.TTL()
+	userKey := newUserSessionsKey(s.UserID).String()
+
+	_, err = r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, newSessionKey(s.ID).String(), payload, ttl)
+		pipe.Set(ctx, newRefreshTokenKey(s.TokenHash).String(), s.ID.String(), ttl)
+		pipe.SAdd(ctx, userKey, s.ID.String())
+		pipe.Expire(ctx, userKey, ttl)
+		return nil
+	})
+	return err
+}
+
+// FindByID implements SessionRepository.
+func (r *redisSession) FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Session, error) {
+	payload, err := r.client.Get(ctx, newSessionKey(id).String()).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	var rec sessionRecord
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return nil, err
+	}
+
+	return rec.toDomain(), nil
+}
+
+// Exists implements SessionRepository.
+func (r *redisSession) Exists(ctx *appctx.Context, id uuid.UUID) (bool, error) {
+	n, err := r.client.Exists(ctx, newSessionKey(id).String()).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ListByUser implements SessionRepository.
+func (r *redisSession) ListByUser(ctx *appctx.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	userKey := newUserSessionsKey(userID).String()
+
+	ids, err := r.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*domain.Session, 0, len(ids))
+	for _, raw := range ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			continue
+		}
+
+		s, err := r.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrSessionNotFound) {
+				// Session expired on its own; drop the stale index entry.
+				r.client.SRem(ctx, userKey, raw)
+				continue
+			}
+			return nil, err
+		}
+
+		sessions = append(sessions, s)
+	}
+
+	return sessions, nil
+}
+
+// ConsumeRefreshToken implements SessionRepository.
+func (r *redisSession) ConsumeRefreshToken(ctx *appctx.Context, tokenHash string) (uuid.UUID, error) {
+	raw, err := r.client.GetDel(ctx, newRefreshTokenKey(tokenHash).String()).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return uuid.Nil, domain.ErrInvalidRefreshToken
+		}
+		return uuid.Nil, err
+	}
+
+	sessionID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, domain.ErrInvalidRefreshToken
+	}
+
+	// Remember the consumed token for as long as its session may live so a replay
+	// can be traced back to the family.
+	ttl := time.Duration(0)
+	if s, err := r.FindByID(ctx, sessionID); err == nil {
+		ttl = s.TTL()
+	}
+	if ttl > 0 {
+		if err := r.client.Set(ctx, newRotatedRefreshTokenKey(tokenHash).String(), raw, ttl).Err(); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return sessionID, nil
+}
+
+// FindRotatedRefreshToken implements SessionRepository.
+func (r *redisSession) FindRotatedRefreshToken(ctx *appctx.Context, tokenHash string) (uuid.UUID, error) {
+	raw, err := r.client.Get(ctx, newRotatedRefreshTokenKey(tokenHash).String()).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return uuid.Nil, domain.ErrInvalidRefreshToken
+		}
+		return uuid.Nil, err
+	}
+
+	sessionID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, domain.ErrInvalidRefreshToken
+	}
+
+	return sessionID, nil
+}
+
+// Delete implements SessionRepository.
+func (r *redisSession) Delete(ctx *appctx.Context, s *domain.Session) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, newSessionKey(s.ID).String())
+		if s.TokenHash != "" {
+			pipe.Del(ctx, newRefreshTokenKey(s.TokenHash).String())
+		}
+		pipe.SRem(ctx, newUserSessionsKey(s.UserID).String(), s.ID.String())
+		return nil
+	})
+	return err
+}
+
+func toSessionRecord(s *domain.Session) *sessionRecord {
+	return &sessionRecord{
+		ID:            s.ID,
+		UserID:        s.UserID,
+		Scope:         s.Scope,
+		Claims:        s.Claims,
+		TokenHash:     s.TokenHash,
+		AccessTokenID: s.AccessTokenID,
+		UserAgent:     s.UserAgent,
+		IPAddress:     s.IPAddress,
+		CreatedAt:     s.CreatedAt,
+		LastUsedAt:    s.LastUsedAt,
+		ExpiresAt:     s.ExpiresAt,
+	}
+}
+
+func (rec *sessionRecord) toDomain() *domain.Session {
+	return &domain.Session{
+		ID:            rec.ID,
+		UserID:        rec.UserID,
+		Scope:         rec.Scope,
+		Claims:        rec.Claims,
+		TokenHash:     rec.TokenHash,
+		AccessTokenID: rec.AccessTokenID,
+		UserAgent:     rec.UserAgent,
+		IPAddress:     rec.IPAddress,
+		CreatedAt:     rec.CreatedAt,
+		LastUsedAt:    rec.LastUsedAt,
+		ExpiresAt:     rec.ExpiresAt,
+	}
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+)
+
+type tenantSelectionRecord struct {
+	UserID    uuid.UUID  `json:"user_id"`
+	SessionID *uuid.UUID `json:"session_id,omitempty"`
+}
+
+type redisTenantSelection struct {
+	client *redis.RedisClient
+}
+
+func NewRedisTenantSelectionRepository(client *redis.RedisClient) TenantSelectionRepository {
+	return &redisTenantSelection{
+		client: client,
+	}
+}
+
+// Save implements TenantSelectionRepository.
+func (r *redisTenantSelection) Save(ctx *appctx.Context, tokenHash string, grant *domain.TenantSelectionGrant, ttl time.Duration) error {
+	payload, err := json.Marshal(&tenantSelectionRecord{
+		UserID:    grant.UserID,
+		SessionID: grant.SessionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	key := newTenantSelectionKey(tokenHash)
+	return r.client.Set(ctx, key.String(), payload, ttl).Err()
+}
+
+// Consume implements TenantSelectionRepository.
+func (r *redisTenantSelection) Consume(ctx *appctx.Context, tokenHash string) (*domain.TenantSelectionGrant, error) {
+	key := newTenantSelectionKey(tokenHash)
+
+	payload, err := r.client.GetDel(ctx, key.String()).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, domain.ErrInvalidSelection
+		}
+		return nil, err
+	}
+
+	var rec tenantSelectionRecord
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return nil, err
+	}
+
+	return &domain.TenantSelectionGrant{
+		UserID:    rec.UserID,
+		SessionID: rec.SessionID,
+	}, nil
+}
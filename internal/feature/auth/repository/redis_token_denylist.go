@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+)
+
+type redisTokenDenylist struct {
+	client *redis.RedisClient
+}
+
+func NewRedisTokenDenylistRepository(client *redis.RedisClient) TokenDenylistRepository {
+	return &redisTokenDenylist{
+		client: client,
+	}
+}
+
+// Add implements TokenDenylistRepository.
+func (r *redisTokenDenylist) Add(ctx *appctx.Context, tokenID string, ttl time.Duration) error {
+	key := newAccessDenylistKey(tokenID)
+	return r.client.Set(ctx, key.String(), 1, ttl).Err()
+}
+
+// Exists implements TokenDenylistRepository.
+func (r *redisTokenDenylist) Exists(ctx *appctx.Context, tokenID string) (bool, error) {
+	key := newAccessDenylistKey(tokenID)
+	n, err := r.client.Exists(ctx, key.String()).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
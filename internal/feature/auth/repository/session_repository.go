@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// SessionRepository persists sessions and the refresh tokens bound to them.
+type SessionRepository interface {
+	// Save stores the session and indexes its current refresh token.
+	Save(ctx *appctx.Context, s *domain.Session) error
+
+	// FindByID returns domain.ErrSessionNotFound when the session does not exist.
+	FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Session, error)
+
+	// Exists reports whether the session is still live.
+	Exists(ctx *appctx.Context, id uuid.UUID) (bool, error)
+
+	// ListByUser returns every live session owned by the user.
+	ListByUser(ctx *appctx.Context, userID uuid.UUID) ([]*domain.Session, error)
+
+	// ConsumeRefreshToken atomically invalidates an active refresh token and returns
+	// its session ID. It returns domain.ErrInvalidRefreshToken when the token is not active.
+	ConsumeRefreshToken(ctx *appctx.Context, tokenHash string) (uuid.UUID, error)
+
+	// FindRotatedRefreshToken returns the session of a refresh token that was already rotated.
+	// It returns domain.ErrInvalidRefreshToken when the token was never issued.
+	FindRotatedRefreshToken(ctx *appctx.Context, tokenHash string) (uuid.UUID, error)
+
+	// Delete removes the session and its active refresh token.
+	Delete(ctx *appctx.Context, s *domain.Session) error
+}
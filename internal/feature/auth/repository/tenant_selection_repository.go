@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// TenantSelectionRepository stores short-lived tenant selection tokens by hash.
+type TenantSelectionRepository interface {
+	Save(ctx *appctx.Context, tokenHash string, grant *domain.TenantSelectionGrant, ttl time.Duration) error
+
+	// Consume atomically removes the grant so a selection token can only be used once.
+	// It returns domain.ErrInvalidSelection when the token is unknown or expired.
+	Consume(ctx *appctx.Context, tokenHash string) (*domain.TenantSelectionGrant, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+// TokenDenylistRepository tracks access tokens revoked before their expiry.
+type TokenDenylistRepository interface {
+	Add(ctx *appctx.Context, tokenID string, ttl time.Duration) error
+	Exists(ctx *appctx.Context, tokenID string) (bool, error)
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/totpfactor"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/feature/auth/mapper"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+)
+
+type totpFactorRepoEnt struct {
+	client *entdb.Client
+}
+
+// NewTOTPFactorRepository returns a new Ent-based TOTP factor repository.
+func NewTOTPFactorRepository(client *entdb.Client) TOTPFactorRepository {
+	return &totpFactorRepoEnt{client: client}
+}
+
+// FindByUserID implements TOTPFactorRepository.
+func (r *totpFactorRepoEnt) FindByUserID(ctx *appctx.Context, userID uuid.UUID) (*domain.TOTPFactor, error) {
+	conn := r.client.GetConn(ctx)
+
+	entFactor, err := conn.TotpFactor.
+		Query().
+		Where(totpfactor.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrMFANotEnrolled
+		}
+		return nil, err
+	}
+
+	return mapper.ToDomainTOTPFactor(entFactor), nil
+}
+
+// Save implements TOTPFactorRepository.
+func (r *totpFactorRepoEnt) Save(ctx *appctx.Context, f *domain.TOTPFactor) (*domain.TOTPFactor, error) {
+	conn := r.client.GetConn(ctx)
+
+	err := conn.TotpFactor.
+		Create().
+		SetUserID(f.UserID).
+		SetSecret(f.Secret).
+		SetNillableConfirmedAt(f.ConfirmedAt).
+		SetLastUsedStep(f.LastUsedStep).
+		SetRecoveryCodes(f.RecoveryCodes).
+		OnConflictColumns(totpfactor.FieldUserID).
+		UpdateNewValues().
+		Update(func(u *ent.TotpFactorUpsert) {
+			if f.ConfirmedAt == nil {
+				u.ClearConfirmedAt()
+			}
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindByUserID(ctx, f.UserID)
+}
+
+// Delete implements TOTPFactorRepository.
+func (r *totpFactorRepoEnt) Delete(ctx *appctx.Context, userID uuid.UUID) error {
+	conn := r.client.GetConn(ctx)
+
+	_, err := conn.TotpFactor.
+		Delete().
+		Where(totpfactor.UserIDEQ(userID)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+)
+
+// TOTPFactorRepository persists users' authenticator registrations. A user has at most one.
+type TOTPFactorRepository interface {
+	// FindByUserID returns domain.ErrMFANotEnrolled when the user has no factor.
+	FindByUserID(ctx *appctx.Context, userID uuid.UUID) (*domain.TOTPFactor, error)
+
+	// Save creates the user's factor or replaces the existing one.
+	Save(ctx *appctx.Context, factor *domain.TOTPFactor) (*domain.TOTPFactor, error)
+
+	Delete(ctx *appctx.Context, userID uuid.UUID) error
+}
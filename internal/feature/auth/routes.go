@@ -4,33 +4,64 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/auth/contract"
 	"github.com/umardev500/laundry/internal/feature/auth/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions contract.SessionService
 }
 
-// Ensure Routes implements the RouteRegistrar interface
-var _ router.RouteRegistrar = (*Routes)(nil)
+// Ensure Routes implements the RouteRegistrar interfaces
+var (
+	_ router.RouteRegistrar     = (*Routes)(nil)
+	_ router.RootRouteRegistrar = (*Routes)(nil)
+)
+
+// RegisterRootRoutes implements router.RootRouteRegistrar.
+func (r *Routes) RegisterRootRoutes(router fiber.Router) {
+	router.Get("/.well-known/jwks.json", r.handler.JWKS)
+}
 
 // RegisterRoutes implements router.RouteRegistrar.
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	auth := router.Group("auth")
 
-	// Login endpoint
+	// Public endpoints
+	auth.Post("/register", r.handler.Register)
+	auth.Get("/verify", r.handler.VerifyEmail)
+	auth.Post("/verify/resend", r.handler.ResendVerification)
 	auth.Post("/login", r.handler.Login)
+	auth.Post("/refresh", r.handler.Refresh)
+	auth.Post("/switch-tenant", r.handler.SwitchTenant)
+	auth.Post("/password/forgot", r.handler.ForgotPassword)
+	auth.Post("/password/reset", r.handler.ResetPassword)
+	auth.Post("/mfa/verify", r.handler.VerifyMFA)
+
+	// Session management
+	auth.Use(middleware.CheckAuth(r.keys, r.sessions))
+	auth.Post("/logout", r.handler.Logout)
+	auth.Post("/logout-all", r.handler.LogoutAll)
+	auth.Get("/sessions", r.handler.ListSessions)
+	auth.Delete("/sessions/:id", r.handler.RevokeSession)
+	auth.Get("/tenants", r.handler.ListTenants)
+	auth.Post("/password/change", r.handler.ChangePassword)
 
-	// Add more auth routes here later (refresh token, logout, etc.)
-	auth.Use(middleware.CheckAuth(r.config))
+	// Second factor
+	auth.Post("/mfa/totp", r.handler.EnrollTOTP)
+	auth.Post("/mfa/totp/confirm", r.handler.ConfirmTOTP)
+	auth.Delete("/mfa/totp", r.handler.DisableTOTP)
+	auth.Post("/mfa/recovery-codes", r.handler.RegenerateRecoveryCodes)
 }
 
 // NewRoutes returns a new Routes instance
-func NewRoutes(handler *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(handler *handler.Handler, keys *jwtkeys.KeySet, sessions contract.SessionService) *Routes {
 	return &Routes{
-		handler: handler,
-		config:  config,
+		handler:  handler,
+		keys:     keys,
+		sessions: sessions,
 	}
 }
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/auth/contract"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/feature/auth/repository"
+	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/types"
+
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+)
+
+// Defaults used when config.Login leaves a value unset.
+const (
+	defaultMaxLoginAttempts      = 5
+	defaultMaxLoginAttemptsPerIP = 20
+	defaultLoginWindow           = 15 * time.Minute
+	defaultLockout               = 15 * time.Minute
+	defaultBackoffBase           = time.Second
+	defaultBackoffMax            = time.Minute
+)
+
+type loginGuard struct {
+	config      *config.Config
+	throttle    repository.LoginThrottleRepository
+	attempts    repository.LoginAttemptRepository
+	userService userContract.Service
+}
+
+func NewLoginGuard(
+	config *config.Config,
+	throttle repository.LoginThrottleRepository,
+	attempts repository.LoginAttemptRepository,
+	userService userContract.Service,
+) contract.LoginGuard {
+	return &loginGuard{
+		config:      config,
+		throttle:    throttle,
+		attempts:    attempts,
+		userService: userService,
+	}
+}
+
+// Check implements contract.LoginGuard.
+func (g *loginGuard) Check(ctx *appctx.Context, email, ipAddress string) error {
+	return g.check(ctx, emailSubject(email), ipAddress)
+}
+
+// Fail implements contract.LoginGuard.
+func (g *loginGuard) Fail(ctx *appctx.Context, attempt *domain.LoginAttempt) error {
+	if _, err := g.attempts.Create(ctx, attempt); err != nil {
+		return err
+	}
+
+	// Attempts against a locked account are recorded but do not extend the lock.
+	if attempt.Reason == types.LoginFailureAccountLocked {
+		return nil
+	}
+
+	return g.fail(ctx, attempt, emailSubject(attempt.Email))
+}
+
+// Succeed implements contract.LoginGuard.
+func (g *loginGuard) Succeed(ctx *appctx.Context, email string) error {
+	return g.throttle.Reset(ctx, emailSubject(email))
+}
+
+// CheckMFA implements contract.LoginGuard.
+func (g *loginGuard) CheckMFA(ctx *appctx.Context, userID uuid.UUID, ipAddress string) error {
+	return g.check(ctx, mfaSubject(userID), ipAddress)
+}
+
+// FailMFA implements contract.LoginGuard.
+func (g *loginGuard) FailMFA(ctx *appctx.Context, attempt *domain.LoginAttempt) error {
+	if _, err := g.attempts.Create(ctx, attempt); err != nil {
+		return err
+	}
+
+	return g.fail(ctx, attempt, mfaSubject(*attempt.UserID))
+}
+
+// SucceedMFA implements contract.LoginGuard.
+func (g *loginGuard) SucceedMFA(ctx *appctx.Context, userID uuid.UUID) error {
+	return g.throttle.Reset(ctx, mfaSubject(userID))
+}
+
+// --- Internal helper ---
+
+// check rejects the attempt while the subject or the IP is backing off, or
+// the IP has reached its failure limit.
+func (g *loginGuard) check(ctx *appctx.Context, subject, ipAddress string) error {
+	subjects := []string{subject}
+	if ipAddress != "" {
+		subjects = append(subjects, ipSubject(ipAddress))
+	}
+
+	for _, subject := range subjects {
+		wait, err := g.throttle.Backoff(ctx, subject)
+		if err != nil {
+			return err
+		}
+		if wait > 0 {
+			return &domain.RetryAfterError{Err: domain.ErrTooManyAttempts, RetryAfter: wait}
+		}
+	}
+
+	if ipAddress == "" {
+		return nil
+	}
+
+	count, wait, err := g.throttle.Failures(ctx, ipSubject(ipAddress))
+	if err != nil {
+		return err
+	}
+	if count >= int64(g.maxAttemptsPerIP()) {
+		return &domain.RetryAfterError{Err: domain.ErrTooManyAttempts, RetryAfter: wait}
+	}
+
+	return nil
+}
+
+// fail counts the attempt against its IP and the subject, and locks the
+// matched user once the subject reaches the limit.
+func (g *loginGuard) fail(ctx *appctx.Context, attempt *domain.LoginAttempt, subject string) error {
+	if attempt.IPAddress != "" {
+		if _, err := g.addFailure(ctx, ipSubject(attempt.IPAddress)); err != nil {
+			return err
+		}
+	}
+
+	count, err := g.addFailure(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	if attempt.UserID == nil || count < int64(g.maxAttempts()) {
+		return nil
+	}
+
+	lockout := g.lockout()
+	if _, err := g.userService.Lock(ctx, *attempt.UserID, time.Now().UTC().Add(lockout)); err != nil {
+		// Only active accounts can be locked; authenticate refuses every other
+		// status even with the right password.
+		if errorsx.IsInvalidTransitionErr[types.UserStatus](err) {
+			return nil
+		}
+		return err
+	}
+
+	// The lock replaces the backoff; an admin unlock starts from a clean slate.
+	if err := g.throttle.Reset(ctx, subject); err != nil {
+		return err
+	}
+
+	return &domain.RetryAfterError{Err: domain.ErrAccountLocked, RetryAfter: lockout}
+}
+
+// addFailure counts a failure and blocks the subject for an exponentially growing delay.
+func (g *loginGuard) addFailure(ctx *appctx.Context, subject string) (int64, error) {
+	count, err := g.throttle.AddFailure(ctx, subject, g.window())
+	if err != nil {
+		return 0, err
+	}
+
+	if err := g.throttle.SetBackoff(ctx, subject, g.backoff(count)); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// backoff returns base * 2^(failures-1), capped at the configured maximum.
+func (g *loginGuard) backoff(failures int64) time.Duration {
+	base, limit := g.backoffBase(), g.backoffMax()
+
+	delay := base
+	for i := int64(1); i < failures && delay < limit; i++ {
+		delay *= 2
+	}
+
+	return min(delay, limit)
+}
+
+func (g *loginGuard) maxAttempts() int {
+	return orDefault(g.config.Login.MaxAttempts, defaultMaxLoginAttempts)
+}
+
+func (g *loginGuard) maxAttemptsPerIP() int {
+	return orDefault(g.config.Login.MaxAttemptsPerIP, defaultMaxLoginAttemptsPerIP)
+}
+
+func (g *loginGuard) window() time.Duration {
+	return secondsOrDefault(g.config.Login.WindowSeconds, defaultLoginWindow)
+}
+
+func (g *loginGuard) lockout() time.Duration {
+	return secondsOrDefault(g.config.Login.LockoutSeconds, defaultLockout)
+}
+
+func (g *loginGuard) backoffBase() time.Duration {
+	return secondsOrDefault(g.config.Login.BackoffBaseSeconds, defaultBackoffBase)
+}
+
+func (g *loginGuard) backoffMax() time.Duration {
+	return secondsOrDefault(g.config.Login.BackoffMaxSeconds, defaultBackoffMax)
+}
+
+func emailSubject(email string) string {
+	return "email:" + email
+}
+
+func ipSubject(ip string) string {
+	return "ip:" + ip
+}
+
+func mfaSubject(userID uuid.UUID) string {
+	return "mfa:" + userID.String()
+}
+
+func orDefault(v, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+func secondsOrDefault(seconds int64, def time.Duration) time.Duration {
+	if seconds <= 0 {
+		return def
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,399 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/auth/contract"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/feature/auth/repository"
+	"github.com/umardev500/laundry/pkg/security"
+	"github.com/umardev500/laundry/pkg/types"
+
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+const (
+	// mfaChallengeTTL bounds how long the second login step may take.
+	mfaChallengeTTL     = 5 * time.Minute
+	mfaChallengeBytes   = 32
+	maxMFAAttempts      = 5
+	recoveryCodeCount   = 10
+	defaultMFAIssuer    = "Laundry"
+	recoveryCodeEntropy = 7 // bytes, enough for 10 base32 characters
+)
+
+type mfaService struct {
+	config         *config.Config
+	factorRepo     repository.TOTPFactorRepository
+	challengeRepo  repository.MFAChallengeRepository
+	sessionService contract.SessionService
+	loginGuard     contract.LoginGuard
+	userService    userContract.Service
+	roleService    rbacContract.Service
+}
+
+func NewMFAService(
+	config *config.Config,
+	factorRepo repository.TOTPFactorRepository,
+	challengeRepo repository.MFAChallengeRepository,
+	sessionService contract.SessionService,
+	loginGuard contract.LoginGuard,
+	userService userContract.Service,
+	roleService rbacContract.Service,
+) contract.MFAService {
+	return &mfaService{
+		config:         config,
+		factorRepo:     factorRepo,
+		challengeRepo:  challengeRepo,
+		sessionService: sessionService,
+		loginGuard:     loginGuard,
+		userService:    userService,
+		roleService:    roleService,
+	}
+}
+
+// Enroll implements contract.MFAService.
+func (s *mfaService) Enroll(ctx *appctx.Context) (*domain.TOTPEnrollment, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	factor, err := s.findFactor(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+	if factor != nil && factor.IsEnabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	return s.startEnrollment(ctx, *userID)
+}
+
+// Confirm implements contract.MFAService.
+func (s *mfaService) Confirm(ctx *appctx.Context, code string) ([]string, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	factor, err := s.factorRepo.FindByUserID(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+	if factor.IsEnabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	now := time.Now().UTC()
+	if !factor.VerifyCode(code, now) {
+		return nil, domain.ErrInvalidMFACode
+	}
+
+	factor.Confirm(now)
+	return s.saveWithRecoveryCodes(ctx, factor)
+}
+
+// RegenerateRecoveryCodes implements contract.MFAService.
+func (s *mfaService) RegenerateRecoveryCodes(ctx *appctx.Context, code string) ([]string, error) {
+	factor, err := s.enabledFactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !factor.VerifyCode(code, time.Now().UTC()) {
+		return nil, domain.ErrInvalidMFACode
+	}
+
+	return s.saveWithRecoveryCodes(ctx, factor)
+}
+
+// Disable implements contract.MFAService.
+func (s *mfaService) Disable(ctx *appctx.Context, code string) error {
+	factor, err := s.enabledFactor(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !factor.VerifyCode(code, time.Now().UTC()) && !factor.UseRecoveryCode(code) {
+		return domain.ErrInvalidMFACode
+	}
+
+	return s.factorRepo.Delete(ctx, factor.UserID)
+}
+
+// Challenge implements contract.MFAService.
+func (s *mfaService) Challenge(ctx *appctx.Context, login *domain.PendingLogin, roleID *uuid.UUID) (*domain.MFAChallenge, error) {
+	factor, err := s.findFactor(ctx, login.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollment *domain.TOTPEnrollment
+	if factor == nil || !factor.IsEnabled() {
+		required, err := s.required(ctx, login.Scope, roleID)
+		if err != nil || !required {
+			return nil, err
+		}
+
+		// The user has to register an authenticator before the login can finish.
+		enrollment, err = s.startEnrollment(ctx, login.UserID)
+		if err != nil {
+			return nil, err
+		}
+		login.Enroll = true
+	}
+
+	token, err := security.RandomToken(mfaChallengeBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.challengeRepo.Save(ctx, security.HashToken(token), login, mfaChallengeTTL); err != nil {
+		return nil, err
+	}
+
+	return &domain.MFAChallenge{
+		Token:      token,
+		ExpiresAt:  time.Now().UTC().Add(mfaChallengeTTL),
+		Enrollment: enrollment,
+	}, nil
+}
+
+// Verify implements contract.MFAService.
+func (s *mfaService) Verify(ctx *appctx.Context, challengeToken, code string, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	tokenHash := security.HashToken(challengeToken)
+
+	login, err := s.challengeRepo.Find(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.loginGuard.CheckMFA(ctx, login.UserID, client.IPAddress); err != nil {
+		return nil, err
+	}
+
+	// Count the attempt before looking at the code, so concurrent guesses
+	// cannot share one attempt.
+	attempts, err := s.challengeRepo.AddAttempt(ctx, tokenHash, mfaChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	if attempts > maxMFAAttempts {
+		return nil, s.dropChallenge(ctx, tokenHash, domain.ErrInvalidMFAChallenge)
+	}
+
+	// The account may have been locked or suspended since the password step.
+	now := time.Now().UTC()
+	user, err := s.userService.GetByID(ctx, login.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsLocked(now) {
+		return nil, s.dropChallenge(ctx, tokenHash, lockedError(user, now))
+	}
+	if err := checkActive(user); err != nil {
+		return nil, s.dropChallenge(ctx, tokenHash, err)
+	}
+
+	factor, err := s.factorRepo.FindByUserID(ctx, login.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFANotEnrolled) {
+			return nil, domain.ErrInvalidMFAChallenge
+		}
+		return nil, err
+	}
+
+	// Recovery codes only exist once the factor is confirmed.
+	if !factor.VerifyCode(code, now) && (login.Enroll || !factor.UseRecoveryCode(code)) {
+		return nil, s.failChallenge(ctx, tokenHash, attempts, user, login, client)
+	}
+
+	// Only one request may complete the challenge.
+	deleted, err := s.challengeRepo.Delete(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, domain.ErrInvalidMFAChallenge
+	}
+
+	if err := s.loginGuard.SucceedMFA(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if login.Enroll {
+		factor.Confirm(now)
+		if recoveryCodes, err = s.saveWithRecoveryCodes(ctx, factor); err != nil {
+			return nil, err
+		}
+	} else if _, err := s.factorRepo.Save(ctx, factor); err != nil {
+		return nil, err
+	}
+
+	result, err := startSession(ctx, s.sessionService, login, client)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RecoveryCodes = recoveryCodes
+	return result, nil
+}
+
+// --- Internal helper ---
+
+// findFactor returns the user's factor, or nil if there is none.
+func (s *mfaService) findFactor(ctx *appctx.Context, userID uuid.UUID) (*domain.TOTPFactor, error) {
+	factor, err := s.factorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFANotEnrolled) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return factor, nil
+}
+
+// enabledFactor returns the signed-in user's confirmed factor.
+func (s *mfaService) enabledFactor(ctx *appctx.Context) (*domain.TOTPFactor, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	factor, err := s.factorRepo.FindByUserID(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+	if !factor.IsEnabled() {
+		return nil, domain.ErrMFANotEnrolled
+	}
+
+	return factor, nil
+}
+
+// startEnrollment stores a new unconfirmed factor, replacing any earlier unconfirmed one.
+func (s *mfaService) startEnrollment(ctx *appctx.Context, userID uuid.UUID) (*domain.TOTPEnrollment, error) {
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := domain.NewTOTPFactor(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.factorRepo.Save(ctx, factor); err != nil {
+		return nil, err
+	}
+
+	return &domain.TOTPEnrollment{
+		Secret: factor.Secret,
+		URI:    security.TOTPURI(s.issuer(), user.Email, factor.Secret),
+	}, nil
+}
+
+// required reports whether the scope or the tenant role enforces a second factor.
+func (s *mfaService) required(ctx *appctx.Context, scope appctx.Scope, roleID *uuid.UUID) (bool, error) {
+	if slices.Contains(s.config.MFA.RequiredScopes, string(scope)) {
+		return true, nil
+	}
+
+	if roleID == nil {
+		return false, nil
+	}
+
+	role, err := s.roleService.GetByID(ctx, *roleID)
+	if err != nil {
+		return false, err
+	}
+
+	return role.MFARequired, nil
+}
+
+// failChallenge records a wrong code against the user's account and drops the
+// challenge once it has no attempts left or the account gets locked.
+func (s *mfaService) failChallenge(
+	ctx *appctx.Context,
+	tokenHash string,
+	attempts int64,
+	user *userDomain.User,
+	login *domain.PendingLogin,
+	client domain.ClientInfo,
+) error {
+	attempt := domain.NewLoginAttempt(user.Email, login.Scope, client)
+	attempt.UserID = &user.ID
+	attempt.Reason = types.LoginFailureInvalidMFACode
+
+	if err := s.loginGuard.FailMFA(ctx, attempt); err != nil {
+		if errors.Is(err, domain.ErrAccountLocked) {
+			return s.dropChallenge(ctx, tokenHash, err)
+		}
+		return err
+	}
+
+	if attempts >= maxMFAAttempts {
+		return s.dropChallenge(ctx, tokenHash, domain.ErrInvalidMFACode)
+	}
+
+	return domain.ErrInvalidMFACode
+}
+
+// dropChallenge deletes the challenge and returns reason, unless the delete fails.
+func (s *mfaService) dropChallenge(ctx *appctx.Context, tokenHash string, reason error) error {
+	if _, err := s.challengeRepo.Delete(ctx, tokenHash); err != nil {
+		return err
+	}
+	return reason
+}
+
+// saveWithRecoveryCodes issues new recovery codes, stores the factor and returns the plain codes.
+func (s *mfaService) saveWithRecoveryCodes(ctx *appctx.Context, factor *domain.TOTPFactor) ([]string, error) {
+	codes, err := newRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	factor.SetRecoveryCodes(codes)
+	if _, err := s.factorRepo.Save(ctx, factor); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (s *mfaService) issuer() string {
+	if s.config.MFA.Issuer == "" {
+		return defaultMFAIssuer
+	}
+	return s.config.MFA.Issuer
+}
+
+// newRecoveryCodes returns n random codes formatted as xxxxx-xxxxx.
+func newRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, recoveryCodeEntropy)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+
+		raw := strings.ToLower(base32.StdEncoding.EncodeToString(b))[:10]
+		codes[i] = raw[:5] + "-" + raw[5:]
+	}
+	return codes, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/auth/contract"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/feature/auth/repository"
+	"github.com/umardev500/laundry/internal/infra/mailer"
+	"github.com/umardev500/laundry/pkg/security"
+	"github.com/umardev500/laundry/pkg/types"
+
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+const (
+	// passwordResetTTL bounds how long a reset token stays valid.
+	passwordResetTTL        = 30 * time.Minute
+	passwordResetTokenBytes = 32
+)
+
+type passwordService struct {
+	config         *config.Config
+	resetRepo      repository.PasswordResetRepository
+	userService    userContract.Service
+	sessionService contract.SessionService
+	mailer         mailer.Mailer
+}
+
+func NewPasswordService(
+	config *config.Config,
+	resetRepo repository.PasswordResetRepository,
+	userService userContract.Service,
+	sessionService contract.SessionService,
+	mailer mailer.Mailer,
+) contract.PasswordService {
+	return &passwordService{
+		config:         config,
+		resetRepo:      resetRepo,
+		userService:    userService,
+		sessionService: sessionService,
+		mailer:         mailer,
+	}
+}
+
+// Forgot implements contract.PasswordService.
+func (s *passwordService) Forgot(ctx *appctx.Context, email string) error {
+	user, err := s.userService.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if user.Status != types.UserStatusActive {
+		return nil
+	}
+
+	token, err := security.RandomToken(passwordResetTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	if err := s.resetRepo.Save(ctx, security.HashToken(token), user.ID, passwordResetTTL); err != nil {
+		return err
+	}
+
+	link := s.config.Email.PasswordResetURL + "?token=" + url.QueryEscape(token)
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      []string{user.Email},
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"We received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If you did not ask for this, you can ignore this email.\n",
+			link, passwordResetTTL,
+		),
+	})
+}
+
+// Reset implements contract.PasswordService.
+func (s *passwordService) Reset(ctx *appctx.Context, token, password string) error {
+	userID, err := s.resetRepo.Consume(ctx, security.HashToken(token))
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return domain.ErrInvalidResetToken
+	}
+	if user.Status != types.UserStatusActive {
+		return domain.ErrInvalidResetToken
+	}
+
+	return s.setPassword(ctx, user.ID, password)
+}
+
+// Change implements contract.PasswordService.
+func (s *passwordService) Change(ctx *appctx.Context, currentPassword, newPassword string) error {
+	userID := ctx.UserID()
+	if userID == nil {
+		return domain.ErrInvalidCredentials
+	}
+
+	user, err := s.userService.GetByID(ctx, *userID)
+	if err != nil {
+		return err
+	}
+
+	if !security.Compare(user.Password, currentPassword) {
+		return domain.ErrIncorrectPassword
+	}
+
+	return s.setPassword(ctx, user.ID, newPassword)
+}
+
+// --- Internal helper ---
+
+// setPassword stores the new password hash and signs the user out everywhere.
+func (s *passwordService) setPassword(ctx *appctx.Context, userID uuid.UUID, password string) error {
+	hashed, err := security.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.userService.Update(ctx, &userDomain.User{
+		ID:       userID,
+		Password: hashed,
+	}); err != nil {
+		return err
+	}
+
+	return s.sessionService.LogoutAll(ctx.WithUserID(&userID))
+}
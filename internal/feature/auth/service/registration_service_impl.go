@@ -0,0 +1,212 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/rs/zerolog/log"
+
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/auth/contract"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+	"github.com/umardev500/laundry/internal/infra/mailer"
+	"github.com/umardev500/laundry/pkg/security"
+	"github.com/umardev500/laundry/pkg/types"
+
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+const (
+	// purposeClaim keeps verification tokens from being accepted anywhere else.
+	purposeClaim       = "purpose"
+	emailClaim         = "email"
+	verifyEmailPurpose = "verify_email"
+
+	defaultVerificationTTL = 24 * time.Hour
+)
+
+type registrationService struct {
+	config      *config.Config
+	keys        *jwtkeys.KeySet
+	userService userContract.Service
+	mailer      mailer.Mailer
+}
+
+func NewRegistrationService(
+	config *config.Config,
+	keys *jwtkeys.KeySet,
+	userService userContract.Service,
+	mailer mailer.Mailer,
+) contract.RegistrationService {
+	return &registrationService{
+		config:      config,
+		keys:        keys,
+		userService: userService,
+		mailer:      mailer,
+	}
+}
+
+// Register implements contract.RegistrationService.
+func (s *registrationService) Register(ctx *appctx.Context, email, password string) (*userDomain.User, error) {
+	hashed, err := security.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.Create(ctx, &userDomain.User{
+		Email:    normalizeEmail(email),
+		Password: hashed,
+		Status:   types.UserStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The account is already stored; a failed delivery can be retried via ResendVerification.
+	if err := s.sendVerification(ctx, user); err != nil {
+		log.Error().Err(err).Str("user_id", user.ID.String()).Msg("Failed to send verification email")
+	}
+
+	return user, nil
+}
+
+// Verify implements contract.RegistrationService.
+func (s *registrationService) Verify(ctx *appctx.Context, token string) (*userDomain.User, error) {
+	userID, email, err := s.parseVerificationToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrUserNotFound) || errors.Is(err, userDomain.ErrUserDeleted) {
+			return nil, domain.ErrInvalidVerificationToken
+		}
+		return nil, err
+	}
+
+	// A changed email invalidates links sent to the old address.
+	if user.Email != email {
+		return nil, domain.ErrInvalidVerificationToken
+	}
+
+	switch user.Status {
+	case types.UserStatusActive:
+		return user, nil
+	case types.UserStatusPending:
+		return s.userService.UpdateStatus(ctx, &userDomain.User{
+			ID:     user.ID,
+			Status: types.UserStatusActive,
+		})
+	default:
+		return nil, domain.ErrInvalidVerificationToken
+	}
+}
+
+// ResendVerification implements contract.RegistrationService.
+func (s *registrationService) ResendVerification(ctx *appctx.Context, email string) error {
+	user, err := s.userService.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if user.Status != types.UserStatusPending {
+		return nil
+	}
+
+	return s.sendVerification(ctx, user)
+}
+
+// --- Internal helper ---
+
+// sendVerification emails the user a link that activates their account.
+func (s *registrationService) sendVerification(ctx *appctx.Context, user *userDomain.User) error {
+	token, err := s.signVerificationToken(user)
+	if err != nil {
+		return err
+	}
+
+	link := strings.TrimRight(s.config.App.BaseURL, "/") + "/api/auth/verify?token=" + url.QueryEscape(token)
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      []string{user.Email},
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Welcome!\n\nConfirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			link, s.verificationTTL(),
+		),
+	})
+}
+
+// signVerificationToken creates a signed token binding the user to their current email.
+func (s *registrationService) signVerificationToken(user *userDomain.User) (string, error) {
+	now := time.Now().UTC()
+
+	token, err := jwt.NewBuilder().
+		Issuer(s.config.JWT.Issuer).
+		Subject(user.ID.String()).
+		IssuedAt(now).
+		Expiration(now.Add(s.verificationTTL())).
+		Claim(purposeClaim, verifyEmailPurpose).
+		Claim(emailClaim, user.Email).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("build verification token: %w", err)
+	}
+
+	signed, err := s.keys.Sign(token)
+	if err != nil {
+		return "", fmt.Errorf("sign verification token: %w", err)
+	}
+
+	return string(signed), nil
+}
+
+// parseVerificationToken validates the token and returns the user ID and email it was issued for.
+func (s *registrationService) parseVerificationToken(raw string) (uuid.UUID, string, error) {
+	token, err := s.keys.Parse([]byte(raw))
+	if err != nil {
+		return uuid.Nil, "", domain.ErrInvalidVerificationToken
+	}
+
+	var purpose string
+	if err := token.Get(purposeClaim, &purpose); err != nil || purpose != verifyEmailPurpose {
+		return uuid.Nil, "", domain.ErrInvalidVerificationToken
+	}
+
+	var email string
+	if err := token.Get(emailClaim, &email); err != nil {
+		return uuid.Nil, "", domain.ErrInvalidVerificationToken
+	}
+
+	sub, ok := token.Subject()
+	if !ok {
+		return uuid.Nil, "", domain.ErrInvalidVerificationToken
+	}
+
+	userID, err := uuid.Parse(sub)
+	if err != nil {
+		return uuid.Nil, "", domain.ErrInvalidVerificationToken
+	}
+
+	return userID, email, nil
+}
+
+func (s *registrationService) verificationTTL() time.Duration {
+	if s.config.Email.VerificationExpirySeconds <= 0 {
+		return defaultVerificationTTL
+	}
+	return time.Duration(s.config.Email.VerificationExpirySeconds) * time.Second
+}
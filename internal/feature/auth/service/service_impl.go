@@ -1,46 +1,58 @@
 package service
 
 import (
-	"fmt"
+	"errors"
 	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/lestrrat-go/jwx/v3/jwa"
-	"github.com/lestrrat-go/jwx/v3/jwt"
 
 	"github.com/umardev500/laundry/internal/app/appctx"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/auth/contract"
 	"github.com/umardev500/laundry/internal/feature/auth/domain"
 	"github.com/umardev500/laundry/internal/feature/auth/repository"
 	"github.com/umardev500/laundry/pkg/security"
+	"github.com/umardev500/laundry/pkg/types"
 
 	platformUserContract "github.com/umardev500/laundry/internal/feature/platformuser/contract"
 	tenantUserContract "github.com/umardev500/laundry/internal/feature/tenantuser/contract"
 	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+const (
+	// tenantSelectionTTL bounds how long a user has to pick a tenant.
+	tenantSelectionTTL  = 5 * time.Minute
+	selectionTokenBytes = 32
 )
 
 type serviceImpl struct {
-	config              *config.Config
+	sessionService      contract.SessionService
+	loginGuard          contract.LoginGuard
+	mfaService          contract.MFAService
+	selectionRepo       repository.TenantSelectionRepository
 	userService         userContract.Service
-	refreshTokenRepo    repository.RefreshTokenRepository
 	tenantUserService   tenantUserContract.Service
 	platformUserService platformUserContract.Service
 }
 
 func NewService(
-	cfg *config.Config,
+	sessionService contract.SessionService,
+	loginGuard contract.LoginGuard,
+	mfaService contract.MFAService,
+	selectionRepo repository.TenantSelectionRepository,
 	userService userContract.Service,
-	refreshTokenRepo repository.RefreshTokenRepository,
 	tenantService tenantUserContract.Service,
 	platformUserService platformUserContract.Service,
 ) contract.Service {
 	return &serviceImpl{
-		config:              cfg,
+		sessionService:      sessionService,
+		loginGuard:          loginGuard,
+		mfaService:          mfaService,
+		selectionRepo:       selectionRepo,
 		userService:         userService,
-		refreshTokenRepo:    refreshTokenRepo,
 		tenantUserService:   tenantService,
 		platformUserService: platformUserService,
 	}
@@ -48,8 +60,8 @@ func NewService(
 
 // --- Public login methods ---
 
-func (s *serviceImpl) LoginAdmin(ctx *appctx.Context, email, password string) (*domain.LoginResponse, error) {
-	return s.loginWithScope(ctx, email, password, appctx.ScopeAdmin, func(userID uuid.UUID) (map[string]any, error) {
+func (s *serviceImpl) LoginAdmin(ctx *appctx.Context, email, password string, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	return s.loginWithScope(ctx, email, password, client, appctx.ScopeAdmin, func(userID uuid.UUID) (map[string]any, error) {
 		pu, err := s.platformUserService.GetByUserID(ctx, userID)
 		if err != nil || pu == nil {
 			return nil, domain.ErrInvalidCredentials
@@ -58,43 +70,97 @@ func (s *serviceImpl) LoginAdmin(ctx *appctx.Context, email, password string) (*
 	})
 }
 
-func (s *serviceImpl) LoginTenant(ctx *appctx.Context, email, password string) (*domain.LoginResponse, error) {
-	return s.loginWithScope(ctx, email, password, appctx.ScopeTenant, func(userID uuid.UUID) (map[string]any, error) {
-		tenants, err := s.tenantUserService.GetByUser(ctx, userID)
-		if err != nil {
-			return nil, domain.ErrInvalidCredentials
-		}
-		if len(tenants) == 0 {
-			return nil, domain.ErrInvalidCredentials
-		}
-		if len(tenants) > 1 {
-			return nil, domain.ErrMultipleTenants
-		}
-		return map[string]any{
-			string(appctx.ContextKeyTenantID): tenants[0].TenantID.String(),
-		}, nil
-	})
+// LoginTenant signs the user in to their tenant. Users with several active
+// memberships get a selection token to pick one via SwitchTenant instead of tokens.
+func (s *serviceImpl) LoginTenant(ctx *appctx.Context, email, password string, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	user, err := s.authenticate(ctx, email, password, appctx.ScopeTenant, client)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.activeMemberships(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	if len(memberships) == 1 {
+		return s.begin(ctx, &domain.PendingLogin{
+			UserID: user.ID,
+			Scope:  appctx.ScopeTenant,
+			Claims: tenantClaims(memberships[0].TenantID),
+		}, memberships[0].RoleID, client)
+	}
+
+	selection, err := s.issueSelection(ctx, &domain.TenantSelectionGrant{UserID: user.ID}, memberships)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResponse{Selection: selection}, nil
 }
 
-func (s *serviceImpl) Login(ctx *appctx.Context, email, password string) (*domain.LoginResponse, error) {
-	return s.loginWithScope(ctx, email, password, appctx.ScopeUser, nil)
+// ListTenants implements contract.Service.
+func (s *serviceImpl) ListTenants(ctx *appctx.Context) (*domain.TenantSelection, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	memberships, err := s.activeMemberships(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueSelection(ctx, &domain.TenantSelectionGrant{
+		UserID:    *userID,
+		SessionID: ctx.SessionID(),
+	}, memberships)
+}
+
+// SwitchTenant implements contract.Service.
+func (s *serviceImpl) SwitchTenant(ctx *appctx.Context, selectionToken string, tenantID uuid.UUID, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	grant, err := s.selectionRepo.Consume(ctx, security.HashToken(selectionToken))
+	if err != nil {
+		return nil, err
+	}
+
+	// Membership may have been suspended or removed since the token was issued.
+	memberships, err := s.activeMemberships(ctx, grant.UserID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(memberships, func(m domain.TenantMembership) bool { return m.TenantID == tenantID })
+	if i < 0 {
+		return nil, domain.ErrTenantNotAllowed
+	}
+
+	// Switching from an existing session replaces it.
+	return s.begin(ctx, &domain.PendingLogin{
+		UserID:          grant.UserID,
+		Scope:           appctx.ScopeTenant,
+		Claims:          tenantClaims(tenantID),
+		ReplacesSession: grant.SessionID,
+	}, memberships[i].RoleID, client)
+}
+
+func (s *serviceImpl) Login(ctx *appctx.Context, email, password string, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	return s.loginWithScope(ctx, email, password, client, appctx.ScopeUser, nil)
 }
 
 // --- Internal helper ---
 
 type extraClaimsFunc func(userID uuid.UUID) (map[string]any, error)
 
-func (s *serviceImpl) loginWithScope(ctx *appctx.Context, email, password string, scope appctx.Scope, extraClaims extraClaimsFunc) (*domain.LoginResponse, error) {
-	email = normalizeEmail(email)
-
-	user, err := s.userService.GetByEmail(ctx, email)
-	if err != nil || !security.Compare(user.Password, password) {
-		return nil, domain.ErrInvalidCredentials
+func (s *serviceImpl) loginWithScope(ctx *appctx.Context, email, password string, client domain.ClientInfo, scope appctx.Scope, extraClaims extraClaimsFunc) (*domain.LoginResponse, error) {
+	user, err := s.authenticate(ctx, email, password, scope, client)
+	if err != nil {
+		return nil, err
 	}
 
-	claims := map[string]any{
-		string(appctx.ContextKeyScope): scope,
-	}
+	claims := map[string]any{}
 
 	if extraClaims != nil {
 		extra, err := extraClaims(user.ID)
@@ -105,63 +171,156 @@ func (s *serviceImpl) loginWithScope(ctx *appctx.Context, email, password string
 		maps.Copy(claims, extra)
 	}
 
-	accessToken, exp, err := s.buildJWT(user.ID.String(), claims)
+	return s.begin(ctx, &domain.PendingLogin{UserID: user.ID, Scope: scope, Claims: claims}, nil, client)
+}
+
+// begin starts the session, or returns an MFA challenge when a second factor
+// is enabled for the user or required for the scope or tenant role.
+func (s *serviceImpl) begin(ctx *appctx.Context, login *domain.PendingLogin, roleID *uuid.UUID, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	challenge, err := s.mfaService.Challenge(ctx, login, roleID)
 	if err != nil {
 		return nil, err
 	}
-
-	refreshToken, err := s.issueRefreshToken(ctx, user.ID.String())
-	if err != nil {
-		return nil, fmt.Errorf("issue refresh token: %w", err)
+	if challenge != nil {
+		return &domain.LoginResponse{MFA: challenge}, nil
 	}
 
-	return &domain.LoginResponse{
-		Tokens: domain.Tokens{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			ExpiresAt:    exp,
-		},
-	}, nil
+	return startSession(ctx, s.sessionService, login, client)
 }
 
-// buildJWT creates and signs a JWT token.
-func (s *serviceImpl) buildJWT(userID string, claims map[string]any) (string, time.Time, error) {
+// authenticate verifies the user's credentials. Failures are throttled and
+// recorded by the login guard, which may lock the account.
+func (s *serviceImpl) authenticate(ctx *appctx.Context, email, password string, scope appctx.Scope, client domain.ClientInfo) (*userDomain.User, error) {
+	email = normalizeEmail(email)
+	if err := s.loginGuard.Check(ctx, email, client.IPAddress); err != nil {
+		return nil, err
+	}
+
+	attempt := domain.NewLoginAttempt(email, scope, client)
 	now := time.Now().UTC()
-	exp := now.Add(time.Duration(s.config.JWT.ExpirySeconds) * time.Second)
 
-	builder := jwt.NewBuilder().
-		Issuer(s.config.JWT.Issuer).
-		Subject(userID).
-		IssuedAt(now).
-		Expiration(exp)
+	user, err := s.userService.GetByEmail(ctx, email)
+	if err == nil {
+		attempt.UserID = &user.ID
+
+		if user.IsLocked(now) {
+			attempt.Reason = types.LoginFailureAccountLocked
+			if err := s.loginGuard.Fail(ctx, attempt); err != nil {
+				return nil, err
+			}
+			return nil, lockedError(user, now)
+		}
+	}
+
+	if err != nil || !security.Compare(user.Password, password) {
+		if err := s.loginGuard.Fail(ctx, attempt); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	// The lock has run out, so the account is usable again.
+	if user.Status == types.UserStatusLocked {
+		user, err = s.userService.UpdateStatus(ctx, &userDomain.User{ID: user.ID, Status: types.UserStatusActive})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkActive(user); err != nil {
+		return nil, err
+	}
 
-	for k, v := range claims {
-		builder.Claim(k, v)
+	if err := s.loginGuard.Succeed(ctx, email); err != nil {
+		return nil, err
 	}
 
-	token, err := builder.Build()
+	return user, nil
+}
+
+// checkActive refuses users whose account may not sign in. Only active
+// accounts get tokens.
+func checkActive(user *userDomain.User) error {
+	switch user.Status {
+	case types.UserStatusActive:
+		return nil
+	case types.UserStatusPending:
+		return domain.ErrEmailNotVerified
+	default:
+		return domain.ErrUserInactive
+	}
+}
+
+// lockedError reports how long the user's account stays locked.
+func lockedError(user *userDomain.User, now time.Time) error {
+	var wait time.Duration
+	if user.LockedUntil != nil {
+		wait = user.LockedUntil.Sub(now)
+	}
+	return &domain.RetryAfterError{Err: domain.ErrAccountLocked, RetryAfter: wait}
+}
+
+// activeMemberships returns the tenants the user is an active member of.
+func (s *serviceImpl) activeMemberships(ctx *appctx.Context, userID uuid.UUID) ([]domain.TenantMembership, error) {
+	tenantUsers, err := s.tenantUserService.GetByUser(ctx, userID)
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("build jwt token: %w", err)
+		return nil, err
 	}
 
-	signed, err := jwt.Sign(token, jwt.WithKey(jwa.HS256(), []byte(s.config.JWT.Secret)))
+	memberships := make([]domain.TenantMembership, 0, len(tenantUsers))
+	for _, tu := range tenantUsers {
+		if tu.Status != types.TenantUserStatusActive {
+			continue
+		}
+		memberships = append(memberships, domain.TenantMembership{
+			TenantID: tu.TenantID,
+			RoleID:   tu.RoleID,
+		})
+	}
+
+	return memberships, nil
+}
+
+// issueSelection creates a single-use selection token for the given memberships.
+func (s *serviceImpl) issueSelection(ctx *appctx.Context, grant *domain.TenantSelectionGrant, memberships []domain.TenantMembership) (*domain.TenantSelection, error) {
+	token, err := security.RandomToken(selectionTokenBytes)
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("sign jwt token: %w", err)
+		return nil, err
 	}
 
-	return string(signed), exp, nil
+	if err := s.selectionRepo.Save(ctx, security.HashToken(token), grant, tenantSelectionTTL); err != nil {
+		return nil, err
+	}
+
+	return &domain.TenantSelection{
+		Token:     token,
+		ExpiresAt: time.Now().UTC().Add(tenantSelectionTTL),
+		Tenants:   memberships,
+	}, nil
 }
 
-// issueRefreshToken creates and persists a refresh token securely.
-func (s *serviceImpl) issueRefreshToken(ctx *appctx.Context, userID string) (string, error) {
-	rt := uuid.NewString()
-	now := time.Now().UTC()
-	exp := now.Add(time.Duration(s.config.JWT.RefreshTokenExpirySeconds) * time.Second)
+// startSession opens the session for a completed login and retires the session it replaces.
+func startSession(ctx *appctx.Context, sessions contract.SessionService, login *domain.PendingLogin, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	result, err := sessions.Start(ctx, login.UserID, login.Scope, login.Claims, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if login.ReplacesSession != nil {
+		ownerCtx := ctx.WithUserID(&login.UserID)
+		if err := sessions.Revoke(ownerCtx, *login.ReplacesSession); err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
 
-	if err := s.refreshTokenRepo.Set(ctx, userID, rt, exp.Sub(now)); err != nil {
-		return "", err
+// tenantClaims returns the access-token claims that scope a session to a tenant.
+func tenantClaims(tenantID uuid.UUID) map[string]any {
+	return map[string]any{
+		string(appctx.ContextKeyTenantID): tenantID.String(),
 	}
-	return rt, nil
 }
 
 // normalizeEmail lowercases and trims spaces.
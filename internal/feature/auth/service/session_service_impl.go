@@ -0,0 +1,290 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/auth/contract"
+	"github.com/umardev500/laundry/internal/feature/auth/domain"
+	"github.com/umardev500/laundry/internal/feature/auth/repository"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+	"github.com/umardev500/laundry/pkg/security"
+	"github.com/umardev500/laundry/pkg/types"
+
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+)
+
+// refreshTokenBytes is the entropy of an opaque refresh token.
+const refreshTokenBytes = 32
+
+type sessionService struct {
+	config      *config.Config
+	keys        *jwtkeys.KeySet
+	repo        repository.SessionRepository
+	denylist    repository.TokenDenylistRepository
+	userService userContract.Service
+}
+
+func NewSessionService(
+	cfg *config.Config,
+	keys *jwtkeys.KeySet,
+	repo repository.SessionRepository,
+	denylist repository.TokenDenylistRepository,
+	userService userContract.Service,
+) contract.SessionService {
+	return &sessionService{
+		config:      cfg,
+		keys:        keys,
+		repo:        repo,
+		denylist:    denylist,
+		userService: userService,
+	}
+}
+
+// Start implements contract.SessionService.
+func (s *sessionService) Start(ctx *appctx.Context, userID uuid.UUID, scope appctx.Scope, claims map[string]any, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	session := domain.NewSession(userID, scope, claims, client, s.refreshTTL())
+	return s.issue(ctx, session)
+}
+
+// Refresh implements contract.SessionService.
+func (s *sessionService) Refresh(ctx *appctx.Context, refreshToken string, client domain.ClientInfo) (*domain.LoginResponse, error) {
+	if refreshToken == "" {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	tokenHash := security.HashToken(refreshToken)
+
+	sessionID, err := s.repo.ConsumeRefreshToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			return nil, s.detectReuse(ctx, tokenHash)
+		}
+		return nil, err
+	}
+
+	session, err := s.repo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	// Users suspended or removed since login must not keep refreshing.
+	user, err := s.userService.GetByID(ctx, session.UserID)
+	if err != nil || user.Status != types.UserStatusActive {
+		if err := s.revoke(ctx, session); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	if client.UserAgent != "" {
+		session.UserAgent = client.UserAgent
+	}
+	if client.IPAddress != "" {
+		session.IPAddress = client.IPAddress
+	}
+
+	return s.issue(ctx, session)
+}
+
+// Logout implements contract.SessionService.
+func (s *sessionService) Logout(ctx *appctx.Context) error {
+	sessionID := ctx.SessionID()
+	if sessionID == nil {
+		return domain.ErrMissingSession
+	}
+
+	// Always deny the presented access token, even if its session is already gone.
+	if tokenID := ctx.TokenID(); tokenID != "" {
+		if err := s.denylist.Add(ctx, tokenID, s.accessTTL()); err != nil {
+			return err
+		}
+	}
+
+	session, err := s.repo.FindByID(ctx, *sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return s.revoke(ctx, session)
+}
+
+// LogoutAll implements contract.SessionService.
+func (s *sessionService) LogoutAll(ctx *appctx.Context) error {
+	sessions, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	if tokenID := ctx.TokenID(); tokenID != "" {
+		if err := s.denylist.Add(ctx, tokenID, s.accessTTL()); err != nil {
+			return err
+		}
+	}
+
+	for _, session := range sessions {
+		if err := s.revoke(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// List implements contract.SessionService.
+func (s *sessionService) List(ctx *appctx.Context) ([]*domain.Session, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	return s.repo.ListByUser(ctx, *userID)
+}
+
+// Revoke implements contract.SessionService.
+func (s *sessionService) Revoke(ctx *appctx.Context, sessionID uuid.UUID) error {
+	userID := ctx.UserID()
+	if userID == nil {
+		return domain.ErrInvalidCredentials
+	}
+
+	session, err := s.repo.FindByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if !session.BelongsTo(*userID) {
+		return domain.ErrSessionNotFound
+	}
+
+	return s.revoke(ctx, session)
+}
+
+// IsTokenRevoked implements contract.SessionService.
+func (s *sessionService) IsTokenRevoked(ctx *appctx.Context, tokenID string) (bool, error) {
+	return s.denylist.Exists(ctx, tokenID)
+}
+
+// IsSessionActive implements contract.SessionService.
+func (s *sessionService) IsSessionActive(ctx *appctx.Context, sessionID uuid.UUID) (bool, error) {
+	return s.repo.Exists(ctx, sessionID)
+}
+
+// --- Internal helpers ---
+
+// issue signs a new access token and rotates the session's refresh token.
+func (s *sessionService) issue(ctx *appctx.Context, session *domain.Session) (*domain.LoginResponse, error) {
+	claims := map[string]any{
+		string(appctx.ContextKeyScope):   session.Scope,
+		string(appctx.ContextKeySession): session.ID.String(),
+	}
+	maps.Copy(claims, session.Claims)
+
+	tokenID := uuid.NewString()
+	accessToken, exp, err := s.buildJWT(session.UserID.String(), tokenID, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := security.RandomToken(refreshTokenBytes)
+	if err != nil {
+		return nil, fmt.Errorf("issue refresh token: %w", err)
+	}
+
+	session.Rotate(security.HashToken(refreshToken), tokenID, s.refreshTTL())
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, fmt.Errorf("save session: %w", err)
+	}
+
+	return &domain.LoginResponse{
+		Tokens: &domain.Tokens{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			ExpiresAt:    exp,
+		},
+	}, nil
+}
+
+// detectReuse revokes the session a rotated refresh token belonged to. A replayed
+// token means it leaked, so neither the attacker nor the victim keeps the family.
+func (s *sessionService) detectReuse(ctx *appctx.Context, tokenHash string) error {
+	sessionID, err := s.repo.FindRotatedRefreshToken(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.repo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return domain.ErrInvalidRefreshToken
+		}
+		return err
+	}
+
+	if err := s.revoke(ctx, session); err != nil {
+		return err
+	}
+
+	return domain.ErrRefreshTokenReused
+}
+
+// revoke deletes the session and denies its latest access token.
+func (s *sessionService) revoke(ctx *appctx.Context, session *domain.Session) error {
+	if session.AccessTokenID != "" {
+		if err := s.denylist.Add(ctx, session.AccessTokenID, s.accessTTL()); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.Delete(ctx, session)
+}
+
+// buildJWT creates and signs a JWT token.
+func (s *sessionService) buildJWT(userID, tokenID string, claims map[string]any) (string, time.Time, error) {
+	now := time.Now().UTC()
+	exp := now.Add(s.accessTTL())
+
+	builder := jwt.NewBuilder().
+		Issuer(s.config.JWT.Issuer).
+		Subject(userID).
+		JwtID(tokenID).
+		IssuedAt(now).
+		Expiration(exp)
+
+	for k, v := range claims {
+		builder.Claim(k, v)
+	}
+
+	token, err := builder.Build()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("build jwt token: %w", err)
+	}
+
+	signed, err := s.keys.Sign(token)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign jwt token: %w", err)
+	}
+
+	return string(signed), exp, nil
+}
+
+func (s *sessionService) accessTTL() time.Duration {
+	return time.Duration(s.config.JWT.ExpirySeconds) * time.Second
+}
+
+func (s *sessionService) refreshTTL() time.Duration {
+	return time.Duration(s.config.JWT.RefreshTokenExpirySeconds) * time.Second
+}
@@ -0,0 +1,39 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/internal/feature/cashshift/query"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Service defines the business logic for cash drawer shifts.
+type Service interface {
+	// Open starts a shift for the staff member in context.
+	Open(ctx *appctx.Context, s *domain.CashShift) (*domain.CashShift, error)
+
+	// Close counts out the open shift of the staff member in context and
+	// stores how far the drawer is off from the expected cash.
+	Close(ctx *appctx.Context, denominations []types.CashDenomination, note *string) (*domain.CashShift, error)
+
+	// Current returns the open shift of the staff member in context.
+	Current(ctx *appctx.Context) (*domain.CashShift, error)
+
+	// OpenShiftID returns the open shift of the staff member in context. Cash
+	// payments and refunds are booked to it, so they are refused with
+	// domain.ErrNoOpenCashShift when the staff member has none.
+	OpenShiftID(ctx *appctx.Context) (uuid.UUID, error)
+
+	// GetByID retrieves a cash shift by its ID
+	GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.CashShift, error)
+
+	// List retrieves paginated cash shifts with filters
+	List(ctx *appctx.Context, q *query.ListCashShiftQuery) (*pagination.PageData[domain.CashShift], error)
+
+	// ZReport summarizes the takings and drawers of the tenant in context for the day starting at day.
+	ZReport(ctx *appctx.Context, day time.Time) (*domain.ZReport, error)
+}
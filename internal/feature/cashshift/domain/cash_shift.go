@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// CashShift is one staff member's turn at the cash drawer, from counting in
+// the opening float to counting the drawer out.
+type CashShift struct {
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+	UserID        uuid.UUID
+	Status        types.CashShiftStatus
+	OpeningFloat  money.Money
+	OpeningNote   *string
+	CashSales     money.Money
+	CashRefunds   money.Money
+	ExpectedCash  *money.Money
+	CountedCash   *money.Money
+	Discrepancy   *money.Money
+	Denominations []types.CashDenomination
+	ClosingNote   *string
+	OpenedAt      time.Time
+	ClosedAt      *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// -------------------------
+// Actions / Mutations
+// -------------------------
+
+// Open starts the shift with the cash already in the drawer.
+func (s *CashShift) Open() error {
+	if s.OpeningFloat < 0 {
+		return ErrInvalidOpeningFloat
+	}
+
+	s.Status = types.CashShiftStatusOpen
+	s.OpenedAt = time.Now()
+	return nil
+}
+
+// ApplyTotals records the cash taken and paid out during the shift and the
+// cash the drawer should therefore hold.
+func (s *CashShift) ApplyTotals(sales, refunds money.Money) {
+	expected := s.OpeningFloat + sales - refunds
+
+	s.CashSales = sales
+	s.CashRefunds = refunds
+	s.ExpectedCash = &expected
+}
+
+// Close counts the drawer out and stores how far it is off from the
+// expected cash. ApplyTotals must be called first.
+func (s *CashShift) Close(denominations []types.CashDenomination, note *string) error {
+	if s.Status != types.CashShiftStatusOpen {
+		return ErrCashShiftClosed
+	}
+
+	counted, err := CountCash(denominations)
+	if err != nil {
+		return err
+	}
+
+	var expected money.Money
+	if s.ExpectedCash != nil {
+		expected = *s.ExpectedCash
+	}
+	discrepancy := counted - expected
+
+	now := time.Now()
+	s.Status = types.CashShiftStatusClosed
+	s.Denominations = denominations
+	s.CountedCash = &counted
+	s.Discrepancy = &discrepancy
+	s.ClosingNote = note
+	s.ClosedAt = &now
+	s.UpdatedAt = now
+	return nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// IsOpen reports whether the drawer is still in use.
+func (s *CashShift) IsOpen() bool {
+	return s.Status == types.CashShiftStatusOpen
+}
+
+// BelongsToTenant checks whether the shift belongs to the tenant in context.
+func (s *CashShift) BelongsToTenant(ctx *appctx.Context) bool {
+	if ctx.Scope() == appctx.ScopeTenant {
+		return ctx.TenantID() != nil && s.TenantID == *ctx.TenantID()
+	}
+	return true
+}
+
+// CountCash adds up a drawer count.
+func CountCash(denominations []types.CashDenomination) (money.Money, error) {
+	var total money.Money
+	for _, d := range denominations {
+		if !d.IsValid() {
+			return 0, ErrInvalidDenomination
+		}
+		total += d.Total()
+	}
+	return total, nil
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "errors"
+
+var (
+	ErrCashShiftNotFound           = errors.New("cash shift not found")
+	ErrUnauthorizedCashShiftAccess = errors.New("unauthorized access to cash shift")
+	ErrCashShiftRequiresStaff      = errors.New("cash shifts are kept for signed-in tenant staff")
+	ErrCashShiftAlreadyOpen        = errors.New("staff member already has an open cash shift")
+	ErrNoOpenCashShift             = errors.New("staff member has no open cash shift")
+	ErrCashShiftClosed             = errors.New("cash shift is already closed")
+	ErrInvalidOpeningFloat         = errors.New("opening float cannot be negative")
+	ErrInvalidDenomination         = errors.New("denominations must be rupiah notes or coins with a non-negative count")
+	ErrInvalidReportDate           = errors.New("report date must be formatted as YYYY-MM-DD")
+)
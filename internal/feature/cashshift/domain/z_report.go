@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// ZReport is the end-of-day summary of a tenant's takings and cash drawers.
+type ZReport struct {
+	TenantID    uuid.UUID
+	Date        time.Time // start of the reported day
+	GeneratedAt time.Time
+
+	Shifts     []*CashShift // shifts opened during the day
+	OpenShifts int          // shifts not counted out yet
+
+	Sales        []MethodTotal // settled payments by payment method
+	Refunds      []MethodTotal // completed refunds by refund method
+	GrossSales   money.Money
+	TotalRefunds money.Money
+	NetSales     money.Money
+
+	// Drawer totals over the closed shifts
+	OpeningFloat money.Money
+	CashSales    money.Money
+	CashRefunds  money.Money
+	ExpectedCash money.Money
+	CountedCash  money.Money
+	Discrepancy  money.Money
+}
+
+// MethodTotal is the number and sum of payments or refunds made one way.
+type MethodTotal struct {
+	Method string
+	Count  int
+	Amount money.Money
+}
+
+// Summarize fills in the report totals from its shifts, sales and refunds.
+func (r *ZReport) Summarize() {
+	for _, m := range r.Sales {
+		r.GrossSales += m.Amount
+	}
+	for _, m := range r.Refunds {
+		r.TotalRefunds += m.Amount
+	}
+	r.NetSales = r.GrossSales - r.TotalRefunds
+
+	for _, s := range r.Shifts {
+		if s.Status != types.CashShiftStatusClosed {
+			r.OpenShifts++
+			continue
+		}
+
+		r.OpeningFloat += s.OpeningFloat
+		r.CashSales += s.CashSales
+		r.CashRefunds += s.CashRefunds
+		if s.ExpectedCash != nil {
+			r.ExpectedCash += *s.ExpectedCash
+		}
+		if s.CountedCash != nil {
+			r.CountedCash += *s.CountedCash
+		}
+		if s.Discrepancy != nil {
+			r.Discrepancy += *s.Discrepancy
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// CashShiftResponse represents a CashShift for API responses. Totals of an
+// open shift are worked out when it is fetched.
+type CashShiftResponse struct {
+	ID            uuid.UUID                `json:"id"`
+	TenantID      uuid.UUID                `json:"tenant_id"`
+	UserID        uuid.UUID                `json:"user_id"`
+	Status        types.CashShiftStatus    `json:"status"`
+	OpeningFloat  money.Money              `json:"opening_float"`
+	OpeningNote   *string                  `json:"opening_note,omitempty"`
+	CashSales     money.Money              `json:"cash_sales"`
+	CashRefunds   money.Money              `json:"cash_refunds"`
+	ExpectedCash  *money.Money             `json:"expected_cash,omitempty"`
+	CountedCash   *money.Money             `json:"counted_cash,omitempty"`
+	Discrepancy   *money.Money             `json:"discrepancy,omitempty"`
+	Denominations []types.CashDenomination `json:"denominations,omitempty"`
+	ClosingNote   *string                  `json:"closing_note,omitempty"`
+	OpenedAt      time.Time                `json:"opened_at"`
+	ClosedAt      *time.Time               `json:"closed_at,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	UpdatedAt     time.Time                `json:"updated_at"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/types"
+
+// CloseCashShiftRequest counts the drawer out by note and coin.
+type CloseCashShiftRequest struct {
+	Denominations []types.CashDenomination `json:"denominations" validate:"required"`
+	Note          *string                  `json:"note,omitempty" validate:"omitempty,max=255"`
+}
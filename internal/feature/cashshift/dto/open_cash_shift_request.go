@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// OpenCashShiftRequest counts in the cash the drawer starts with.
+type OpenCashShiftRequest struct {
+	OpeningFloat money.Money `json:"opening_float" validate:"gte=0"`
+	Note         *string     `json:"note,omitempty" validate:"omitempty,max=255"`
+}
+
+func (r *OpenCashShiftRequest) ToDomain() *domain.CashShift {
+	return &domain.CashShift{
+		OpeningFloat: r.OpeningFloat,
+		OpeningNote:  r.Note,
+	}
+}
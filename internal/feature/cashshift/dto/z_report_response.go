@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// ZReportResponse is the end-of-day report of a tenant.
+type ZReportResponse struct {
+	TenantID     uuid.UUID             `json:"tenant_id"`
+	Date         string                `json:"date"`
+	GeneratedAt  time.Time             `json:"generated_at"`
+	Shifts       []*CashShiftResponse  `json:"shifts"`
+	OpenShifts   int                   `json:"open_shifts"`
+	Sales        []MethodTotalResponse `json:"sales"`
+	Refunds      []MethodTotalResponse `json:"refunds"`
+	GrossSales   money.Money           `json:"gross_sales"`
+	TotalRefunds money.Money           `json:"total_refunds"`
+	NetSales     money.Money           `json:"net_sales"`
+	Drawer       DrawerTotalsResponse  `json:"drawer"`
+}
+
+// MethodTotalResponse is the number and sum of payments or refunds made one way.
+type MethodTotalResponse struct {
+	Method string      `json:"method"`
+	Count  int         `json:"count"`
+	Amount money.Money `json:"amount"`
+}
+
+// DrawerTotalsResponse adds up the closed shifts of the day.
+type DrawerTotalsResponse struct {
+	OpeningFloat money.Money `json:"opening_float"`
+	CashSales    money.Money `json:"cash_sales"`
+	CashRefunds  money.Money `json:"cash_refunds"`
+	ExpectedCash money.Money `json:"expected_cash"`
+	CountedCash  money.Money `json:"counted_cash"`
+	Discrepancy  money.Money `json:"discrepancy"`
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/cashshift/contract"
+	"github.com/umardev500/laundry/internal/feature/cashshift/dto"
+	"github.com/umardev500/laundry/internal/feature/cashshift/mapper"
+	"github.com/umardev500/laundry/internal/feature/cashshift/query"
+	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/validator"
+)
+
+type Handler struct {
+	service   contract.Service
+	validator *validator.Validator
+}
+
+func NewHandler(s contract.Service, v *validator.Validator) *Handler {
+	return &Handler{
+		service:   s,
+		validator: v,
+	}
+}
+
+// Open POST /api/cash-shifts/open
+func (h *Handler) Open(c *fiber.Ctx) error {
+	var req dto.OpenCashShiftRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	shift, err := h.service.Open(ctx, req.ToDomain())
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToResponse(shift))
+}
+
+// Close POST /api/cash-shifts/close
+func (h *Handler) Close(c *fiber.Ctx) error {
+	var req dto.CloseCashShiftRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	shift, err := h.service.Close(ctx, req.Denominations, req.Note)
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(shift))
+}
+
+// Current GET /api/cash-shifts/current
+func (h *Handler) Current(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+
+	shift, err := h.service.Current(ctx)
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(shift))
+}
+
+// List GET /api/cash-shifts
+func (h *Handler) List(c *fiber.Ctx) error {
+	var q query.ListCashShiftQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	q.Normalize()
+	ctx := appctx.New(c.UserContext())
+
+	page, err := h.service.List(ctx, &q)
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	return httpx.JSONPaginated(
+		c,
+		fiber.StatusOK,
+		mapper.ToResponsePage(page).Data,
+		httpx.NewPagination(q.Page, q.Limit, page.Total),
+	)
+}
+
+// FindById GET /api/cash-shifts/:id
+func (h *Handler) FindById(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid cash shift ID")
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	shift, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(shift))
+}
+
+// ZReport GET /api/cash-shifts/z-report?date=YYYY-MM-DD&format=json|csv
+func (h *Handler) ZReport(c *fiber.Ctx) error {
+	var q query.ZReportQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+	q.Normalize()
+
+	day, err := q.Day()
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	report, err := h.service.ZReport(ctx, day)
+	if err != nil {
+		return handleCashShiftError(c, err)
+	}
+
+	if q.Format == query.ZReportFormatJSON {
+		return httpx.JSON(c, fiber.StatusOK, mapper.ToZReportResponse(report))
+	}
+
+	body, err := mapper.ToZReportCSV(report)
+	if err != nil {
+		return httpx.InternalServerError(c, err.Error())
+	}
+
+	c.Type("csv")
+	c.Attachment(fmt.Sprintf("z-report-%s.csv", day.Format(time.DateOnly)))
+	return c.Status(fiber.StatusOK).Send(body)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/pkg/httpx"
+)
+
+// handleCashShiftError centralizes HTTP error mapping for cash shift module
+func handleCashShiftError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCashShiftNotFound),
+		errors.Is(err, domain.ErrNoOpenCashShift):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrUnauthorizedCashShiftAccess),
+		errors.Is(err, domain.ErrCashShiftRequiresStaff):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, domain.ErrCashShiftAlreadyOpen),
+		errors.Is(err, domain.ErrCashShiftClosed):
+		return httpx.Conflict(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidOpeningFloat),
+		errors.Is(err, domain.ErrInvalidDenomination),
+		errors.Is(err, domain.ErrInvalidReportDate):
+		return httpx.BadRequest(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
@@ -0,0 +1,89 @@
+package mapper
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/internal/feature/cashshift/dto"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// FromEnt converts an Ent CashShift to a domain CashShift
+func FromEnt(e *ent.CashShift) *domain.CashShift {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.CashShift{
+		ID:            e.ID,
+		TenantID:      e.TenantID,
+		UserID:        e.UserID,
+		Status:        types.CashShiftStatus(e.Status),
+		OpeningFloat:  e.OpeningFloat,
+		OpeningNote:   e.OpeningNote,
+		CashSales:     e.CashSales,
+		CashRefunds:   e.CashRefunds,
+		ExpectedCash:  e.ExpectedCash,
+		CountedCash:   e.CountedCash,
+		Discrepancy:   e.Discrepancy,
+		Denominations: e.Denominations,
+		ClosingNote:   e.ClosingNote,
+		OpenedAt:      e.OpenedAt,
+		ClosedAt:      e.ClosedAt,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+}
+
+// FromEntList converts a slice of Ent CashShifts to domain CashShifts
+func FromEntList(ents []*ent.CashShift) []*domain.CashShift {
+	shifts := make([]*domain.CashShift, len(ents))
+	for i, e := range ents {
+		shifts[i] = FromEnt(e)
+	}
+	return shifts
+}
+
+// ToResponse converts a domain CashShift to a CashShiftResponse DTO
+func ToResponse(d *domain.CashShift) *dto.CashShiftResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.CashShiftResponse{
+		ID:            d.ID,
+		TenantID:      d.TenantID,
+		UserID:        d.UserID,
+		Status:        d.Status,
+		OpeningFloat:  d.OpeningFloat,
+		OpeningNote:   d.OpeningNote,
+		CashSales:     d.CashSales,
+		CashRefunds:   d.CashRefunds,
+		ExpectedCash:  d.ExpectedCash,
+		CountedCash:   d.CountedCash,
+		Discrepancy:   d.Discrepancy,
+		Denominations: d.Denominations,
+		ClosingNote:   d.ClosingNote,
+		OpenedAt:      d.OpenedAt,
+		ClosedAt:      d.ClosedAt,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
+
+// ToResponseList converts a slice of domain CashShifts to DTOs
+func ToResponseList(shifts []*domain.CashShift) []*dto.CashShiftResponse {
+	res := make([]*dto.CashShiftResponse, len(shifts))
+	for i, s := range shifts {
+		res[i] = ToResponse(s)
+	}
+	return res
+}
+
+// ToResponsePage converts paginated domain CashShifts to paginated DTOs
+func ToResponsePage(data *pagination.PageData[domain.CashShift]) *pagination.PageData[dto.CashShiftResponse] {
+	return &pagination.PageData[dto.CashShiftResponse]{
+		Data:  ToResponseList(data.Data),
+		Total: data.Total,
+	}
+}
@@ -0,0 +1,120 @@
+package mapper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/internal/feature/cashshift/dto"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// ToZReportResponse converts a domain ZReport to its DTO
+func ToZReportResponse(r *domain.ZReport) *dto.ZReportResponse {
+	return &dto.ZReportResponse{
+		TenantID:     r.TenantID,
+		Date:         r.Date.Format(time.DateOnly),
+		GeneratedAt:  r.GeneratedAt,
+		Shifts:       ToResponseList(r.Shifts),
+		OpenShifts:   r.OpenShifts,
+		Sales:        toMethodTotals(r.Sales),
+		Refunds:      toMethodTotals(r.Refunds),
+		GrossSales:   r.GrossSales,
+		TotalRefunds: r.TotalRefunds,
+		NetSales:     r.NetSales,
+		Drawer: dto.DrawerTotalsResponse{
+			OpeningFloat: r.OpeningFloat,
+			CashSales:    r.CashSales,
+			CashRefunds:  r.CashRefunds,
+			ExpectedCash: r.ExpectedCash,
+			CountedCash:  r.CountedCash,
+			Discrepancy:  r.Discrepancy,
+		},
+	}
+}
+
+// ToZReportCSV renders the report as CSV sections separated by blank lines:
+// summary, sales and refunds by method, then one row per shift.
+func ToZReportCSV(r *domain.ZReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		{"Z-Report", r.Date.Format(time.DateOnly)},
+		{"Tenant", r.TenantID.String()},
+		{"Generated at", r.GeneratedAt.Format(time.RFC3339)},
+		{},
+		{"Gross sales", amount(r.GrossSales)},
+		{"Refunds", amount(r.TotalRefunds)},
+		{"Net sales", amount(r.NetSales)},
+		{},
+		{"Opening float", amount(r.OpeningFloat)},
+		{"Cash sales", amount(r.CashSales)},
+		{"Cash refunds", amount(r.CashRefunds)},
+		{"Expected cash", amount(r.ExpectedCash)},
+		{"Counted cash", amount(r.CountedCash)},
+		{"Discrepancy", amount(r.Discrepancy)},
+		{"Open shifts", strconv.Itoa(r.OpenShifts)},
+		{},
+		{"Payment method", "Count", "Amount"},
+	}
+	for _, m := range r.Sales {
+		rows = append(rows, []string{m.Method, strconv.Itoa(m.Count), amount(m.Amount)})
+	}
+
+	rows = append(rows, []string{}, []string{"Refund method", "Count", "Amount"})
+	for _, m := range r.Refunds {
+		rows = append(rows, []string{m.Method, strconv.Itoa(m.Count), amount(m.Amount)})
+	}
+
+	rows = append(rows, []string{}, []string{
+		"Shift", "Staff", "Status", "Opened at", "Closed at", "Opening float",
+		"Cash sales", "Cash refunds", "Expected cash", "Counted cash", "Discrepancy",
+	})
+	for _, s := range r.Shifts {
+		closedAt := ""
+		if s.ClosedAt != nil {
+			closedAt = s.ClosedAt.Format(time.RFC3339)
+		}
+
+		rows = append(rows, []string{
+			s.ID.String(),
+			s.UserID.String(),
+			string(s.Status),
+			s.OpenedAt.Format(time.RFC3339),
+			closedAt,
+			amount(s.OpeningFloat),
+			amount(s.CashSales),
+			amount(s.CashRefunds),
+			optionalAmount(s.ExpectedCash),
+			optionalAmount(s.CountedCash),
+			optionalAmount(s.Discrepancy),
+		})
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func toMethodTotals(totals []domain.MethodTotal) []dto.MethodTotalResponse {
+	res := make([]dto.MethodTotalResponse, len(totals))
+	for i, t := range totals {
+		res[i] = dto.MethodTotalResponse{Method: t.Method, Count: t.Count, Amount: t.Amount}
+	}
+	return res
+}
+
+func amount(v money.Money) string {
+	return v.String()
+}
+
+func optionalAmount(v *money.Money) string {
+	if v == nil {
+		return ""
+	}
+	return amount(*v)
+}
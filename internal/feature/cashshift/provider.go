@@ -0,0 +1,16 @@
+package cashshift
+
+import (
+	"github.com/google/wire"
+	"github.com/umardev500/laundry/internal/feature/cashshift/handler"
+	"github.com/umardev500/laundry/internal/feature/cashshift/repository"
+	"github.com/umardev500/laundry/internal/feature/cashshift/service"
+)
+
+// ProviderSet wires CashShift module dependencies
+var ProviderSet = wire.NewSet(
+	handler.NewHandler,
+	repository.NewEntCashShiftRepository,
+	service.NewCashShiftService,
+	NewRoutes,
+)
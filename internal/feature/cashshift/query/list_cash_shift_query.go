@@ -0,0 +1,32 @@
+package query
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// OrderBy for cash shifts
+type CashShiftOrder string
+
+const (
+	CashShiftOrderOpenedAtAsc  CashShiftOrder = "opened_at_asc"
+	CashShiftOrderOpenedAtDesc CashShiftOrder = "opened_at_desc"
+)
+
+// ListCashShiftQuery defines filters for listing cash shifts
+type ListCashShiftQuery struct {
+	pagination.Query
+	UserID *uuid.UUID            `query:"user_id"` // Filter by staff member (optional)
+	Status types.CashShiftStatus `query:"status"`  // Filter by shift status (optional)
+	Order  CashShiftOrder        `query:"order"`   // Order by opened_at
+}
+
+// Normalize sets default pagination and ordering values
+func (q *ListCashShiftQuery) Normalize() {
+	q.Query.Normalize(1, 10) // Default page 1, 10 items per page
+	if q.Order == "" {
+		q.Order = CashShiftOrderOpenedAtDesc
+	}
+	q.Status = q.Status.Normalize()
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+)
+
+// ZReportFormat is how the report is exported.
+type ZReportFormat string
+
+const (
+	ZReportFormatJSON ZReportFormat = "json"
+	ZReportFormatCSV  ZReportFormat = "csv"
+)
+
+// ZReportQuery selects the day to report on, today when empty.
+type ZReportQuery struct {
+	Date   string        `query:"date"` // YYYY-MM-DD in server time
+	Format ZReportFormat `query:"format"`
+}
+
+// Normalize sets the default format
+func (q *ZReportQuery) Normalize() {
+	q.Format = ZReportFormat(strings.ToLower(string(q.Format)))
+	if q.Format != ZReportFormatCSV {
+		q.Format = ZReportFormatJSON
+	}
+}
+
+// Day returns the start of the reported day.
+func (q *ZReportQuery) Day() (time.Time, error) {
+	if q.Date == "" {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()), nil
+	}
+
+	day, err := time.ParseInLocation(time.DateOnly, q.Date, time.Local)
+	if err != nil {
+		return time.Time{}, domain.ErrInvalidReportDate
+	}
+	return day, nil
+}
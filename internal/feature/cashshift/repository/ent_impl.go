@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/cashshift"
+	"github.com/umardev500/laundry/ent/payment"
+	"github.com/umardev500/laundry/ent/refund"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/internal/feature/cashshift/mapper"
+	"github.com/umardev500/laundry/internal/feature/cashshift/query"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// settledPaymentStatuses are payments whose money was taken, including those
+// refunded since; refunds are reported on their own.
+var settledPaymentStatuses = []payment.Status{
+	payment.Status(types.PaymentStatusPaid),
+	payment.Status(types.PaymentStatusRefundRequested),
+	payment.Status(types.PaymentStatusRefunded),
+}
+
+// EntCashShiftRepository implements domain.CashShift repository using Ent
+type EntCashShiftRepository struct {
+	client *entdb.Client
+}
+
+// NewEntCashShiftRepository creates a new repository instance
+func NewEntCashShiftRepository(client *entdb.Client) Repository {
+	return &EntCashShiftRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new cash shift
+func (r *EntCashShiftRepository) Create(ctx *appctx.Context, s *domain.CashShift) (*domain.CashShift, error) {
+	conn := r.client.GetConn(ctx)
+	entShift, err := conn.CashShift.
+		Create().
+		SetTenantID(s.TenantID).
+		SetUserID(s.UserID).
+		SetStatus(cashshift.Status(s.Status)).
+		SetOpeningFloat(s.OpeningFloat).
+		SetNillableOpeningNote(s.OpeningNote).
+		SetOpenedAt(s.OpenedAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Another request opened a shift for the staff member first
+		return nil, domain.ErrCashShiftAlreadyOpen
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entShift), nil
+}
+
+// Update modifies an existing cash shift
+func (r *EntCashShiftRepository) Update(ctx *appctx.Context, s *domain.CashShift) (*domain.CashShift, error) {
+	conn := r.client.GetConn(ctx)
+	entShift, err := conn.CashShift.
+		UpdateOneID(s.ID).
+		SetStatus(cashshift.Status(s.Status)).
+		SetCashSales(s.CashSales).
+		SetCashRefunds(s.CashRefunds).
+		SetNillableExpectedCash(s.ExpectedCash).
+		SetNillableCountedCash(s.CountedCash).
+		SetNillableDiscrepancy(s.Discrepancy).
+		SetDenominations(s.Denominations).
+		SetNillableClosingNote(s.ClosingNote).
+		SetNillableClosedAt(s.ClosedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entShift), nil
+}
+
+// FindById returns a cash shift by its ID
+func (r *EntCashShiftRepository) FindById(ctx *appctx.Context, id uuid.UUID) (*domain.CashShift, error) {
+	conn := r.client.GetConn(ctx)
+	qb := conn.CashShift.
+		Query().
+		Where(cashshift.IDEQ(id))
+
+	entShift, err := r.applyScope(ctx, qb).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entShift), nil
+}
+
+// FindOpen returns the open shift of a staff member
+func (r *EntCashShiftRepository) FindOpen(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.CashShift, error) {
+	conn := r.client.GetConn(ctx)
+	entShift, err := conn.CashShift.
+		Query().
+		Where(
+			cashshift.TenantIDEQ(tenantID),
+			cashshift.UserIDEQ(userID),
+			cashshift.StatusEQ(cashshift.Status(types.CashShiftStatusOpen)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entShift), nil
+}
+
+// List retrieves paginated cash shifts with filtering, ordering, and tenant scoping.
+func (r *EntCashShiftRepository) List(ctx *appctx.Context, q *query.ListCashShiftQuery) (*pagination.PageData[domain.CashShift], error) {
+	q.Normalize()
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.CashShift.Query()
+	qb = r.applyScope(ctx, qb)
+
+	// Filter by staff member
+	if q.UserID != nil {
+		qb = qb.Where(cashshift.UserIDEQ(*q.UserID))
+	}
+
+	// Filter by status
+	if q.Status != "" {
+		qb = qb.Where(cashshift.StatusEQ(cashshift.Status(q.Status)))
+	}
+
+	// Ordering
+	switch q.Order {
+	case query.CashShiftOrderOpenedAtAsc:
+		qb = qb.Order(ent.Asc(cashshift.FieldOpenedAt))
+	default:
+		qb = qb.Order(ent.Desc(cashshift.FieldOpenedAt))
+	}
+
+	// Total count for pagination
+	total, err := qb.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch paginated data
+	ents, err := qb.
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagination.PageData[domain.CashShift]{
+		Data:  mapper.FromEntList(ents),
+		Total: total,
+	}, nil
+}
+
+// CashTotals sums the cash payments taken and cash refunds paid out during a shift
+func (r *EntCashShiftRepository) CashTotals(ctx *appctx.Context, shiftID uuid.UUID) (money.Money, money.Money, error) {
+	conn := r.client.GetConn(ctx)
+
+	amounts, err := conn.Payment.
+		Query().
+		Where(
+			payment.CashShiftIDEQ(shiftID),
+			payment.StatusIn(settledPaymentStatuses...),
+		).
+		Select(payment.FieldAmount).
+		Ints(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	refunds, err := conn.Refund.
+		Query().
+		Where(
+			refund.CashShiftIDEQ(shiftID),
+			refund.StatusEQ(refund.Status(types.RefundStatusRefunded)),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var sales, paidOut money.Money
+	for _, a := range amounts {
+		sales += money.FromMinor(int64(a))
+	}
+	for _, rf := range refunds {
+		if rf.ApprovedAmount != nil {
+			paidOut += *rf.ApprovedAmount
+		}
+	}
+
+	return sales, paidOut, nil
+}
+
+// ListOpenedBetween returns the tenant's shifts opened in [from, to)
+func (r *EntCashShiftRepository) ListOpenedBetween(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]*domain.CashShift, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.CashShift.
+		Query().
+		Where(
+			cashshift.TenantIDEQ(tenantID),
+			cashshift.OpenedAtGTE(from),
+			cashshift.OpenedAtLT(to),
+		).
+		Order(ent.Asc(cashshift.FieldOpenedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntList(ents), nil
+}
+
+// SalesByMethod sums the tenant's payments settled in [from, to) per payment method
+func (r *EntCashShiftRepository) SalesByMethod(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]domain.MethodTotal, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Payment.
+		Query().
+		Where(
+			payment.TenantIDEQ(tenantID),
+			payment.StatusIn(settledPaymentStatuses...),
+			payment.PaidAtGTE(from),
+			payment.PaidAtLT(to),
+			payment.DeletedAtIsNil(),
+		).
+		WithPaymentMethod().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]*domain.MethodTotal)
+	for _, p := range ents {
+		method := "unknown"
+		if p.Edges.PaymentMethod != nil {
+			method = p.Edges.PaymentMethod.Name
+		}
+		addTotal(totals, method, p.Amount)
+	}
+
+	return sortedTotals(totals), nil
+}
+
+// RefundsByMethod sums the tenant's refunds completed in [from, to) per refund method
+func (r *EntCashShiftRepository) RefundsByMethod(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]domain.MethodTotal, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Refund.
+		Query().
+		Where(
+			refund.TenantIDEQ(tenantID),
+			refund.StatusEQ(refund.Status(types.RefundStatusRefunded)),
+			refund.RefundedAtGTE(from),
+			refund.RefundedAtLT(to),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]*domain.MethodTotal)
+	for _, rf := range ents {
+		if rf.ApprovedAmount != nil {
+			addTotal(totals, string(rf.Method), *rf.ApprovedAmount)
+		}
+	}
+
+	return sortedTotals(totals), nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// applyScope ensures tenant-level filtering.
+func (r *EntCashShiftRepository) applyScope(ctx *appctx.Context, qb *ent.CashShiftQuery) *ent.CashShiftQuery {
+	switch ctx.Scope() {
+	case appctx.ScopeTenant:
+		qb = qb.Where(cashshift.TenantIDEQ(*ctx.TenantID()))
+	case appctx.ScopeUser:
+		qb = qb.Where(cashshift.UserIDEQ(*ctx.UserID()))
+	case appctx.ScopeAdmin:
+		// no filtering for admin
+	}
+
+	return qb
+}
+
+func addTotal(totals map[string]*domain.MethodTotal, method string, amount money.Money) {
+	t, ok := totals[method]
+	if !ok {
+		t = &domain.MethodTotal{Method: method}
+		totals[method] = t
+	}
+	t.Count++
+	t.Amount += amount
+}
+
+func sortedTotals(totals map[string]*domain.MethodTotal) []domain.MethodTotal {
+	res := make([]domain.MethodTotal, 0, len(totals))
+	for _, t := range totals {
+		res = append(res, *t)
+	}
+	slices.SortFunc(res, func(a, b domain.MethodTotal) int {
+		return strings.Compare(a.Method, b.Method)
+	})
+	return res
+}
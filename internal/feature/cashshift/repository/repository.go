@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/internal/feature/cashshift/query"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+type Repository interface {
+	// Create inserts a new cash shift into the database. It returns
+	// domain.ErrCashShiftAlreadyOpen when the staff member has an open shift.
+	Create(ctx *appctx.Context, s *domain.CashShift) (*domain.CashShift, error)
+
+	// Update saves the closing count of a cash shift.
+	Update(ctx *appctx.Context, s *domain.CashShift) (*domain.CashShift, error)
+
+	// FindById returns a cash shift by its ID.
+	FindById(ctx *appctx.Context, id uuid.UUID) (*domain.CashShift, error)
+
+	// FindOpen returns the open shift of a staff member.
+	FindOpen(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.CashShift, error)
+
+	List(ctx *appctx.Context, q *query.ListCashShiftQuery) (*pagination.PageData[domain.CashShift], error)
+
+	// CashTotals sums the cash payments taken and cash refunds paid out during a shift.
+	CashTotals(ctx *appctx.Context, shiftID uuid.UUID) (sales money.Money, refunds money.Money, err error)
+
+	// ListOpenedBetween returns the tenant's shifts opened in [from, to).
+	ListOpenedBetween(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]*domain.CashShift, error)
+
+	// SalesByMethod sums the tenant's payments settled in [from, to) per payment method.
+	SalesByMethod(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]domain.MethodTotal, error)
+
+	// RefundsByMethod sums the tenant's refunds completed in [from, to) per refund method.
+	RefundsByMethod(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]domain.MethodTotal, error)
+}
@@ -0,0 +1,44 @@
+package cashshift
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
+	"github.com/umardev500/laundry/internal/app/router"
+	"github.com/umardev500/laundry/internal/feature/cashshift/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+)
+
+type Routes struct {
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
+}
+
+var _ router.RouteRegistrar = (*Routes)(nil)
+
+func (r *Routes) RegisterRoutes(router fiber.Router) {
+	group := router.Group("cash-shifts")
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+
+	// Staff work their own drawer
+	group.Get("/current", middleware.RequirePermission(r.authz, "operate_cash_shift"), r.handler.Current)
+	group.Post("/open", middleware.RequirePermission(r.authz, "operate_cash_shift"), r.handler.Open)
+	group.Post("/close", middleware.RequirePermission(r.authz, "operate_cash_shift"), r.handler.Close)
+
+	group.Get("/", middleware.RequirePermission(r.authz, "view_cash_shift"), r.handler.List)
+	group.Get("/z-report", middleware.RequirePermission(r.authz, "view_cash_shift"), r.handler.ZReport)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_cash_shift"), r.handler.FindById)
+}
+
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
+}
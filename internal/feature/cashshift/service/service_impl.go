@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/cashshift/contract"
+	"github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	"github.com/umardev500/laundry/internal/feature/cashshift/query"
+	"github.com/umardev500/laundry/internal/feature/cashshift/repository"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+type cashShiftService struct {
+	client *entdb.Client
+	repo   repository.Repository
+}
+
+// NewCashShiftService creates a new cash shift service
+func NewCashShiftService(client *entdb.Client, repo repository.Repository) contract.Service {
+	return &cashShiftService{
+		client: client,
+		repo:   repo,
+	}
+}
+
+// Open implements contract.Service.
+func (s *cashShiftService) Open(ctx *appctx.Context, shift *domain.CashShift) (*domain.CashShift, error) {
+	tenantID, userID, err := staff(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *domain.CashShift
+	err = s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		_, err := s.repo.FindOpen(newCtx, tenantID, userID)
+		switch {
+		case err == nil:
+			return domain.ErrCashShiftAlreadyOpen
+		case !ent.IsNotFound(err):
+			return err
+		}
+
+		shift.TenantID = tenantID
+		shift.UserID = userID
+		if err := shift.Open(); err != nil {
+			return err
+		}
+
+		created, err = s.repo.Create(newCtx, shift)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Close implements contract.Service.
+func (s *cashShiftService) Close(ctx *appctx.Context, denominations []types.CashDenomination, note *string) (*domain.CashShift, error) {
+	var closed *domain.CashShift
+	err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		shift, err := s.Current(newCtx)
+		if err != nil {
+			return err
+		}
+
+		if err := shift.Close(denominations, note); err != nil {
+			return err
+		}
+
+		closed, err = s.repo.Update(newCtx, shift)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closed, nil
+}
+
+// Current implements contract.Service.
+func (s *cashShiftService) Current(ctx *appctx.Context) (*domain.CashShift, error) {
+	tenantID, userID, err := staff(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shift, err := s.repo.FindOpen(ctx, tenantID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNoOpenCashShift
+		}
+		return nil, err
+	}
+
+	if err := s.applyTotals(ctx, shift); err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// OpenShiftID implements contract.Service.
+func (s *cashShiftService) OpenShiftID(ctx *appctx.Context) (uuid.UUID, error) {
+	tenantID, userID, err := staff(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	shift, err := s.repo.FindOpen(ctx, tenantID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, domain.ErrNoOpenCashShift
+		}
+		return uuid.Nil, err
+	}
+
+	return shift.ID, nil
+}
+
+// GetByID implements contract.Service.
+func (s *cashShiftService) GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.CashShift, error) {
+	shift, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrCashShiftNotFound
+		}
+		return nil, err
+	}
+
+	if !shift.BelongsToTenant(ctx) {
+		return nil, domain.ErrUnauthorizedCashShiftAccess
+	}
+
+	if shift.IsOpen() {
+		if err := s.applyTotals(ctx, shift); err != nil {
+			return nil, err
+		}
+	}
+
+	return shift, nil
+}
+
+// List implements contract.Service.
+func (s *cashShiftService) List(ctx *appctx.Context, q *query.ListCashShiftQuery) (*pagination.PageData[domain.CashShift], error) {
+	if q == nil {
+		q = &query.ListCashShiftQuery{}
+	}
+	q.Normalize()
+
+	return s.repo.List(ctx, q)
+}
+
+// ZReport implements contract.Service.
+func (s *cashShiftService) ZReport(ctx *appctx.Context, day time.Time) (*domain.ZReport, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, domain.ErrCashShiftRequiresStaff
+	}
+
+	from := day
+	to := day.AddDate(0, 0, 1)
+
+	shifts, err := s.repo.ListOpenedBetween(ctx, *tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// Open drawers show what they should hold so far
+	for _, shift := range shifts {
+		if shift.IsOpen() {
+			if err := s.applyTotals(ctx, shift); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sales, err := s.repo.SalesByMethod(ctx, *tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.repo.RefundsByMethod(ctx, *tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ZReport{
+		TenantID:    *tenantID,
+		Date:        day,
+		GeneratedAt: time.Now(),
+		Shifts:      shifts,
+		Sales:       sales,
+		Refunds:     refunds,
+	}
+	report.Summarize()
+
+	return report, nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// applyTotals works out the cash taken and paid out so far in the shift.
+func (s *cashShiftService) applyTotals(ctx *appctx.Context, shift *domain.CashShift) error {
+	sales, refunds, err := s.repo.CashTotals(ctx, shift.ID)
+	if err != nil {
+		return err
+	}
+
+	shift.ApplyTotals(sales, refunds)
+	return nil
+}
+
+// staff returns the tenant and user of the staff member in context.
+func staff(ctx *appctx.Context) (uuid.UUID, uuid.UUID, error) {
+	if ctx.Scope() != appctx.ScopeTenant || ctx.TenantID() == nil || ctx.UserID() == nil {
+		return uuid.Nil, uuid.Nil, domain.ErrCashShiftRequiresStaff
+	}
+	return *ctx.TenantID(), *ctx.UserID(), nil
+}
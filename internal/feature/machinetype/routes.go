@@ -2,12 +2,20 @@ package machinetype
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/feature/machinetype/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -15,14 +23,20 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	m := router.Group("machine-types")
 
-	m.Post("/", r.handler.Create)
-	m.Get("/", r.handler.List)
-	m.Get("/:id", r.handler.Get)
-	m.Put("/:id", r.handler.Update)
-	m.Delete("/:id", r.handler.Delete)
-	m.Delete("/:id/purge", r.handler.Purge)
+	m.Use(middleware.CheckAuth(r.keys, r.sessions))
+	m.Post("/", middleware.RequirePermission(r.authz, "create_machine"), r.handler.Create)
+	m.Get("/", middleware.RequirePermission(r.authz, "view_machine"), r.handler.List)
+	m.Get("/:id", middleware.RequirePermission(r.authz, "view_machine"), r.handler.Get)
+	m.Put("/:id", middleware.RequirePermission(r.authz, "update_machine"), r.handler.Update)
+	m.Delete("/:id", middleware.RequirePermission(r.authz, "delete_machine"), r.handler.Delete)
+	m.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_machine"), r.handler.Purge)
 }
 
-func NewRoutes(handler *handler.Handler) *Routes {
-	return &Routes{handler: handler}
+func NewRoutes(handler *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  handler,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
 }
@@ -0,0 +1,22 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/internal/feature/modifier/query"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// Service defines the business logic for service modifiers
+type Service interface {
+	Create(ctx *appctx.Context, m *domain.Modifier) (*domain.Modifier, error)
+	List(ctx *appctx.Context, q *query.ListModifierQuery) (*pagination.PageData[domain.Modifier], error)
+	GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.Modifier, error)
+	Update(ctx *appctx.Context, id uuid.UUID, u *domain.ModifierUpdate) (*domain.Modifier, error)
+	Delete(ctx *appctx.Context, id uuid.UUID) error // soft delete
+
+	// GetAvailable returns those of the modifiers that can be chosen for new
+	// orders. Unknown, inactive and deleted ones are left out.
+	GetAvailable(ctx *appctx.Context, ids []uuid.UUID) ([]*domain.Modifier, error)
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var (
+	ErrModifierNotFound       = errors.New("modifier not found")
+	ErrModifierDeleted        = errors.New("modifier has been deleted")
+	ErrModifierTenantRequired = errors.New("modifiers are managed per tenant")
+	ErrInvalidModifierPrice   = errors.New("fixed modifiers need a price above 0")
+	ErrInvalidModifierPercent = errors.New("percent modifiers need a percent above 0 and at most 100")
+	ErrModifierTargetRequired = errors.New("service modifiers need a service_id and category modifiers a service_category_id")
+)
@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+
+	serviceDomain "github.com/umardev500/laundry/internal/feature/service/domain"
+)
+
+// Modifier is an add-on a tenant offers for order items, such as stain
+// treatment, premium fragrance or hanger packing.
+type Modifier struct {
+	ID                uuid.UUID
+	TenantID          uuid.UUID
+	Name              string
+	Description       *string
+	Type              types.ModifierType
+	Price             money.Money // used by fixed modifiers
+	PerUnit           bool        // the fixed price is charged per billed unit instead of once per item
+	Percent           float64     // used by percent modifiers, a percentage of the item's service price
+	Scope             types.ModifierScope
+	ServiceID         *uuid.UUID
+	ServiceCategoryID *uuid.UUID
+	Active            bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time
+}
+
+// ModifierUpdate holds the fields to change on a modifier; nil fields are kept.
+type ModifierUpdate struct {
+	Name              *string
+	Description       *string
+	Type              *types.ModifierType
+	Price             *money.Money
+	PerUnit           *bool
+	Percent           *float64
+	Scope             *types.ModifierScope
+	ServiceID         *uuid.UUID
+	ServiceCategoryID *uuid.UUID
+	Active            *bool
+}
+
+// Normalize tidies the enums, fills in the default scope and drops the
+// settings the type and scope do not use.
+func (m *Modifier) Normalize() {
+	m.Type = m.Type.Normalize()
+	m.Scope = m.Scope.Normalize()
+	if m.Scope == "" {
+		m.Scope = types.ModifierScopeAll
+	}
+
+	if m.Type == types.ModifierTypePercent {
+		m.Price = 0
+		m.PerUnit = false
+	} else {
+		m.Percent = 0
+	}
+
+	// Only the target of the scope is kept
+	if m.Scope != types.ModifierScopeService {
+		m.ServiceID = nil
+	}
+	if m.Scope != types.ModifierScopeCategory {
+		m.ServiceCategoryID = nil
+	}
+}
+
+// Validate checks the modifier can be priced.
+func (m *Modifier) Validate() error {
+	switch m.Type {
+	case types.ModifierTypeFixed:
+		if !m.Price.IsPositive() {
+			return ErrInvalidModifierPrice
+		}
+	case types.ModifierTypePercent:
+		if m.Percent <= 0 || m.Percent > 100 {
+			return ErrInvalidModifierPercent
+		}
+	}
+
+	if (m.Scope == types.ModifierScopeService && m.ServiceID == nil) ||
+		(m.Scope == types.ModifierScopeCategory && m.ServiceCategoryID == nil) {
+		return ErrModifierTargetRequired
+	}
+
+	return nil
+}
+
+// Update applies the non-nil fields of u.
+func (m *Modifier) Update(u *ModifierUpdate) {
+	if u.Name != nil {
+		m.Name = *u.Name
+	}
+	if u.Description != nil {
+		m.Description = u.Description
+	}
+	if u.Type != nil {
+		m.Type = *u.Type
+	}
+	if u.Price != nil {
+		m.Price = *u.Price
+	}
+	if u.PerUnit != nil {
+		m.PerUnit = *u.PerUnit
+	}
+	if u.Percent != nil {
+		m.Percent = *u.Percent
+	}
+	if u.Scope != nil {
+		m.Scope = *u.Scope
+	}
+	if u.ServiceID != nil {
+		m.ServiceID = u.ServiceID
+	}
+	if u.ServiceCategoryID != nil {
+		m.ServiceCategoryID = u.ServiceCategoryID
+	}
+	if u.Active != nil {
+		m.Active = *u.Active
+	}
+}
+
+// IsDeleted returns true if the modifier has been soft-deleted.
+func (m *Modifier) IsDeleted() bool {
+	return m.DeletedAt != nil
+}
+
+// SoftDelete marks the modifier as deleted.
+func (m *Modifier) SoftDelete() {
+	now := time.Now()
+	m.DeletedAt = &now
+}
+
+// BelongsToTenant reports whether the modifier is offered by the tenant.
+func (m *Modifier) BelongsToTenant(tenantID uuid.UUID) bool {
+	return m.TenantID == tenantID
+}
+
+// IsAvailable reports whether the modifier can be chosen for new orders.
+func (m *Modifier) IsAvailable() bool {
+	return m.Active && !m.IsDeleted()
+}
+
+// AppliesTo reports whether the modifier can be chosen for items of the service.
+func (m *Modifier) AppliesTo(svc *serviceDomain.Service) bool {
+	if !m.BelongsToTenant(svc.TenantID) {
+		return false
+	}
+
+	switch m.Scope {
+	case types.ModifierScopeService:
+		return m.ServiceID != nil && *m.ServiceID == svc.ID
+	case types.ModifierScopeCategory:
+		return m.ServiceCategoryID != nil && svc.ServiceCategoryID != nil && *svc.ServiceCategoryID == *m.ServiceCategoryID
+	default:
+		return true
+	}
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// CreateModifierRequest is the payload for creating a service modifier.
+// Without a scope it can be chosen for items of every service.
+type CreateModifierRequest struct {
+	Name              string      `json:"name" validate:"required,min=2,max=100"`
+	Description       *string     `json:"description,omitempty" validate:"omitempty,max=255"`
+	Type              string      `json:"type" validate:"required,oneof=fixed percent"`
+	Price             money.Money `json:"price,omitempty" validate:"omitempty,gt=0"`
+	PerUnit           bool        `json:"per_unit,omitempty"`
+	Percent           float64     `json:"percent,omitempty" validate:"omitempty,gt=0,lte=100"`
+	Scope             string      `json:"scope,omitempty" validate:"omitempty,oneof=all service category"`
+	ServiceID         *uuid.UUID  `json:"service_id,omitempty"`
+	ServiceCategoryID *uuid.UUID  `json:"service_category_id,omitempty"`
+}
+
+// ToDomain converts the request to an active domain.Modifier
+func (r *CreateModifierRequest) ToDomain() *domain.Modifier {
+	return &domain.Modifier{
+		Name:              r.Name,
+		Description:       r.Description,
+		Type:              types.ModifierType(r.Type),
+		Price:             r.Price,
+		PerUnit:           r.PerUnit,
+		Percent:           r.Percent,
+		Scope:             types.ModifierScope(r.Scope),
+		ServiceID:         r.ServiceID,
+		ServiceCategoryID: r.ServiceCategoryID,
+		Active:            true,
+	}
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// ModifierResponse represents a service modifier returned to clients
+type ModifierResponse struct {
+	ID                uuid.UUID           `json:"id"`
+	TenantID          uuid.UUID           `json:"tenant_id"`
+	Name              string              `json:"name"`
+	Description       *string             `json:"description,omitempty"`
+	Type              types.ModifierType  `json:"type"`
+	Price             money.Money         `json:"price,omitempty"`
+	PerUnit           bool                `json:"per_unit"`
+	Percent           float64             `json:"percent,omitempty"`
+	Scope             types.ModifierScope `json:"scope"`
+	ServiceID         *uuid.UUID          `json:"service_id,omitempty"`
+	ServiceCategoryID *uuid.UUID          `json:"service_category_id,omitempty"`
+	Active            bool                `json:"active"`
+	CreatedAt         time.Time           `json:"created_at"`
+	UpdatedAt         time.Time           `json:"updated_at"`
+	DeletedAt         *time.Time          `json:"deleted_at,omitempty"`
+}
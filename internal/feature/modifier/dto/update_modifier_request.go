@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// UpdateModifierRequest is the payload for changing a modifier; omitted fields are kept.
+type UpdateModifierRequest struct {
+	Name              *string      `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Description       *string      `json:"description,omitempty" validate:"omitempty,max=255"`
+	Type              *string      `json:"type,omitempty" validate:"omitempty,oneof=fixed percent"`
+	Price             *money.Money `json:"price,omitempty" validate:"omitempty,gt=0"`
+	PerUnit           *bool        `json:"per_unit,omitempty"`
+	Percent           *float64     `json:"percent,omitempty" validate:"omitempty,gt=0,lte=100"`
+	Scope             *string      `json:"scope,omitempty" validate:"omitempty,oneof=all service category"`
+	ServiceID         *uuid.UUID   `json:"service_id,omitempty"`
+	ServiceCategoryID *uuid.UUID   `json:"service_category_id,omitempty"`
+	Active            *bool        `json:"active,omitempty"`
+}
+
+// ToDomain converts the request to a domain.ModifierUpdate
+func (r *UpdateModifierRequest) ToDomain() *domain.ModifierUpdate {
+	u := &domain.ModifierUpdate{
+		Name:              r.Name,
+		Description:       r.Description,
+		Price:             r.Price,
+		PerUnit:           r.PerUnit,
+		Percent:           r.Percent,
+		ServiceID:         r.ServiceID,
+		ServiceCategoryID: r.ServiceCategoryID,
+		Active:            r.Active,
+	}
+
+	if r.Type != nil {
+		t := types.ModifierType(*r.Type)
+		u.Type = &t
+	}
+	if r.Scope != nil {
+		s := types.ModifierScope(*r.Scope)
+		u.Scope = &s
+	}
+
+	return u
+}
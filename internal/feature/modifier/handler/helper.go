@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/pkg/httpx"
+)
+
+// handleModifierError centralizes HTTP error mapping for modifier module
+func handleModifierError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrModifierNotFound),
+		errors.Is(err, domain.ErrModifierDeleted):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrModifierTenantRequired):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidModifierPrice),
+		errors.Is(err, domain.ErrInvalidModifierPercent),
+		errors.Is(err, domain.ErrModifierTargetRequired):
+		return httpx.BadRequest(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
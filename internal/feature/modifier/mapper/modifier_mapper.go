@@ -0,0 +1,81 @@
+package mapper
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/internal/feature/modifier/dto"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// FromEnt converts an Ent Modifier to a domain Modifier
+func FromEnt(e *ent.Modifier) *domain.Modifier {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.Modifier{
+		ID:                e.ID,
+		TenantID:          e.TenantID,
+		Name:              e.Name,
+		Description:       e.Description,
+		Type:              types.ModifierType(e.Type),
+		Price:             e.Price,
+		PerUnit:           e.PerUnit,
+		Percent:           e.Percent,
+		Scope:             types.ModifierScope(e.Scope),
+		ServiceID:         e.ServiceID,
+		ServiceCategoryID: e.ServiceCategoryID,
+		Active:            e.Active,
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
+		DeletedAt:         e.DeletedAt,
+	}
+}
+
+// FromEntList converts a slice of Ent Modifiers to domain Modifiers
+func FromEntList(ents []*ent.Modifier) []*domain.Modifier {
+	modifiers := make([]*domain.Modifier, len(ents))
+	for i, e := range ents {
+		modifiers[i] = FromEnt(e)
+	}
+	return modifiers
+}
+
+// ToResponse converts a domain Modifier to a ModifierResponse DTO
+func ToResponse(d *domain.Modifier) *dto.ModifierResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.ModifierResponse{
+		ID:                d.ID,
+		TenantID:          d.TenantID,
+		Name:              d.Name,
+		Description:       d.Description,
+		Type:              d.Type,
+		Price:             d.Price,
+		PerUnit:           d.PerUnit,
+		Percent:           d.Percent,
+		Scope:             d.Scope,
+		ServiceID:         d.ServiceID,
+		ServiceCategoryID: d.ServiceCategoryID,
+		Active:            d.Active,
+		CreatedAt:         d.CreatedAt,
+		UpdatedAt:         d.UpdatedAt,
+		DeletedAt:         d.DeletedAt,
+	}
+}
+
+// ToResponsePage converts a paginated list of domain Modifiers to DTOs
+func ToResponsePage(data *pagination.PageData[domain.Modifier]) *pagination.PageData[dto.ModifierResponse] {
+	res := make([]*dto.ModifierResponse, len(data.Data))
+	for i, m := range data.Data {
+		res[i] = ToResponse(m)
+	}
+
+	return &pagination.PageData[dto.ModifierResponse]{
+		Data:  res,
+		Total: data.Total,
+	}
+}
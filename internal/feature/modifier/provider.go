@@ -0,0 +1,16 @@
+package modifier
+
+import (
+	"github.com/google/wire"
+	"github.com/umardev500/laundry/internal/feature/modifier/handler"
+	"github.com/umardev500/laundry/internal/feature/modifier/repository"
+	"github.com/umardev500/laundry/internal/feature/modifier/service"
+)
+
+// ProviderSet wires Modifier module dependencies
+var ProviderSet = wire.NewSet(
+	handler.NewHandler,
+	repository.NewEntModifierRepository,
+	service.NewModifierService,
+	NewRoutes,
+)
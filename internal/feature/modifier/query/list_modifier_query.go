@@ -0,0 +1,20 @@
+package query
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// ListModifierQuery defines filters for listing the modifiers of a tenant
+type ListModifierQuery struct {
+	pagination.Query
+	Active            *bool      `query:"active"`              // Only active or inactive modifiers (optional)
+	ServiceID         *uuid.UUID `query:"service_id"`          // Modifiers targeting the service (optional)
+	ServiceCategoryID *uuid.UUID `query:"service_category_id"` // Modifiers targeting the category (optional)
+	IncludeDeleted    bool       `query:"include_deleted"`     // Include soft-deleted modifiers
+}
+
+// Normalize sets default pagination values
+func (q *ListModifierQuery) Normalize() {
+	q.Query.Normalize(1, 10) // Default page 1, 10 items per page
+}
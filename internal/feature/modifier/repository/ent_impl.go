@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/modifier"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/internal/feature/modifier/mapper"
+	"github.com/umardev500/laundry/internal/feature/modifier/query"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// EntModifierRepository implements domain.Modifier repository using Ent
+type EntModifierRepository struct {
+	client *entdb.Client
+}
+
+// NewEntModifierRepository creates a new repository instance
+func NewEntModifierRepository(client *entdb.Client) Repository {
+	return &EntModifierRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new modifier
+func (r *EntModifierRepository) Create(ctx *appctx.Context, m *domain.Modifier) (*domain.Modifier, error) {
+	conn := r.client.GetConn(ctx)
+	entModifier, err := conn.Modifier.
+		Create().
+		SetTenantID(m.TenantID).
+		SetName(m.Name).
+		SetNillableDescription(m.Description).
+		SetType(modifier.Type(m.Type)).
+		SetPrice(m.Price).
+		SetPerUnit(m.PerUnit).
+		SetPercent(m.Percent).
+		SetScope(modifier.Scope(m.Scope)).
+		SetNillableServiceID(m.ServiceID).
+		SetNillableServiceCategoryID(m.ServiceCategoryID).
+		SetActive(m.Active).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entModifier), nil
+}
+
+// Update saves the modifier
+func (r *EntModifierRepository) Update(ctx *appctx.Context, m *domain.Modifier) (*domain.Modifier, error) {
+	conn := r.client.GetConn(ctx)
+	builder := conn.Modifier.
+		UpdateOneID(m.ID).
+		SetName(m.Name).
+		SetType(modifier.Type(m.Type)).
+		SetPrice(m.Price).
+		SetPerUnit(m.PerUnit).
+		SetPercent(m.Percent).
+		SetScope(modifier.Scope(m.Scope)).
+		SetActive(m.Active)
+
+	// Optional fields are cleared when unset
+	if m.Description != nil {
+		builder.SetDescription(*m.Description)
+	} else {
+		builder.ClearDescription()
+	}
+	if m.ServiceID != nil {
+		builder.SetServiceID(*m.ServiceID)
+	} else {
+		builder.ClearServiceID()
+	}
+	if m.ServiceCategoryID != nil {
+		builder.SetServiceCategoryID(*m.ServiceCategoryID)
+	} else {
+		builder.ClearServiceCategoryID()
+	}
+	if m.DeletedAt != nil {
+		builder.SetDeletedAt(*m.DeletedAt)
+	} else {
+		builder.ClearDeletedAt()
+	}
+
+	entModifier, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entModifier), nil
+}
+
+// FindByID retrieves a modifier by ID
+func (r *EntModifierRepository) FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Modifier, error) {
+	conn := r.client.GetConn(ctx)
+	entModifier, err := conn.Modifier.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entModifier), nil
+}
+
+// FindAvailable returns the active modifiers with the IDs that are not deleted
+func (r *EntModifierRepository) FindAvailable(ctx *appctx.Context, ids []uuid.UUID) ([]*domain.Modifier, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Modifier.
+		Query().
+		Where(
+			modifier.IDIn(ids...),
+			modifier.Active(true),
+			modifier.DeletedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntList(ents), nil
+}
+
+// List retrieves paginated modifiers with tenant scoping
+func (r *EntModifierRepository) List(ctx *appctx.Context, q *query.ListModifierQuery) (*pagination.PageData[domain.Modifier], error) {
+	q.Normalize()
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.Modifier.Query()
+	qb = r.applyScope(ctx, qb)
+
+	if q.Active != nil {
+		qb = qb.Where(modifier.Active(*q.Active))
+	}
+	if q.ServiceID != nil {
+		qb = qb.Where(modifier.ServiceIDEQ(*q.ServiceID))
+	}
+	if q.ServiceCategoryID != nil {
+		qb = qb.Where(modifier.ServiceCategoryIDEQ(*q.ServiceCategoryID))
+	}
+	if !q.IncludeDeleted {
+		qb = qb.Where(modifier.DeletedAtIsNil())
+	}
+
+	total, err := qb.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ents, err := qb.
+		Order(ent.Asc(modifier.FieldName)).
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagination.PageData[domain.Modifier]{
+		Data:  mapper.FromEntList(ents),
+		Total: total,
+	}, nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// applyScope ensures tenant-level filtering.
+func (r *EntModifierRepository) applyScope(ctx *appctx.Context, qb *ent.ModifierQuery) *ent.ModifierQuery {
+	switch ctx.Scope() {
+	case appctx.ScopeTenant:
+		qb = qb.Where(modifier.TenantIDEQ(*ctx.TenantID()))
+	case appctx.ScopeAdmin:
+		// no filtering for admin
+	default:
+		// customers do not manage modifiers
+		qb = qb.Where(modifier.IDEQ(uuid.Nil))
+	}
+
+	return qb
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/internal/feature/modifier/query"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// Repository defines persistence operations for service modifiers
+type Repository interface {
+	Create(ctx *appctx.Context, m *domain.Modifier) (*domain.Modifier, error)
+	Update(ctx *appctx.Context, m *domain.Modifier) (*domain.Modifier, error)
+	FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Modifier, error)
+	List(ctx *appctx.Context, q *query.ListModifierQuery) (*pagination.PageData[domain.Modifier], error)
+
+	// FindAvailable returns the active modifiers with the IDs that are not deleted
+	FindAvailable(ctx *appctx.Context, ids []uuid.UUID) ([]*domain.Modifier, error)
+}
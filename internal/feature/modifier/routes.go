@@ -0,0 +1,41 @@
+package modifier
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
+	"github.com/umardev500/laundry/internal/app/router"
+	"github.com/umardev500/laundry/internal/feature/modifier/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+)
+
+type Routes struct {
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
+}
+
+var _ router.RouteRegistrar = (*Routes)(nil)
+
+func (r *Routes) RegisterRoutes(router fiber.Router) {
+	group := router.Group("modifiers")
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+
+	group.Get("/", middleware.RequirePermission(r.authz, "view_modifier"), r.handler.List)
+	group.Post("/", middleware.RequirePermission(r.authz, "create_modifier"), r.handler.Create)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_modifier"), r.handler.Get)
+	group.Put("/:id", middleware.RequirePermission(r.authz, "update_modifier"), r.handler.Update)
+	group.Delete("/:id", middleware.RequirePermission(r.authz, "delete_modifier"), r.handler.Delete)
+}
+
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/modifier/contract"
+	"github.com/umardev500/laundry/internal/feature/modifier/domain"
+	"github.com/umardev500/laundry/internal/feature/modifier/query"
+	"github.com/umardev500/laundry/internal/feature/modifier/repository"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+type modifierService struct {
+	repo repository.Repository
+}
+
+// NewModifierService creates a new modifier service
+func NewModifierService(repo repository.Repository) contract.Service {
+	return &modifierService{
+		repo: repo,
+	}
+}
+
+// Create implements contract.Service.
+func (s *modifierService) Create(ctx *appctx.Context, m *domain.Modifier) (*domain.Modifier, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, domain.ErrModifierTenantRequired
+	}
+	m.TenantID = *tenantID
+
+	m.Normalize()
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, m)
+}
+
+// List implements contract.Service.
+func (s *modifierService) List(ctx *appctx.Context, q *query.ListModifierQuery) (*pagination.PageData[domain.Modifier], error) {
+	if q == nil {
+		q = &query.ListModifierQuery{}
+	}
+	q.Normalize()
+
+	return s.repo.List(ctx, q)
+}
+
+// GetByID implements contract.Service.
+func (s *modifierService) GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.Modifier, error) {
+	return s.findExisting(ctx, id)
+}
+
+// Update implements contract.Service.
+func (s *modifierService) Update(ctx *appctx.Context, id uuid.UUID, u *domain.ModifierUpdate) (*domain.Modifier, error) {
+	m, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Update(u)
+	m.Normalize()
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, m)
+}
+
+// Delete implements contract.Service.
+func (s *modifierService) Delete(ctx *appctx.Context, id uuid.UUID) error {
+	m, err := s.findExisting(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	m.SoftDelete()
+	_, err = s.repo.Update(ctx, m)
+	return err
+}
+
+// GetAvailable implements contract.Service.
+func (s *modifierService) GetAvailable(ctx *appctx.Context, ids []uuid.UUID) ([]*domain.Modifier, error) {
+	if len(ids) == 0 {
+		return []*domain.Modifier{}, nil
+	}
+
+	return s.repo.FindAvailable(ctx, ids)
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// findExisting fetches a modifier of the tenant in context that is not deleted.
+func (s *modifierService) findExisting(ctx *appctx.Context, id uuid.UUID) (*domain.Modifier, error) {
+	m, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrModifierNotFound
+		}
+		return nil, err
+	}
+
+	if ctx.Scope() != appctx.ScopeAdmin {
+		tenantID := ctx.TenantID()
+		if tenantID == nil || !m.BelongsToTenant(*tenantID) {
+			return nil, domain.ErrModifierNotFound
+		}
+	}
+	if m.IsDeleted() {
+		return nil, domain.ErrModifierDeleted
+	}
+
+	return m, nil
+}
@@ -1,10 +1,13 @@
 package contract
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/order/domain"
 	"github.com/umardev500/laundry/internal/feature/order/query"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/pagination"
 
 	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
@@ -12,10 +15,45 @@ import (
 
 // OrderService defines the business logic for orders.
 type OrderService interface {
-	CreatePayment(ctx *appctx.Context, o *domain.Order) (*paymentDomain.Payment, error)
+	// CreatePayment records a payment against the order's outstanding balance
+	// and appends it to o.Payments. Cash payments are settled immediately and
+	// wallet payments are debited from the customer's wallet; call it inside
+	// the order transaction so a failed debit rolls the order back.
+	CreatePayment(ctx *appctx.Context, o *domain.Order, p *paymentDomain.Payment) (*paymentDomain.Payment, error)
 	GuestOrder(ctx *appctx.Context, o *domain.Order) (*domain.Order, error)
+
+	// Create places a walk-in order for the tenant in context, optionally for a registered customer.
+	Create(ctx *appctx.Context, o *domain.Order) (*domain.Order, error)
 	List(ctx *appctx.Context, q *query.ListOrderQuery) (*pagination.PageData[domain.Order], error)
 	FindByID(ctx *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error)
+
+	// FindByCode finds an order by its human-readable code, e.g. LDR-2610-000123.
+	FindByCode(ctx *appctx.Context, code string, q *query.OrderQuery) (*domain.Order, error)
+
+	// Track finds an order by its public tracking token, with its items,
+	// payments and status history. It needs no scope; the token is the access.
+	Track(ctx *appctx.Context, token string) (*domain.Order, error)
 	Preview(ctx *appctx.Context, o *domain.Order) (*domain.Order, error)
 	UpdateStatus(ctx *appctx.Context, o *domain.Order) (*domain.Order, error)
+
+	// RecordSLABreaches flags the orders of every tenant still being worked on
+	// past their due time and records an SLA_BREACHED event for each. It
+	// returns how many it flagged.
+	RecordSLABreaches(ctx *appctx.Context, now time.Time) (int, error)
+
+	// AddPayment takes another payment towards the order, e.g. the rest of a deposit.
+	AddPayment(ctx *appctx.Context, id uuid.UUID, p *paymentDomain.Payment) (*domain.Order, error)
+
+	// SettlePayment marks one of the order's pending payments as paid and moves
+	// the order to the matching status. Without a paymentID the order must have
+	// exactly one pending payment. A nil receivedAmount means the exact amount due.
+	SettlePayment(ctx *appctx.Context, id uuid.UUID, paymentID *uuid.UUID, receivedAmount *money.Money) (*domain.Order, error)
+
+	// SettleCharge marks an online payment of the order as paid once the
+	// gateway reported its charge paid, and moves the order along.
+	SettleCharge(ctx *appctx.Context, id uuid.UUID, paymentID uuid.UUID) (*domain.Order, error)
+
+	// TaxSummary reports the PPN and service charge on the orders the tenant
+	// in context placed in the month starting at month.
+	TaxSummary(ctx *appctx.Context, month time.Time) (*domain.TaxSummary, error)
 }
@@ -12,6 +12,19 @@ var (
 	ErrOrderNotFound             = fmt.Errorf("order not found")
 	ErrOrderDeleted              = fmt.Errorf("order has been deleted")
 	ErrUnauthorizedOrderAccess   = fmt.Errorf("unauthorized access to order")
+	ErrOrderPaymentNotFound      = fmt.Errorf("order payment not found")
+	ErrPaymentIDRequired         = fmt.Errorf("order has several pending payments, payment_id is required")
+	ErrNoOutstandingBalance      = fmt.Errorf("order has no outstanding balance")
+	ErrPaymentExceedsBalance     = fmt.Errorf("payment amount exceeds the outstanding balance")
+	ErrOutstandingBalance        = fmt.Errorf("order cannot be completed with an outstanding balance")
+	ErrCustomerNotFound          = fmt.Errorf("customer not found")
+	ErrCustomerInactive          = fmt.Errorf("customer account is not active")
+	ErrInvalidReportMonth        = fmt.Errorf("report month must be formatted as YYYY-MM")
+	ErrModifierNotAvailable      = fmt.Errorf("modifier is not available for the item's service")
+	ErrDuplicateModifier         = fmt.Errorf("modifier is chosen more than once for the same item")
+	ErrTurnaroundNotOffered      = fmt.Errorf("turnaround is not offered for the item's service")
+	ErrAmbiguousOrderCode        = fmt.Errorf("order code matches orders of several tenants, tenant_id is required")
+	ErrOrderTenantRequired       = fmt.Errorf("tenant_id is required")
 )
 
 // ServiceUnavailableError is an error that occurs when one or more services are unavailable.
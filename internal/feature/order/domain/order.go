@@ -6,33 +6,54 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/ordercode"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 
 	"github.com/umardev500/laundry/internal/app/appctx"
+	modifierDomain "github.com/umardev500/laundry/internal/feature/modifier/domain"
 	orderItemDomain "github.com/umardev500/laundry/internal/feature/orderitem/domain"
 	orderStatusHistoryDomain "github.com/umardev500/laundry/internal/feature/orderstatushistory/domain"
 	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+	promotionDomain "github.com/umardev500/laundry/internal/feature/promotion/domain"
 	serviceDomain "github.com/umardev500/laundry/internal/feature/service/domain"
 )
 
 type Order struct {
-	ID           uuid.UUID
-	PaymentID    *uuid.UUID
-	TenantID     uuid.UUID
-	UserID       *uuid.UUID
-	Status       types.OrderStatus
-	TotalAmount  float64
-	Notes        *string
-	GuestName    *string
-	GuestEmail   *string
-	GuestPhone   *string
-	GuestAddress *string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    *time.Time
-	Items        []*orderItemDomain.OrderItem
-
-	Payment  *paymentDomain.Payment
+	ID                  uuid.UUID
+	TenantID            uuid.UUID
+	Code                *string // human-readable number, e.g. LDR-2610-000123; nil for orders placed before codes
+	TrackingToken       *string // unguessable token of the public tracking link
+	UserID              *uuid.UUID
+	Status              types.OrderStatus
+	TotalAmount         money.Money
+	DiscountAmount      money.Money // everything the promotions took off, item and order discounts alike
+	ServiceChargeAmount money.Money
+	TaxAmount           money.Money // PPN
+	Tax                 tax.Settings
+	Currency            money.Currency
+	Turnaround          turnaround.Speed
+	DueAt               *time.Time // when the order is promised to be ready
+	SLABreachedAt       *time.Time // when the order was found not ready by DueAt
+	Notes               *string
+	GuestName           *string
+	GuestEmail          *string
+	GuestPhone          *string
+	GuestAddress        *string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	DeletedAt           *time.Time
+	Items               []*orderItemDomain.OrderItem
+
+	Calendar   turnaround.Calendar // tenant business hours the due time is worked out with, not stored
+	CodeFormat ordercode.Format    // how the tenant's order codes look, not stored
+
+	PromoCodes []string                    // codes the customer entered
+	Discounts  []*promotionDomain.Discount // promotions applied to the order
+
+	Payments []*paymentDomain.Payment
 	Statuses []*orderStatusHistoryDomain.OrderStatusHistory
 }
 
@@ -46,6 +67,11 @@ func (o *Order) UpdateStatus(newStatus types.OrderStatus) error {
 		return fmt.Errorf("order is already in status %s", newStatus)
 	}
 
+	// Completing requires the balance to be settled
+	if newStatus.Normalize() == types.OrderStatusCompleted && o.Balance() > 0 {
+		return ErrOutstandingBalance
+	}
+
 	// Check for allowed staatus transitions
 	if !o.Status.CanTransitionTo(newStatus) {
 		allowedStatuses := o.Status.AllowedNextStatuses()
@@ -75,7 +101,11 @@ func (o *Order) AttachOrderID(orderID uuid.UUID) {
 	}
 }
 
-func (o *Order) Place(availableServices []*serviceDomain.Service) error {
+// Place prices the items from their services, the turnaround and the
+// modifiers chosen for them, then works out the totals and when the order is
+// due. Every service must offer the turnaround, and every chosen modifier must
+// be among the available ones and apply to the item's service.
+func (o *Order) Place(availableServices []*serviceDomain.Service, availableModifiers []*modifierDomain.Modifier) error {
 	if o == nil {
 		return fmt.Errorf("order cannot be nil")
 	}
@@ -86,8 +116,19 @@ func (o *Order) Place(availableServices []*serviceDomain.Service) error {
 		serviceMap[s.ID] = s
 	}
 
-	// Calculate totals
-	var total float64
+	modifierMap := make(map[uuid.UUID]*modifierDomain.Modifier, len(availableModifiers))
+	for _, m := range availableModifiers {
+		modifierMap[m.ID] = m
+	}
+
+	o.Turnaround = o.Turnaround.Normalize()
+	if o.Turnaround == "" {
+		o.Turnaround = turnaround.SpeedRegular
+	}
+
+	// Price the items
+	now := time.Now()
+	o.DueAt = nil
 	for _, item := range o.Items {
 		svc, exists := serviceMap[item.ServiceID]
 		if !exists {
@@ -95,11 +136,26 @@ func (o *Order) Place(availableServices []*serviceDomain.Service) error {
 		}
 
 		item.Price = svc.BasePrice
-		item.CalculateTotals()
-		total += item.Subtotal
+		item.Pricing = svc.Pricing
+
+		opt, offered := svc.Turnarounds.OrDefault().Find(o.Turnaround)
+		if !offered {
+			return fmt.Errorf("service %s: %w", svc.ID, ErrTurnaroundNotOffered)
+		}
+		item.Turnaround = &opt
+
+		// The order is ready when its slowest item is
+		if due := o.Calendar.DueAt(now, opt); o.DueAt == nil || due.After(*o.DueAt) {
+			o.DueAt = &due
+		}
+
+		if err := placeModifiers(item, svc, modifierMap); err != nil {
+			return err
+		}
 	}
 
-	o.TotalAmount = total
+	// Calculate totals, with service charge and PPN per item
+	o.calculateTotals()
 
 	// Init defaults
 	o.InitDefaults()
@@ -107,28 +163,297 @@ func (o *Order) Place(availableServices []*serviceDomain.Service) error {
 	return nil
 }
 
+// placeModifiers copies the name and price of the modifiers chosen for the
+// item, so the item keeps them when the modifiers are edited later.
+func placeModifiers(item *orderItemDomain.OrderItem, svc *serviceDomain.Service, modifierMap map[uuid.UUID]*modifierDomain.Modifier) error {
+	seen := make(map[uuid.UUID]bool, len(item.Modifiers))
+	for _, chosen := range item.Modifiers {
+		if seen[chosen.ModifierID] {
+			return fmt.Errorf("modifier %s: %w", chosen.ModifierID, ErrDuplicateModifier)
+		}
+		seen[chosen.ModifierID] = true
+
+		m, exists := modifierMap[chosen.ModifierID]
+		if !exists || !m.AppliesTo(svc) {
+			return fmt.Errorf("modifier %s: %w", chosen.ModifierID, ErrModifierNotAvailable)
+		}
+
+		chosen.Name = m.Name
+		chosen.Type = m.Type
+		chosen.Price = m.Price
+		chosen.PerUnit = m.PerUnit
+		chosen.Percent = m.Percent
+	}
+	return nil
+}
+
+// Cart returns the priced items for evaluating promotions. Call it after Place.
+func (o *Order) Cart(availableServices []*serviceDomain.Service) *promotionDomain.Cart {
+	categories := make(map[uuid.UUID]*uuid.UUID, len(availableServices))
+	for _, s := range availableServices {
+		categories[s.ID] = s.ServiceCategoryID
+	}
+
+	cart := &promotionDomain.Cart{
+		TenantID: o.TenantID,
+		UserID:   o.UserID,
+		Lines:    make([]promotionDomain.CartLine, len(o.Items)),
+	}
+	for i, item := range o.Items {
+		cart.Lines[i] = promotionDomain.CartLine{
+			ServiceID:         item.ServiceID,
+			ServiceCategoryID: categories[item.ServiceID],
+			Subtotal:          item.Subtotal,
+		}
+	}
+
+	return cart
+}
+
+// ApplyDiscounts books the discounts worked out for the order's cart on the
+// items they were spread over and recalculates the totals. Order discounts
+// cover every item, so the tax on each item is on what is paid for it.
+func (o *Order) ApplyDiscounts(discounts []*promotionDomain.Discount) {
+	for _, item := range o.Items {
+		item.DiscountAmount = 0
+	}
+
+	for _, d := range discounts {
+		for i, amount := range d.Lines {
+			o.Items[i].DiscountAmount += amount
+		}
+	}
+
+	o.calculateTotals()
+	o.Discounts = discounts
+}
+
+// calculateTotals works out each item under the order's tax rules and adds
+// them up.
+func (o *Order) calculateTotals() {
+	o.TotalAmount, o.DiscountAmount, o.ServiceChargeAmount, o.TaxAmount = 0, 0, 0, 0
+
+	for _, item := range o.Items {
+		item.Tax = o.Tax
+		item.CalculateTotals()
+
+		o.TotalAmount += item.TotalAmount
+		o.DiscountAmount += item.DiscountAmount
+		o.ServiceChargeAmount += item.ServiceChargeAmount
+		o.TaxAmount += item.TaxAmount
+	}
+}
+
+// Subtotal returns the order at list prices, before discounts and, unless
+// prices include them, before service charge and PPN.
+func (o *Order) Subtotal() money.Money {
+	subtotal := o.TotalAmount + o.DiscountAmount
+	if !o.Tax.Inclusive {
+		subtotal -= o.ServiceChargeAmount + o.TaxAmount
+	}
+	return subtotal
+}
+
+// TaxableAmount returns the DPP, what the order costs before service charge and PPN.
+func (o *Order) TaxableAmount() money.Money {
+	return o.TotalAmount - o.ServiceChargeAmount - o.TaxAmount
+}
+
 func (o *Order) Validate() error {
 	if o.TenantID == uuid.Nil {
 		return types.ErrTenantIDRequired
 	}
 
-	return nil
+	return o.Currency.Validate()
 }
 
 func (o *Order) InitDefaults() {
 	if o.Status == "" {
 		o.Status = types.OrderStatusPending
 	}
+	o.Currency = o.Currency.Normalize()
 }
 
 func (o *Order) IsGuestOrder() bool {
 	return o.UserID == nil
 }
 
+// PaidAmount returns the sum of the settled payments.
+func (o *Order) PaidAmount() money.Money {
+	var paid money.Money
+	for _, p := range o.Payments {
+		if p.Status == types.PaymentStatusPaid {
+			paid += p.Amount
+		}
+	}
+	return paid
+}
+
+// IsFullyRefunded reports whether the order took payments and all of them
+// have since been refunded.
+func (o *Order) IsFullyRefunded() bool {
+	refunded := false
+	for _, p := range o.Payments {
+		switch p.Status {
+		case types.PaymentStatusPaid, types.PaymentStatusRefundRequested:
+			return false
+		case types.PaymentStatusRefunded:
+			refunded = true
+		}
+	}
+	return refunded
+}
+
+// Balance returns what is still owed. It is negative when the order is overpaid.
+func (o *Order) Balance() money.Money {
+	return o.TotalAmount - o.PaidAmount()
+}
+
+// PaymentState derives the payment state from the settled payments.
+func (o *Order) PaymentState() types.OrderPaymentState {
+	paid := o.PaidAmount()
+
+	switch {
+	case paid <= 0:
+		return types.OrderPaymentStateUnpaid
+	case paid < o.TotalAmount:
+		return types.OrderPaymentStatePartiallyPaid
+	case paid == o.TotalAmount:
+		return types.OrderPaymentStatePaid
+	default:
+		return types.OrderPaymentStateOverpaid
+	}
+}
+
+// UnallocatedAmount returns the part of the total not yet covered by a
+// pending, processing or settled payment.
+func (o *Order) UnallocatedAmount() money.Money {
+	var allocated money.Money
+	for _, p := range o.Payments {
+		switch p.Status {
+		case types.PaymentStatusPending, types.PaymentStatusProcessing, types.PaymentStatusPaid:
+			allocated += p.Amount
+		}
+	}
+	return o.TotalAmount - allocated
+}
+
+// AllocatePayment sets the amount of a new payment against the unallocated
+// balance. Without an amount it covers the rest of the balance, or for cash
+// as much of it as the received amount pays for.
+func (o *Order) AllocatePayment(p *paymentDomain.Payment, method types.PaymentMethod) error {
+	remaining := o.UnallocatedAmount()
+	if remaining <= 0 {
+		return ErrNoOutstandingBalance
+	}
+
+	if p.Amount == 0 {
+		p.Amount = remaining
+		if method == types.PaymentMethodCash && p.ReceivedAmount != nil {
+			p.Amount = min(*p.ReceivedAmount, remaining)
+		}
+	}
+
+	if p.Amount > remaining {
+		return ErrPaymentExceedsBalance
+	}
+
+	return nil
+}
+
+// PendingPayment returns the payment with the given ID, or the only pending
+// payment when id is nil.
+func (o *Order) PendingPayment(id *uuid.UUID) (*paymentDomain.Payment, error) {
+	if id != nil {
+		for _, p := range o.Payments {
+			if p.ID == *id {
+				return p, nil
+			}
+		}
+		return nil, ErrOrderPaymentNotFound
+	}
+
+	var pending *paymentDomain.Payment
+	for _, p := range o.Payments {
+		if p.Status != types.PaymentStatusPending {
+			continue
+		}
+		if pending != nil {
+			return nil, ErrPaymentIDRequired
+		}
+		pending = p
+	}
+
+	if pending == nil {
+		return nil, ErrOrderPaymentNotFound
+	}
+	return pending, nil
+}
+
+// ReplacePayment swaps in an updated copy of one of the order's payments.
+func (o *Order) ReplacePayment(p *paymentDomain.Payment) {
+	for i, existing := range o.Payments {
+		if existing.ID == p.ID {
+			o.Payments[i] = p
+			return
+		}
+	}
+}
+
+// CodePeriod returns the order code period of now, in the tenant's time zone.
+func (o *Order) CodePeriod(now time.Time) string {
+	return o.CodeFormat.Period(now.In(o.Calendar.Location()))
+}
+
+// AssignCode gives the order the code of the seq-th order of the period.
+func (o *Order) AssignCode(period string, seq int) {
+	code := o.CodeFormat.Code(period, seq)
+	o.Code = &code
+}
+
 func (o *Order) IsDeleted() bool {
 	return o.DeletedAt != nil
 }
 
+// IsOverdue reports whether the order is still being worked on past its due time.
+func (o *Order) IsOverdue(now time.Time) bool {
+	return o.DueAt != nil && o.Status.IsInProgress() && now.After(*o.DueAt)
+}
+
+// CheckSLA records the order missing its due time, once. Orders still being
+// worked on past their due time breach it, and so do orders that only became
+// ready after it. It reports whether a breach was recorded now.
+func (o *Order) CheckSLA(now time.Time) bool {
+	if o.SLABreachedAt != nil || o.DueAt == nil || !now.After(*o.DueAt) {
+		return false
+	}
+	if !o.Status.IsInProgress() && o.Status != types.OrderStatusReadyForDelivery {
+		return false
+	}
+
+	o.SLABreachedAt = &now
+	return true
+}
+
+// GetModifierIDs returns the IDs of the modifiers chosen for the order items,
+// each once.
+func (o *Order) GetModifierIDs() []uuid.UUID {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, item := range o.Items {
+		if item == nil {
+			continue
+		}
+		for _, m := range item.Modifiers {
+			if !seen[m.ModifierID] {
+				seen[m.ModifierID] = true
+				ids = append(ids, m.ModifierID)
+			}
+		}
+	}
+	return ids
+}
+
 // GetServiceIDs returns a list of service IDs from the order items.
 func (o *Order) GetServiceIDs() []uuid.UUID {
 	if o == nil || len(o.Items) == 0 {
@@ -144,10 +469,14 @@ func (o *Order) GetServiceIDs() []uuid.UUID {
 	return ids
 }
 
-// BelongsToTenant checks whether the service belongs to the tenant in context.
+// BelongsToTenant checks whether the order belongs to the tenant in context,
+// or for customers, whether it is their own.
 func (s *Order) BelongsToTenant(ctx *appctx.Context) bool {
-	if ctx.Scope() == appctx.ScopeTenant {
+	switch ctx.Scope() {
+	case appctx.ScopeTenant:
 		return ctx.TenantID() != nil && s.TenantID == *ctx.TenantID()
+	case appctx.ScopeUser:
+		return ctx.UserID() != nil && s.UserID != nil && *s.UserID == *ctx.UserID()
 	}
 	return true
 }
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// OrderEvent is something that happened to an order that others may want to
+// act on, such as notifying the customer.
+type OrderEvent struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	OrderID   uuid.UUID
+	Type      types.OrderEventType
+	Data      map[string]any
+	CreatedAt time.Time
+}
+
+// NewSLABreachedEvent records the order missing its due time. Call it after
+// CheckSLA reported a breach.
+func NewSLABreachedEvent(o *Order) *OrderEvent {
+	data := map[string]any{
+		"status":     o.Status,
+		"turnaround": o.Turnaround,
+	}
+	if o.DueAt != nil {
+		data["due_at"] = o.DueAt
+	}
+	if o.SLABreachedAt != nil {
+		data["breached_at"] = o.SLABreachedAt
+	}
+
+	return &OrderEvent{
+		TenantID: o.TenantID,
+		OrderID:  o.ID,
+		Type:     types.OrderEventSLABreached,
+		Data:     data,
+	}
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/tax"
+)
+
+// TaxSummary is a tenant's monthly report of the PPN and service charge on
+// its orders, e.g. for filing the PPN return.
+type TaxSummary struct {
+	TenantID    uuid.UUID
+	Month       time.Time // start of the reported month
+	GeneratedAt time.Time
+
+	Rates []*TaxRateTotals // per set of tax rules the orders were priced with
+	TaxTotals
+}
+
+// TaxRateTotals adds up the orders priced with the same tax rules.
+type TaxRateTotals struct {
+	Tax tax.Settings
+	TaxTotals
+}
+
+// TaxTotals adds up the amounts of a number of orders.
+type TaxTotals struct {
+	Orders        int
+	Subtotal      money.Money
+	Discount      money.Money
+	Taxable       money.Money
+	ServiceCharge money.Money
+	Tax           money.Money
+	Total         money.Money
+}
+
+// Add counts an order in the totals.
+func (t *TaxTotals) Add(o *Order) {
+	t.Orders++
+	t.Subtotal += o.Subtotal()
+	t.Discount += o.DiscountAmount
+	t.Taxable += o.TaxableAmount()
+	t.ServiceCharge += o.ServiceChargeAmount
+	t.Tax += o.TaxAmount
+	t.Total += o.TotalAmount
+}
+
+// Summarize adds up the orders, grouped by the tax rules they were priced
+// with since the rates may have changed during the month.
+func (s *TaxSummary) Summarize(orders []*Order) {
+	byRate := make(map[tax.Settings]*TaxRateTotals)
+	for _, o := range orders {
+		totals, ok := byRate[o.Tax]
+		if !ok {
+			totals = &TaxRateTotals{Tax: o.Tax}
+			byRate[o.Tax] = totals
+			s.Rates = append(s.Rates, totals)
+		}
+
+		totals.Add(o)
+		s.Add(o)
+	}
+}
@@ -3,22 +3,30 @@ package dto
 import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/feature/order/domain"
+	"github.com/umardev500/laundry/pkg/turnaround"
 
 	orderItemDomain "github.com/umardev500/laundry/internal/feature/orderitem/domain"
-	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
 	paymentDto "github.com/umardev500/laundry/internal/feature/payment/dto"
 )
 
 type CreateGuestOrderRequest struct {
+	// Laundry the order is placed at; tenant staff order at their own.
+	TenantID *uuid.UUID `json:"tenant_id,omitempty"`
+
 	Name    string  `json:"name" validate:"required"`
 	Email   *string `json:"email,omitempty" validate:"omitempty,email"`
 	Phone   *string `json:"phone,omitempty" validate:"omitempty,e164"`
 	Address string  `json:"address" validate:"required,min=5,max=200"`
 	Notes   *string `json:"notes,omitempty" validate:"omitempty,max=255"`
 
-	Items []CreateOrderItemRequest `json:"items" validate:"required,min=1"`
+	Items []CreateOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+
+	PromoCodes []string `json:"promo_codes,omitempty" validate:"omitempty,max=5,dive,min=3,max=32"`
+
+	// Turnaround of every item; regular when empty.
+	Turnaround turnaround.Speed `json:"turnaround,omitempty" validate:"omitempty,oneof=regular express same_day"`
 
-	Payment *paymentDto.CreatePaymentRequest `json:"payment" validate:"required"`
+	Payments []paymentDto.CreatePaymentRequest `json:"payments" validate:"required,min=1,dive"`
 }
 
 func (r *CreateGuestOrderRequest) Validate() error {
@@ -29,7 +37,7 @@ func (r *CreateGuestOrderRequest) Validate() error {
 	return nil
 }
 
-func (r *CreateGuestOrderRequest) ToDomain(tenantID uuid.UUID) (*domain.Order, error) {
+func (r *CreateGuestOrderRequest) ToDomain() (*domain.Order, error) {
 	var items []*orderItemDomain.OrderItem
 	for _, i := range r.Items {
 		item, err := i.ToDomain()
@@ -39,6 +47,11 @@ func (r *CreateGuestOrderRequest) ToDomain(tenantID uuid.UUID) (*domain.Order, e
 		items = append(items, item)
 	}
 
+	var tenantID uuid.UUID
+	if r.TenantID != nil {
+		tenantID = *r.TenantID
+	}
+
 	return &domain.Order{
 		TenantID:     tenantID,
 		GuestName:    &r.Name,
@@ -46,10 +59,8 @@ func (r *CreateGuestOrderRequest) ToDomain(tenantID uuid.UUID) (*domain.Order, e
 		GuestPhone:   r.Phone,
 		GuestAddress: &r.Address,
 		Items:        items,
-		Payment: &paymentDomain.Payment{
-			PaymentMethodID: r.Payment.PaymentMethodID,
-			ReceivedAmount:  r.Payment.ReceivedAmount,
-			Notes:           *r.Payment.Notes,
-		},
+		PromoCodes:   r.PromoCodes,
+		Turnaround:   r.Turnaround,
+		Payments:     toDomainPayments(r.Payments),
 	}, nil
 }
@@ -8,11 +8,19 @@ import (
 type CreateOrderItemRequest struct {
 	ServiceID uuid.UUID `json:"service_id" binding:"required"`
 	Quantity  float64   `json:"quantity" binding:"required,min=1"` // At least 1
+
+	// Add-ons such as stain treatment, priced when the order is placed
+	ModifierIDs []uuid.UUID `json:"modifier_ids,omitempty" validate:"omitempty,max=10,unique"`
 }
 
 func (req CreateOrderItemRequest) ToDomain() (*orderItemDomain.OrderItem, error) {
-	return &orderItemDomain.OrderItem{
+	item := &orderItemDomain.OrderItem{
 		ServiceID: req.ServiceID,
 		Quantity:  req.Quantity,
-	}, nil
+	}
+	for _, id := range req.ModifierIDs {
+		item.Modifiers = append(item.Modifiers, &orderItemDomain.OrderItemModifier{ModifierID: id})
+	}
+
+	return item, nil
 }
@@ -1,16 +1,82 @@
 package dto
 
-import "time"
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/feature/order/domain"
+	"github.com/umardev500/laundry/pkg/turnaround"
 
+	orderItemDomain "github.com/umardev500/laundry/internal/feature/orderitem/domain"
+	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+	paymentDto "github.com/umardev500/laundry/internal/feature/payment/dto"
+)
+
+// CreateOrderRequest is a walk-in order taken at the counter by tenant staff.
+// UserID links the order to a registered customer; without it the guest
+// fields describe the customer.
 type CreateOrderRequest struct {
-	// Guest info (required only if user is guest)
-	GuestName    *string `json:"guest_name,omitempty" binding:"omitempty,min=2,max=100"`
-	GuestEmail   *string `json:"guest_email,omitempty" binding:"omitempty,email"`
-	GuestPhone   *string `json:"guest_phone,omitempty" binding:"omitempty,e164"` // E.164 phone format
-	GuestAddress *string `json:"guest_address,omitempty" binding:"omitempty,min=5,max=200"`
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+
+	GuestName    *string `json:"guest_name,omitempty" validate:"omitempty,min=2,max=100"`
+	GuestEmail   *string `json:"guest_email,omitempty" validate:"omitempty,email"`
+	GuestPhone   *string `json:"guest_phone,omitempty" validate:"omitempty,e164"`
+	GuestAddress *string `json:"guest_address,omitempty" validate:"omitempty,min=5,max=200"`
+	Notes        *string `json:"notes,omitempty" validate:"omitempty,max=255"`
+
+	Items []CreateOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+
+	PromoCodes []string `json:"promo_codes,omitempty" validate:"omitempty,max=5,dive,min=3,max=32"`
+
+	// Turnaround of every item; regular when empty.
+	Turnaround turnaround.Speed `json:"turnaround,omitempty" validate:"omitempty,oneof=regular express same_day"`
+
+	// Payments taken at the counter, e.g. a deposit or a cash/transfer split.
+	// The rest can be paid later.
+	Payments []paymentDto.CreatePaymentRequest `json:"payments,omitempty" validate:"omitempty,dive"`
+}
 
-	PickupDate   *time.Time `json:"pickup_date,omitempty" binding:"omitempty,gt"` // gt ensures date is in the future
-	DeliveryDate *time.Time `json:"delivery_date,omitempty" binding:"omitempty,gtfield=PickupDate"`
+func (r *CreateOrderRequest) Validate() error {
+	if len(r.Items) == 0 {
+		return domain.ErrOrderItemsRequired
+	}
+
+	return nil
+}
+
+func (r *CreateOrderRequest) ToDomain() (*domain.Order, error) {
+	var items []*orderItemDomain.OrderItem
+	for _, i := range r.Items {
+		item, err := i.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	o := &domain.Order{
+		UserID:     r.UserID,
+		Notes:      r.Notes,
+		Items:      items,
+		PromoCodes: r.PromoCodes,
+		Turnaround: r.Turnaround,
+		Payments:   toDomainPayments(r.Payments),
+	}
+
+	// Guest details are only kept for orders without a registered customer.
+	if r.UserID == nil {
+		o.GuestName = r.GuestName
+		o.GuestEmail = r.GuestEmail
+		o.GuestPhone = r.GuestPhone
+		o.GuestAddress = r.GuestAddress
+	}
+
+	return o, nil
+}
 
-	Items []CreateOrderItemRequest `json:"items" binding:"required,min=1"`
+// toDomainPayments converts the requested payments, keeping their order.
+func toDomainPayments(reqs []paymentDto.CreatePaymentRequest) []*paymentDomain.Payment {
+	payments := make([]*paymentDomain.Payment, len(reqs))
+	for i := range reqs {
+		payments[i] = reqs[i].ToDomain()
+	}
+	return payments
 }
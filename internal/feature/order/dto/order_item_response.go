@@ -1,12 +1,38 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/types"
+)
 
 type OrderItemResponse struct {
-	ID        uuid.UUID `json:"id"`
-	OrderID   uuid.UUID `json:"order_id"`
-	ServiceID uuid.UUID `json:"service_id"`
-	Quantity  float64   `json:"quantity"`
-	Price     float64   `json:"price"`
-	Total     float64   `json:"total"`
+	ID             uuid.UUID                    `json:"id"`
+	OrderID        uuid.UUID                    `json:"order_id"`
+	ServiceID      uuid.UUID                    `json:"service_id"`
+	Quantity       float64                      `json:"quantity"`
+	BilledQuantity float64                      `json:"billed_quantity"`
+	Pricing        []pricing.Applied            `json:"pricing,omitempty"`
+	Modifiers      []*OrderItemModifierResponse `json:"modifiers,omitempty"`
+	Price          money.Money                  `json:"price"`
+	Surcharge      money.Money                  `json:"surcharge"`
+	ModifierAmount money.Money                  `json:"modifier_amount"`
+	Subtotal       money.Money                  `json:"subtotal"`
+	Discount       money.Money                  `json:"discount"`
+	ServiceCharge  money.Money                  `json:"service_charge"`
+	Tax            money.Money                  `json:"tax"`
+	Total          money.Money                  `json:"total"`
+}
+
+// OrderItemModifierResponse is a modifier chosen for an order item, priced as
+// it was when the order was placed.
+type OrderItemModifierResponse struct {
+	ModifierID uuid.UUID          `json:"modifier_id"`
+	Name       string             `json:"name"`
+	Type       types.ModifierType `json:"type"`
+	Price      money.Money        `json:"price,omitempty"`
+	PerUnit    bool               `json:"per_unit,omitempty"`
+	Percent    float64            `json:"percent,omitempty"`
+	Amount     money.Money        `json:"amount"`
 }
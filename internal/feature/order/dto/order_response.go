@@ -4,27 +4,49 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 
 	orderStatusHistoryDto "github.com/umardev500/laundry/internal/feature/orderstatushistory/dto"
 	paymentDto "github.com/umardev500/laundry/internal/feature/payment/dto"
+	promotionDto "github.com/umardev500/laundry/internal/feature/promotion/dto"
 )
 
 type OrderResponse struct {
-	ID           uuid.UUID                                           `json:"id"`
-	TenantID     uuid.UUID                                           `json:"tenant_id"`
-	UserID       *uuid.UUID                                          `json:"user_id,omitempty"`
-	Status       types.OrderStatus                                   `json:"status"`
-	TotalAmount  float64                                             `json:"total_amount"`
-	Notes        *string                                             `json:"notes,omitempty"`
-	GuestName    *string                                             `json:"guest_name,omitempty"`
-	GuestEmail   *string                                             `json:"guest_email,omitempty"`
-	GuestPhone   *string                                             `json:"guest_phone,omitempty"`
-	GuestAddress *string                                             `json:"guest_address,omitempty"`
-	CreatedAt    time.Time                                           `json:"created_at"`
-	UpdatedAt    time.Time                                           `json:"updated_at"`
-	DeletedAt    *time.Time                                          `json:"deleted_at,omitempty"`
-	Items        []*OrderItemResponse                                `json:"items"`
-	Payment      *paymentDto.PaymentResponse                         `json:"payment,omitempty"`
-	Statuses     []*orderStatusHistoryDto.OrderStatusHistoryResponse `json:"statuses"`
+	ID                  uuid.UUID                                           `json:"id"`
+	TenantID            uuid.UUID                                           `json:"tenant_id"`
+	Code                *string                                             `json:"code,omitempty"`
+	TrackingToken       *string                                             `json:"tracking_token,omitempty"`
+	UserID              *uuid.UUID                                          `json:"user_id,omitempty"`
+	Status              types.OrderStatus                                   `json:"status"`
+	SubtotalAmount      money.Money                                         `json:"subtotal_amount"`
+	DiscountAmount      money.Money                                         `json:"discount_amount"`
+	TaxableAmount       money.Money                                         `json:"taxable_amount"`
+	ServiceChargeAmount money.Money                                         `json:"service_charge_amount"`
+	TaxAmount           money.Money                                         `json:"tax_amount"`
+	TaxRate             float64                                             `json:"tax_rate"`
+	TaxInclusive        bool                                                `json:"tax_inclusive"`
+	ServiceChargeRate   float64                                             `json:"service_charge_rate"`
+	TotalAmount         money.Money                                         `json:"total_amount"`
+	Currency            money.Currency                                      `json:"currency"`
+	Turnaround          turnaround.Speed                                    `json:"turnaround,omitempty"`
+	DueAt               *time.Time                                          `json:"due_at,omitempty"`
+	SLABreachedAt       *time.Time                                          `json:"sla_breached_at,omitempty"`
+	Overdue             bool                                                `json:"overdue"`
+	Notes               *string                                             `json:"notes,omitempty"`
+	GuestName           *string                                             `json:"guest_name,omitempty"`
+	GuestEmail          *string                                             `json:"guest_email,omitempty"`
+	GuestPhone          *string                                             `json:"guest_phone,omitempty"`
+	GuestAddress        *string                                             `json:"guest_address,omitempty"`
+	CreatedAt           time.Time                                           `json:"created_at"`
+	UpdatedAt           time.Time                                           `json:"updated_at"`
+	DeletedAt           *time.Time                                          `json:"deleted_at,omitempty"`
+	Items               []*OrderItemResponse                                `json:"items"`
+	Discounts           []*promotionDto.DiscountResponse                    `json:"discounts,omitempty"`
+	Payments            []*paymentDto.PaymentResponse                       `json:"payments,omitempty"`
+	PaidAmount          *money.Money                                        `json:"paid_amount,omitempty"`
+	Balance             *money.Money                                        `json:"balance,omitempty"`
+	PaymentState        *types.OrderPaymentState                            `json:"payment_state,omitempty"`
+	Statuses            []*orderStatusHistoryDto.OrderStatusHistoryResponse `json:"statuses"`
 }
@@ -1,12 +1,22 @@
 package dto
 
 import (
+	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/feature/order/domain"
 	orderItemDomain "github.com/umardev500/laundry/internal/feature/orderitem/domain"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type PreviewOrderRequest struct {
-	Items []CreateOrderItemRequest `json:"items" validate:"required,min=1"`
+	// Laundry the order is previewed at; tenant staff preview at their own.
+	TenantID *uuid.UUID `json:"tenant_id,omitempty"`
+
+	Items []CreateOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+
+	PromoCodes []string `json:"promo_codes,omitempty" validate:"omitempty,max=5,dive,min=3,max=32"`
+
+	// Turnaround of every item; regular when empty.
+	Turnaround turnaround.Speed `json:"turnaround,omitempty" validate:"omitempty,oneof=regular express same_day"`
 }
 
 // Validate basic structure
@@ -28,7 +38,15 @@ func (r *PreviewOrderRequest) ToDomain() (*domain.Order, error) {
 		items = append(items, item)
 	}
 
+	var tenantID uuid.UUID
+	if r.TenantID != nil {
+		tenantID = *r.TenantID
+	}
+
 	return &domain.Order{
-		Items: items,
+		TenantID:   tenantID,
+		Items:      items,
+		PromoCodes: r.PromoCodes,
+		Turnaround: r.Turnaround,
 	}, nil
 }
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// TaxSummaryResponse is the monthly tax report of a tenant.
+type TaxSummaryResponse struct {
+	TenantID    uuid.UUID                `json:"tenant_id"`
+	Month       string                   `json:"month"`
+	GeneratedAt time.Time                `json:"generated_at"`
+	Rates       []*TaxRateTotalsResponse `json:"rates"`
+	TaxTotalsResponse
+}
+
+// TaxRateTotalsResponse adds up the orders priced with the same tax rules.
+type TaxRateTotalsResponse struct {
+	TaxRate           float64 `json:"tax_rate"`
+	TaxInclusive      bool    `json:"tax_inclusive"`
+	ServiceChargeRate float64 `json:"service_charge_rate"`
+	TaxTotalsResponse
+}
+
+// TaxTotalsResponse adds up the amounts of a number of orders.
+type TaxTotalsResponse struct {
+	Orders              int         `json:"orders"`
+	SubtotalAmount      money.Money `json:"subtotal_amount"`
+	DiscountAmount      money.Money `json:"discount_amount"`
+	TaxableAmount       money.Money `json:"taxable_amount"`
+	ServiceChargeAmount money.Money `json:"service_charge_amount"`
+	TaxAmount           money.Money `json:"tax_amount"`
+	TotalAmount         money.Money `json:"total_amount"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/turnaround"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// TrackingResponse is the public view of an order behind its tracking link.
+// It leaves out the customer's contact details, internal IDs and notes.
+type TrackingResponse struct {
+	Code         *string                 `json:"code,omitempty"`
+	CustomerName *string                 `json:"customer_name,omitempty"` // masked, e.g. B*** S***
+	Status       types.OrderStatus       `json:"status"`
+	Turnaround   turnaround.Speed        `json:"turnaround,omitempty"`
+	DueAt        *time.Time              `json:"due_at,omitempty"`
+	Overdue      bool                    `json:"overdue"`
+	Items        []*TrackingItemResponse `json:"items"`
+	Currency     money.Currency          `json:"currency"`
+	TotalAmount  money.Money             `json:"total_amount"`
+	PaidAmount   money.Money             `json:"paid_amount"`
+	Balance      money.Money             `json:"balance"`
+	PaymentState types.OrderPaymentState `json:"payment_state"`
+	Timeline     []*TrackingStatusEntry  `json:"timeline"`
+	CreatedAt    time.Time               `json:"created_at"`
+}
+
+type TrackingItemResponse struct {
+	ServiceID uuid.UUID   `json:"service_id"`
+	Quantity  float64     `json:"quantity"`
+	Modifiers []string    `json:"modifiers,omitempty"`
+	Total     money.Money `json:"total"`
+}
+
+// TrackingStatusEntry is a status the order reached and when.
+type TrackingStatusEntry struct {
+	Status    types.OrderStatus `json:"status"`
+	CreatedAt time.Time         `json:"created_at"`
+}
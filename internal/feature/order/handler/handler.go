@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"fmt"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
@@ -16,6 +18,7 @@ import (
 	historyContract "github.com/umardev500/laundry/internal/feature/orderstatushistory/contract"
 	historyMapper "github.com/umardev500/laundry/internal/feature/orderstatushistory/mapper"
 	historyQuery "github.com/umardev500/laundry/internal/feature/orderstatushistory/query"
+	paymentDto "github.com/umardev500/laundry/internal/feature/payment/dto"
 )
 
 type Handler struct {
@@ -57,6 +60,62 @@ func (h *Handler) FindByID(c *fiber.Ctx) error {
 	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(result))
 }
 
+// FindByCode handles GET /orders/by-code/:code.
+func (h *Handler) FindByCode(c *fiber.Ctx) error {
+	var q query.OrderQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.FindByCode(ctx, c.Params("code"), &q)
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(result))
+}
+
+// Track handles GET /track/:token, the public tracking link of an order.
+func (h *Handler) Track(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.Track(ctx, c.Params("token"))
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTrackingResponse(result))
+}
+
+// Create handles POST /orders for walk-in orders taken by tenant staff.
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dto.CreateOrderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := req.Validate(); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	data, err := req.ToDomain()
+	if err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	result, err := h.service.Create(ctx, data)
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToResponse(result))
+}
+
 func (h *Handler) GuestOrder(c *fiber.Ctx) error {
 	var req dto.CreateGuestOrderRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -73,7 +132,7 @@ func (h *Handler) GuestOrder(c *fiber.Ctx) error {
 	}
 
 	ctx := appctx.New(c.UserContext())
-	data, err := req.ToDomain(uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"))
+	data, err := req.ToDomain()
 	if err != nil {
 		return httpx.BadRequest(c, err.Error())
 	}
@@ -103,6 +162,11 @@ func (h *Handler) History(c *fiber.Ctx) error {
 
 	ctx := appctx.New(c.UserContext())
 
+	// The history is only shown to those who can see the order
+	if _, err := h.service.FindByID(ctx, id, &query.OrderQuery{}); err != nil {
+		return handleOrderError(c, err)
+	}
+
 	page, err := h.historyService.List(ctx, &q)
 	if err != nil {
 		return handleOrderError(c, err)
@@ -192,6 +256,66 @@ func (h *Handler) UpdateStatus(c *fiber.Ctx) error {
 	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(res))
 }
 
+// AddPayment POST /api/orders/:id/payments
+func (h *Handler) AddPayment(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid order ID")
+	}
+
+	var req paymentDto.CreatePaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	res, err := h.service.AddPayment(ctx, id, req.ToDomain())
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToResponse(res))
+}
+
+// SettlePayment POST /api/orders/:id/payments/settle and /api/orders/:id/payments/:payment_id/settle
+func (h *Handler) SettlePayment(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid order ID")
+	}
+
+	// Without a payment ID the order's only pending payment is settled
+	var paymentID *uuid.UUID
+	if raw := c.Params("payment_id"); raw != "" {
+		pid, err := uuid.Parse(raw)
+		if err != nil {
+			return httpx.BadRequest(c, "invalid payment ID")
+		}
+		paymentID = &pid
+	}
+
+	var req paymentDto.SettlePaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	res, err := h.service.SettlePayment(ctx, id, paymentID, req.ReceivedAmount)
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(res))
+}
+
 // -----------------------
 // Helper methods
 // -----------------------
@@ -204,3 +328,37 @@ func (h *Handler) refToResponse(ref any) any {
 	}
 	return ref
 }
+
+// TaxSummary GET /api/orders/tax-summary?month=YYYY-MM&format=json|csv
+func (h *Handler) TaxSummary(c *fiber.Ctx) error {
+	var q query.TaxSummaryQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+	q.Normalize()
+
+	month, err := q.Start()
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	summary, err := h.service.TaxSummary(ctx, month)
+	if err != nil {
+		return handleOrderError(c, err)
+	}
+
+	if q.Format == query.TaxSummaryFormatJSON {
+		return httpx.JSON(c, fiber.StatusOK, mapper.ToTaxSummaryResponse(summary))
+	}
+
+	body, err := mapper.ToTaxSummaryCSV(summary)
+	if err != nil {
+		return httpx.InternalServerError(c, err.Error())
+	}
+
+	c.Type("csv")
+	c.Attachment(fmt.Sprintf("tax-summary-%s.csv", month.Format("2006-01")))
+	return c.Status(fiber.StatusOK).Send(body)
+}
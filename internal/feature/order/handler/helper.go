@@ -8,9 +8,14 @@ import (
 	"github.com/umardev500/laundry/internal/feature/order/dto"
 	"github.com/umardev500/laundry/pkg/errorsx"
 	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 
+	cashShiftDomain "github.com/umardev500/laundry/internal/feature/cashshift/domain"
 	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+	promotionDomain "github.com/umardev500/laundry/internal/feature/promotion/domain"
+	tenantDomain "github.com/umardev500/laundry/internal/feature/tenant/domain"
+	walletDomain "github.com/umardev500/laundry/internal/feature/wallet/domain"
 )
 
 // handleOrderError centralizes HTTP error mapping for order module
@@ -28,23 +33,80 @@ func handleOrderError(c *fiber.Ctx, err error) error {
 			err,
 		)
 
+	case errorsx.IsInvalidTransitionErr[types.PaymentStatus](err):
+		return httpx.JSONErrorWithData(
+			c,
+			fiber.StatusBadRequest,
+			"invalid payment status transition",
+			err,
+			err,
+		)
+
+	case errors.Is(err, paymentDomain.ErrOnlyPendingPayments),
+		errors.Is(err, paymentDomain.ErrSettledByGateway),
+		errors.Is(err, cashShiftDomain.ErrNoOpenCashShift),
+		errors.Is(err, domain.ErrNoOutstandingBalance),
+		errors.Is(err, domain.ErrOutstandingBalance):
+		return httpx.Conflict(c, err.Error())
+
 	case errors.Is(err, types.ErrStatusUnchanged):
 		return httpx.JSONWithMessage[*dto.OrderResponse](c, fiber.StatusOK, nil, err.Error())
 
 	case errors.Is(err, domain.ErrOrderDeleted),
-		errors.Is(err, domain.ErrUnauthorizedOrderAccess):
+		errors.Is(err, domain.ErrUnauthorizedOrderAccess),
+		errors.Is(err, cashShiftDomain.ErrCashShiftRequiresStaff),
+		errors.Is(err, types.ErrTenantIDRequired):
 		return httpx.Forbidden(c, err.Error())
 
-	case errors.Is(err, domain.ErrOrderNotFound):
+	case errors.Is(err, domain.ErrOrderNotFound),
+		errors.Is(err, domain.ErrOrderPaymentNotFound),
+		errors.Is(err, paymentDomain.ErrPaymentNotFound),
+		errors.Is(err, tenantDomain.ErrTenantNotFound):
 		return httpx.NotFound(c, err.Error())
 
 	case isServiceUnavailable,
 		errors.Is(err, domain.ErrGuestEmailOrPhoneRequired),
 		errors.Is(err, domain.ErrOrderItemsRequired),
-		errors.Is(err, paymentDomain.ErrInsufficientPayment):
+		errors.Is(err, domain.ErrCustomerNotFound),
+		errors.Is(err, domain.ErrCustomerInactive),
+		errors.Is(err, domain.ErrPaymentIDRequired),
+		errors.Is(err, domain.ErrInvalidReportMonth),
+		errors.Is(err, domain.ErrModifierNotAvailable),
+		errors.Is(err, domain.ErrDuplicateModifier),
+		errors.Is(err, domain.ErrTurnaroundNotOffered),
+		errors.Is(err, domain.ErrAmbiguousOrderCode),
+		errors.Is(err, domain.ErrOrderTenantRequired),
+		errors.Is(err, money.ErrUnsupportedCurrency),
+		errors.Is(err, domain.ErrPaymentExceedsBalance),
+		errors.Is(err, paymentDomain.ErrInsufficientPayment),
+		errors.Is(err, walletDomain.ErrInsufficientBalance),
+		errors.Is(err, walletDomain.ErrWalletCustomerRequired),
+		isPromotionError(err):
 		return httpx.BadRequest(c, err.Error())
 
 	default:
 		return httpx.InternalServerError(c, err.Error())
 	}
 }
+
+// isPromotionError reports whether a promo code or promotion could not be
+// applied to the order.
+func isPromotionError(err error) bool {
+	for _, target := range []error{
+		promotionDomain.ErrPromoCodeNotFound,
+		promotionDomain.ErrPromotionInactive,
+		promotionDomain.ErrPromotionNotStarted,
+		promotionDomain.ErrPromotionExpired,
+		promotionDomain.ErrPromotionUsedUp,
+		promotionDomain.ErrCustomerLimitReached,
+		promotionDomain.ErrPromotionMembersOnly,
+		promotionDomain.ErrMinSpendNotMet,
+		promotionDomain.ErrPromotionNotApplicable,
+		promotionDomain.ErrPromotionNotStackable,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/feature/order/domain"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// EventFromEnt converts an Ent OrderEvent model to a domain OrderEvent.
+func EventFromEnt(e *ent.OrderEvent) *domain.OrderEvent {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.OrderEvent{
+		ID:        e.ID,
+		TenantID:  e.TenantID,
+		OrderID:   e.OrderID,
+		Type:      types.OrderEventType(e.Type),
+		Data:      e.Data,
+		CreatedAt: e.CreatedAt,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/umardev500/laundry/pkg/pagination"
 
 	orderItemDomain "github.com/umardev500/laundry/internal/feature/orderitem/domain"
+	orderItemMapper "github.com/umardev500/laundry/internal/feature/orderitem/mapper"
 )
 
 // FromEntItem converts an Ent OrderItem to a domain OrderItem.
@@ -15,13 +16,21 @@ func FromEntItem(e *ent.OrderItem) *orderItemDomain.OrderItem {
 	}
 
 	return &orderItemDomain.OrderItem{
-		ID:          e.ID,
-		OrderID:     e.OrderID,
-		ServiceID:   e.ServiceID,
-		Quantity:    e.Quantity,
-		Price:       e.Price,
-		Subtotal:    e.Subtotal,
-		TotalAmount: e.TotalAmount,
+		ID:                  e.ID,
+		OrderID:             e.OrderID,
+		ServiceID:           e.ServiceID,
+		Quantity:            e.Quantity,
+		BilledQuantity:      billedQuantity(e),
+		PricingApplied:      e.PricingApplied,
+		Modifiers:           orderItemMapper.ModifiersFromEnt(e.Edges.Modifiers),
+		Price:               e.Price,
+		SurchargeAmount:     e.SurchargeAmount,
+		ModifierAmount:      e.ModifierAmount,
+		Subtotal:            e.Subtotal,
+		DiscountAmount:      e.DiscountAmount,
+		ServiceChargeAmount: e.ServiceChargeAmount,
+		TaxAmount:           e.TaxAmount,
+		TotalAmount:         e.TotalAmount,
 	}
 }
 
@@ -41,12 +50,21 @@ func ToItemResponse(d *orderItemDomain.OrderItem) *dto.OrderItemResponse {
 	}
 
 	return &dto.OrderItemResponse{
-		ID:        d.ID,
-		OrderID:   d.OrderID,
-		ServiceID: d.ServiceID,
-		Quantity:  d.Quantity,
-		Price:     d.Price,
-		Total:     d.TotalAmount,
+		ID:             d.ID,
+		OrderID:        d.OrderID,
+		ServiceID:      d.ServiceID,
+		Quantity:       d.Quantity,
+		BilledQuantity: d.BilledQuantity,
+		Pricing:        d.PricingApplied,
+		Modifiers:      toItemModifierResponseList(d.Modifiers),
+		Price:          d.Price,
+		Surcharge:      d.SurchargeAmount,
+		ModifierAmount: d.ModifierAmount,
+		Subtotal:       d.Subtotal,
+		Discount:       d.DiscountAmount,
+		ServiceCharge:  d.ServiceChargeAmount,
+		Tax:            d.TaxAmount,
+		Total:          d.TotalAmount,
 	}
 }
 
@@ -75,3 +93,33 @@ func ToItemResponsePage(data *pagination.PageData[orderItemDomain.OrderItem]) *p
 		Total: data.Total,
 	}
 }
+
+// toItemModifierResponseList converts the modifiers of an order item to response DTOs.
+func toItemModifierResponseList(modifiers []*orderItemDomain.OrderItemModifier) []*dto.OrderItemModifierResponse {
+	if modifiers == nil {
+		return nil
+	}
+
+	res := make([]*dto.OrderItemModifierResponse, len(modifiers))
+	for i, m := range modifiers {
+		res[i] = &dto.OrderItemModifierResponse{
+			ModifierID: m.ModifierID,
+			Name:       m.Name,
+			Type:       m.Type,
+			Price:      m.Price,
+			PerUnit:    m.PerUnit,
+			Percent:    m.Percent,
+			Amount:     m.Amount,
+		}
+	}
+	return res
+}
+
+// billedQuantity falls back to the ordered quantity for items placed before
+// pricing rules were recorded.
+func billedQuantity(e *ent.OrderItem) float64 {
+	if e.BilledQuantity == nil {
+		return e.Quantity
+	}
+	return *e.BilledQuantity
+}
@@ -1,14 +1,19 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/internal/feature/order/domain"
 	"github.com/umardev500/laundry/internal/feature/order/dto"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 
 	orderStatusHistoryMapper "github.com/umardev500/laundry/internal/feature/orderstatushistory/mapper"
 	paymentMapper "github.com/umardev500/laundry/internal/feature/payment/mapper"
+	promotionMapper "github.com/umardev500/laundry/internal/feature/promotion/mapper"
 )
 
 // FromEnt converts an Ent Order model to a domain Order.
@@ -18,21 +23,34 @@ func FromEnt(e *ent.Order) *domain.Order {
 	}
 
 	order := &domain.Order{
-		ID:           e.ID,
-		TenantID:     e.TenantID,
-		UserID:       e.UserID,
-		Status:       types.OrderStatus(e.Status),
-		TotalAmount:  e.TotalAmount,
-		Notes:        e.Notes,
-		GuestName:    e.GuestName,
-		GuestEmail:   e.GuestEmail,
-		GuestPhone:   e.GuestPhone,
-		GuestAddress: e.GuestAddress,
-		CreatedAt:    e.CreatedAt,
-		UpdatedAt:    e.UpdatedAt,
-		DeletedAt:    e.DeletedAt,
-		Payment:      paymentMapper.FromEnt(e.Edges.Payment),
-		Statuses:     orderStatusHistoryMapper.FromEntStatusHistoryList(e.Edges.StatusHistory),
+		ID:                  e.ID,
+		TenantID:            e.TenantID,
+		Code:                e.Code,
+		TrackingToken:       e.TrackingToken,
+		UserID:              e.UserID,
+		Status:              types.OrderStatus(e.Status),
+		TotalAmount:         e.TotalAmount,
+		DiscountAmount:      e.DiscountAmount,
+		ServiceChargeAmount: e.ServiceChargeAmount,
+		TaxAmount:           e.TaxAmount,
+		Tax: tax.Settings{
+			Rate:              e.TaxRate,
+			Inclusive:         e.TaxInclusive,
+			ServiceChargeRate: e.ServiceChargeRate,
+		},
+		Currency:      e.Currency,
+		Turnaround:    turnaround.Speed(e.Turnaround),
+		DueAt:         e.DueAt,
+		SLABreachedAt: e.SLABreachedAt,
+		Notes:         e.Notes,
+		GuestName:     e.GuestName,
+		GuestEmail:    e.GuestEmail,
+		GuestPhone:    e.GuestPhone,
+		GuestAddress:  e.GuestAddress,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+		DeletedAt:     e.DeletedAt,
+		Statuses:      orderStatusHistoryMapper.FromEntStatusHistoryList(e.Edges.StatusHistory),
 	}
 
 	// Convert related items if preloaded
@@ -40,6 +58,15 @@ func FromEnt(e *ent.Order) *domain.Order {
 		order.Items = FromEntItemList(e.Edges.Items)
 	}
 
+	if e.Edges.PromotionRedemptions != nil {
+		order.Discounts = promotionMapper.DiscountsFromRedemptions(e.Edges.PromotionRedemptions)
+	}
+
+	// Payments stay nil unless preloaded, so balances are only reported when known
+	if payments, err := e.Edges.PaymentsOrErr(); err == nil {
+		order.Payments = paymentMapper.FromEntList(payments)
+	}
+
 	return order
 }
 
@@ -58,24 +85,49 @@ func ToResponse(d *domain.Order) *dto.OrderResponse {
 		return nil
 	}
 
-	return &dto.OrderResponse{
-		ID:           d.ID,
-		TenantID:     d.TenantID,
-		UserID:       d.UserID,
-		Status:       d.Status,
-		TotalAmount:  d.TotalAmount,
-		Notes:        d.Notes,
-		GuestName:    d.GuestName,
-		GuestEmail:   d.GuestEmail,
-		GuestPhone:   d.GuestPhone,
-		GuestAddress: d.GuestAddress,
-		CreatedAt:    d.CreatedAt,
-		UpdatedAt:    d.UpdatedAt,
-		DeletedAt:    d.DeletedAt,
-		Items:        ToItemResponseList(d.Items),
-		Payment:      paymentMapper.ToResponse(d.Payment, nil),
-		Statuses:     orderStatusHistoryMapper.FromDomainList(d.Statuses, nil),
+	res := &dto.OrderResponse{
+		ID:                  d.ID,
+		TenantID:            d.TenantID,
+		Code:                d.Code,
+		TrackingToken:       d.TrackingToken,
+		UserID:              d.UserID,
+		Status:              d.Status,
+		SubtotalAmount:      d.Subtotal(),
+		DiscountAmount:      d.DiscountAmount,
+		TaxableAmount:       d.TaxableAmount(),
+		ServiceChargeAmount: d.ServiceChargeAmount,
+		TaxAmount:           d.TaxAmount,
+		TaxRate:             d.Tax.Rate,
+		TaxInclusive:        d.Tax.Inclusive,
+		ServiceChargeRate:   d.Tax.ServiceChargeRate,
+		TotalAmount:         d.TotalAmount,
+		Currency:            d.Currency,
+		Turnaround:          d.Turnaround,
+		DueAt:               d.DueAt,
+		SLABreachedAt:       d.SLABreachedAt,
+		Overdue:             d.IsOverdue(time.Now()),
+		Notes:               d.Notes,
+		GuestName:           d.GuestName,
+		GuestEmail:          d.GuestEmail,
+		GuestPhone:          d.GuestPhone,
+		GuestAddress:        d.GuestAddress,
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+		DeletedAt:           d.DeletedAt,
+		Items:               ToItemResponseList(d.Items),
+		Discounts:           promotionMapper.ToDiscountResponseList(d.Discounts),
+		Statuses:            orderStatusHistoryMapper.FromDomainList(d.Statuses, nil),
 	}
+
+	if d.Payments != nil {
+		paid, balance, state := d.PaidAmount(), d.Balance(), d.PaymentState()
+		res.Payments = paymentMapper.ToResponseList(d.Payments, nil)
+		res.PaidAmount = &paid
+		res.Balance = &balance
+		res.PaymentState = &state
+	}
+
+	return res
 }
 
 func ToResponseList(orders []*domain.Order) []*dto.OrderResponse {
@@ -0,0 +1,95 @@
+package mapper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/umardev500/laundry/internal/feature/order/domain"
+	"github.com/umardev500/laundry/internal/feature/order/dto"
+)
+
+// ToTaxSummaryResponse converts a domain TaxSummary to its DTO
+func ToTaxSummaryResponse(s *domain.TaxSummary) *dto.TaxSummaryResponse {
+	rates := make([]*dto.TaxRateTotalsResponse, len(s.Rates))
+	for i, r := range s.Rates {
+		rates[i] = &dto.TaxRateTotalsResponse{
+			TaxRate:           r.Tax.Rate,
+			TaxInclusive:      r.Tax.Inclusive,
+			ServiceChargeRate: r.Tax.ServiceChargeRate,
+			TaxTotalsResponse: toTaxTotalsResponse(r.TaxTotals),
+		}
+	}
+
+	return &dto.TaxSummaryResponse{
+		TenantID:          s.TenantID,
+		Month:             s.Month.Format("2006-01"),
+		GeneratedAt:       s.GeneratedAt,
+		Rates:             rates,
+		TaxTotalsResponse: toTaxTotalsResponse(s.TaxTotals),
+	}
+}
+
+// ToTaxSummaryCSV renders the report as a header followed by one row per set
+// of tax rules and a total row.
+func ToTaxSummaryCSV(s *domain.TaxSummary) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		{"Tax summary", s.Month.Format("2006-01")},
+		{"Tenant", s.TenantID.String()},
+		{"Generated at", s.GeneratedAt.Format(time.RFC3339)},
+		{},
+		{
+			"Tax rate", "Tax inclusive", "Service charge rate", "Orders", "Subtotal",
+			"Discount", "Taxable (DPP)", "Service charge", "PPN", "Total",
+		},
+	}
+	for _, r := range s.Rates {
+		rows = append(rows, taxTotalsRow(
+			formatRate(r.Tax.Rate),
+			strconv.FormatBool(r.Tax.Inclusive),
+			formatRate(r.Tax.ServiceChargeRate),
+			r.TaxTotals,
+		))
+	}
+	rows = append(rows, taxTotalsRow("Total", "", "", s.TaxTotals))
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func toTaxTotalsResponse(t domain.TaxTotals) dto.TaxTotalsResponse {
+	return dto.TaxTotalsResponse{
+		Orders:              t.Orders,
+		SubtotalAmount:      t.Subtotal,
+		DiscountAmount:      t.Discount,
+		TaxableAmount:       t.Taxable,
+		ServiceChargeAmount: t.ServiceCharge,
+		TaxAmount:           t.Tax,
+		TotalAmount:         t.Total,
+	}
+}
+
+func taxTotalsRow(rate, inclusive, serviceChargeRate string, t domain.TaxTotals) []string {
+	return []string{
+		rate,
+		inclusive,
+		serviceChargeRate,
+		strconv.Itoa(t.Orders),
+		t.Subtotal.String(),
+		t.Discount.String(),
+		t.Taxable.String(),
+		t.ServiceCharge.String(),
+		t.Tax.String(),
+		t.Total.String(),
+	}
+}
+
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', -1, 64)
+}
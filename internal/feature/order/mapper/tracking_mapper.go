@@ -0,0 +1,68 @@
+package mapper
+
+import (
+	"strings"
+	"time"
+
+	"github.com/umardev500/laundry/internal/feature/order/domain"
+	"github.com/umardev500/laundry/internal/feature/order/dto"
+)
+
+// ToTrackingResponse converts a domain Order to its public tracking view.
+func ToTrackingResponse(d *domain.Order) *dto.TrackingResponse {
+	if d == nil {
+		return nil
+	}
+
+	res := &dto.TrackingResponse{
+		Code:         d.Code,
+		Status:       d.Status,
+		Turnaround:   d.Turnaround,
+		DueAt:        d.DueAt,
+		Overdue:      d.IsOverdue(time.Now()),
+		Items:        make([]*dto.TrackingItemResponse, 0, len(d.Items)),
+		Currency:     d.Currency,
+		TotalAmount:  d.TotalAmount,
+		PaidAmount:   d.PaidAmount(),
+		Balance:      d.Balance(),
+		PaymentState: d.PaymentState(),
+		Timeline:     make([]*dto.TrackingStatusEntry, 0, len(d.Statuses)),
+		CreatedAt:    d.CreatedAt,
+	}
+
+	if d.GuestName != nil {
+		masked := maskName(*d.GuestName)
+		res.CustomerName = &masked
+	}
+
+	for _, item := range d.Items {
+		entry := &dto.TrackingItemResponse{
+			ServiceID: item.ServiceID,
+			Quantity:  item.Quantity,
+			Total:     item.TotalAmount,
+		}
+		for _, m := range item.Modifiers {
+			entry.Modifiers = append(entry.Modifiers, m.Name)
+		}
+		res.Items = append(res.Items, entry)
+	}
+
+	for _, s := range d.Statuses {
+		res.Timeline = append(res.Timeline, &dto.TrackingStatusEntry{
+			Status:    s.Status,
+			CreatedAt: s.CreatedAt,
+		})
+	}
+
+	return res
+}
+
+// maskName keeps the first letter of each word, e.g. "Budi Santoso" becomes
+// "B*** S***".
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, w := range words {
+		words[i] = string([]rune(w)[0]) + "***"
+	}
+	return strings.Join(words, " ")
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/umardev500/laundry/internal/feature/order/handler"
 	"github.com/umardev500/laundry/internal/feature/order/repository"
 	"github.com/umardev500/laundry/internal/feature/order/service"
+	"github.com/umardev500/laundry/internal/feature/order/worker"
 )
 
 // ProviderSet defines all dependencies for the Order feature.
@@ -13,4 +14,5 @@ var ProviderSet = wire.NewSet(
 	handler.NewHandler,
 	service.NewOrderService,
 	repository.NewEntRepository,
+	worker.NewSLAMonitor,
 )
@@ -12,8 +12,20 @@ const (
 	OrderUpdatedAtDesc   OrderBy = "updated_at_desc"
 	OrderTotalAmountAsc  OrderBy = "total_asc"
 	OrderTotalAmountDesc OrderBy = "total_desc"
+	OrderDueAtAsc        OrderBy = "due_asc"
 )
 
+// SLAFilter narrows orders still being worked on down by their due time.
+type SLAFilter string
+
+const (
+	SLAOverdue SLAFilter = "overdue" // past their due time
+	SLAAtRisk  SLAFilter = "at_risk" // due within AtRiskHours
+)
+
+// DefaultAtRiskHours is how close to their due time orders count as at risk.
+const DefaultAtRiskHours = 2
+
 type StatusesOrder string
 
 const (
@@ -28,11 +40,13 @@ type ListOrderQuery struct {
 	Status               *string       `query:"status"`
 	IncludeDeleted       bool          `query:"include_deleted"`
 	IncludeItems         bool          `query:"include_items"`
-	IncludePayment       bool          `query:"include_payment"`
+	IncludePayments      bool          `query:"include_payments"`
 	IncludePaymentMethod bool          `query:"include_payment_method"`
 	IncludeStatuses      bool          `query:"include_statuses"`
 	StatusOrder          StatusesOrder `query:"status_order"`
 	Order                OrderBy       `query:"order"`
+	SLA                  SLAFilter     `query:"sla"`
+	AtRiskHours          int           `query:"at_risk_hours"`
 }
 
 // Normalize applies default pagination and sort values.
@@ -45,4 +59,12 @@ func (q *ListOrderQuery) Normalize() {
 	if q.StatusOrder == "" {
 		q.StatusOrder = StatusesOrderAsc
 	}
+
+	if q.SLA != SLAOverdue && q.SLA != SLAAtRisk {
+		q.SLA = ""
+	}
+
+	if q.AtRiskHours <= 0 {
+		q.AtRiskHours = DefaultAtRiskHours
+	}
 }
@@ -1,9 +1,12 @@
 package query
 
+import "github.com/google/uuid"
+
 type OrderQuery struct {
+	TenantID             *uuid.UUID    `query:"tenant_id"` // Narrows a lookup by code to one tenant (optional)
 	IncludeDeleted       bool          `query:"include_deleted"`
 	IncludeItems         bool          `query:"include_items"`
-	IncludePayment       bool          `query:"include_payment"`
+	IncludePayments      bool          `query:"include_payments"`
 	IncludePaymentMethod bool          `query:"include_payment_method"`
 	IncludeStatuses      bool          `query:"include_statuses"`
 	StatusOrder          StatusesOrder `query:"status_order"`
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	"github.com/umardev500/laundry/internal/feature/order/domain"
+)
+
+// TaxSummaryFormat is how the report is exported.
+type TaxSummaryFormat string
+
+const (
+	TaxSummaryFormatJSON TaxSummaryFormat = "json"
+	TaxSummaryFormatCSV  TaxSummaryFormat = "csv"
+)
+
+// TaxSummaryQuery selects the month to report on, the current one when empty.
+type TaxSummaryQuery struct {
+	Month  string           `query:"month"` // YYYY-MM in server time
+	Format TaxSummaryFormat `query:"format"`
+}
+
+// Normalize sets the default format
+func (q *TaxSummaryQuery) Normalize() {
+	q.Format = TaxSummaryFormat(strings.ToLower(string(q.Format)))
+	if q.Format != TaxSummaryFormatCSV {
+		q.Format = TaxSummaryFormatJSON
+	}
+}
+
+// Start returns the start of the reported month.
+func (q *TaxSummaryQuery) Start() (time.Time, error) {
+	if q.Month == "" {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), nil
+	}
+
+	month, err := time.ParseInLocation("2006-01", q.Month, time.Local)
+	if err != nil {
+		return time.Time{}, domain.ErrInvalidReportMonth
+	}
+	return month, nil
+}
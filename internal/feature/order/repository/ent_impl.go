@@ -1,18 +1,41 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/ent/order"
+	"github.com/umardev500/laundry/ent/ordercodesequence"
+	"github.com/umardev500/laundry/ent/orderevent"
 	"github.com/umardev500/laundry/ent/orderstatushistory"
+	"github.com/umardev500/laundry/ent/predicate"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/order/domain"
 	"github.com/umardev500/laundry/internal/feature/order/mapper"
 	"github.com/umardev500/laundry/internal/feature/order/query"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
 )
 
+// unsoldStatuses are orders that did not end in a sale.
+var unsoldStatuses = []order.Status{
+	order.Status(types.OrderStatusPreview),
+	order.Status(types.OrderStatusCancelled),
+	order.Status(types.OrderStatusFailed),
+	order.Status(types.OrderStatusRefunded),
+}
+
+// inProgressStatuses are orders still being worked on.
+var inProgressStatuses = func() []order.Status {
+	statuses := make([]order.Status, len(types.InProgressOrderStatuses))
+	for i, s := range types.InProgressOrderStatuses {
+		statuses[i] = order.Status(s)
+	}
+	return statuses
+}()
+
 // entImpl implements Repository using Ent.
 type entImpl struct {
 	client *entdb.Client
@@ -20,24 +43,35 @@ type entImpl struct {
 
 // FindById implements Repository.
 func (r *entImpl) FindById(ctx *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error) {
+	return r.findOne(ctx, order.IDEQ(id), q)
+}
+
+// FindByTrackingToken implements Repository.
+func (r *entImpl) FindByTrackingToken(ctx *appctx.Context, token string, q *query.OrderQuery) (*domain.Order, error) {
+	return r.findOne(ctx, order.TrackingTokenEQ(token), q)
+}
+
+// findOne returns the order matching the predicate with the relations q asks for.
+func (r *entImpl) findOne(ctx *appctx.Context, where predicate.Order, q *query.OrderQuery) (*domain.Order, error) {
 	conn := r.client.GetConn(ctx)
 	qb := conn.Order.Query().
-		Where(order.IDEQ(id))
+		Where(where)
 
-	// Conditionally preload items
+	// Conditionally preload items and their modifiers, along with the discounts booked on them
 	if q.IncludeItems {
-		qb = qb.WithItems()
+		qb = qb.WithItems(func(iq *ent.OrderItemQuery) {
+			iq.WithModifiers()
+		}).WithPromotionRedemptions()
 	}
 
-	if q.IncludePayment {
-		qb = qb.WithPayment(func(pq *ent.PaymentQuery) {
+	if q.IncludePayments {
+		qb = qb.WithPayments(func(pq *ent.PaymentQuery) {
 			if q.IncludePaymentMethod {
 				pq.WithPaymentMethod()
 			}
 		})
 	}
 
-	// Conditionally preload status
 	// Conditionally preload status
 	if q.IncludeStatuses {
 		orderFunc := ent.Desc(orderstatushistory.FieldCreatedAt)
@@ -69,11 +103,12 @@ func (r *entImpl) Update(ctx *appctx.Context, o *domain.Order) (*domain.Order, e
 		SetStatus(order.Status(o.Status)).
 		SetNillableNotes(o.Notes).
 		SetTotalAmount(o.TotalAmount).
+		SetNillableDueAt(o.DueAt).
+		SetNillableSLABreachedAt(o.SLABreachedAt).
 		SetNillableGuestName(o.GuestName).
 		SetNillableGuestEmail(o.GuestEmail).
 		SetNillableGuestPhone(o.GuestPhone).
-		SetNillableGuestAddress(o.GuestAddress).
-		SetNillablePaymentID(o.PaymentID)
+		SetNillableGuestAddress(o.GuestAddress)
 
 	orderObj, err := builder.Save(ctx)
 	if err != nil {
@@ -94,14 +129,29 @@ func (r *entImpl) Create(ctx *appctx.Context, o *domain.Order) (*domain.Order, e
 
 	builder := conn.Order.Create().
 		SetTenantID(o.TenantID).
+		SetNillableUserID(o.UserID).
 		SetNillableNotes(o.Notes).
 		SetStatus(order.Status(o.Status)).
 		SetTotalAmount(o.TotalAmount).
+		SetDiscountAmount(o.DiscountAmount).
+		SetServiceChargeAmount(o.ServiceChargeAmount).
+		SetTaxAmount(o.TaxAmount).
+		SetTaxRate(o.Tax.Rate).
+		SetTaxInclusive(o.Tax.Inclusive).
+		SetServiceChargeRate(o.Tax.ServiceChargeRate).
+		SetCurrency(o.Currency.Normalize()).
+		SetNillableCode(o.Code).
+		SetNillableTrackingToken(o.TrackingToken).
+		SetNillableDueAt(o.DueAt).
 		SetNillableGuestName(o.GuestName).
 		SetNillableGuestEmail(o.GuestEmail).
 		SetNillableGuestPhone(o.GuestPhone).
 		SetNillableGuestAddress(o.GuestAddress)
 
+	if o.Turnaround != "" {
+		builder.SetTurnaround(order.Turnaround(o.Turnaround))
+	}
+
 	orderObj, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
@@ -123,13 +173,31 @@ func (r *entImpl) List(ctx *appctx.Context, q *query.ListOrderQuery) (*paginatio
 		qb = qb.Where(order.StatusEQ(order.Status(*q.Status)))
 	}
 
-	// Conditionally preload items
+	// Filter orders still being worked on by how close they are to their due time
+	now := time.Now()
+	switch q.SLA {
+	case query.SLAOverdue:
+		qb = qb.Where(
+			order.StatusIn(inProgressStatuses...),
+			order.DueAtLT(now),
+		)
+	case query.SLAAtRisk:
+		qb = qb.Where(
+			order.StatusIn(inProgressStatuses...),
+			order.DueAtGTE(now),
+			order.DueAtLT(now.Add(time.Duration(q.AtRiskHours)*time.Hour)),
+		)
+	}
+
+	// Conditionally preload items and their modifiers, along with the discounts booked on them
 	if q.IncludeItems {
-		qb = qb.WithItems()
+		qb = qb.WithItems(func(iq *ent.OrderItemQuery) {
+			iq.WithModifiers()
+		}).WithPromotionRedemptions()
 	}
 
-	if q.IncludePayment {
-		qb = qb.WithPayment(func(pq *ent.PaymentQuery) {
+	if q.IncludePayments {
+		qb = qb.WithPayments(func(pq *ent.PaymentQuery) {
 			if q.IncludePaymentMethod {
 				pq.WithPaymentMethod()
 			}
@@ -147,10 +215,11 @@ func (r *entImpl) List(ctx *appctx.Context, q *query.ListOrderQuery) (*paginatio
 		})
 	}
 
-	// Search by guest info
+	// Search by order code or guest info
 	if q.Search != "" {
 		qb = qb.Where(
 			order.Or(
+				order.CodeContainsFold(q.Search),
 				order.GuestNameContainsFold(q.Search),
 				order.GuestEmailContainsFold(q.Search),
 				order.GuestPhoneContainsFold(q.Search),
@@ -177,6 +246,8 @@ func (r *entImpl) List(ctx *appctx.Context, q *query.ListOrderQuery) (*paginatio
 		qb = qb.Order(ent.Asc(order.FieldTotalAmount))
 	case query.OrderTotalAmountDesc:
 		qb = qb.Order(ent.Desc(order.FieldTotalAmount))
+	case query.OrderDueAtAsc:
+		qb = qb.Order(ent.Asc(order.FieldDueAt), ent.Asc(order.FieldCreatedAt))
 	default:
 		qb = qb.Order(ent.Desc(order.FieldCreatedAt))
 	}
@@ -214,3 +285,102 @@ func (r *entImpl) applyScope(ctx *appctx.Context, qb *ent.OrderQuery) *ent.Order
 
 	return qb
 }
+
+// ListSalesBetween implements Repository.
+func (r *entImpl) ListSalesBetween(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]*domain.Order, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Order.
+		Query().
+		Where(
+			order.TenantIDEQ(tenantID),
+			order.StatusNotIn(unsoldStatuses...),
+			order.CreatedAtGTE(from),
+			order.CreatedAtLT(to),
+			order.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(order.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntList(ents), nil
+}
+
+// ListUnflaggedOverdue implements Repository.
+func (r *entImpl) ListUnflaggedOverdue(ctx *appctx.Context, now time.Time) ([]*domain.Order, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Order.
+		Query().
+		Where(
+			order.StatusIn(inProgressStatuses...),
+			order.DueAtLT(now),
+			order.SLABreachedAtIsNil(),
+			order.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(order.FieldDueAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntList(ents), nil
+}
+
+// CreateEvent implements Repository.
+func (r *entImpl) CreateEvent(ctx *appctx.Context, e *domain.OrderEvent) (*domain.OrderEvent, error) {
+	conn := r.client.GetConn(ctx)
+	eventObj, err := conn.OrderEvent.Create().
+		SetTenantID(e.TenantID).
+		SetOrderID(e.OrderID).
+		SetType(orderevent.Type(e.Type)).
+		SetData(e.Data).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.EventFromEnt(eventObj), nil
+}
+
+// FindIDsByCode implements Repository.
+func (r *entImpl) FindIDsByCode(ctx *appctx.Context, code string, tenantID *uuid.UUID) ([]uuid.UUID, error) {
+	conn := r.client.GetConn(ctx)
+	qb := conn.Order.Query().
+		Where(order.CodeEQ(code))
+	qb = r.applyScope(ctx, qb)
+
+	if tenantID != nil {
+		qb = qb.Where(order.TenantIDEQ(*tenantID))
+	}
+
+	return qb.IDs(ctx)
+}
+
+// NextCodeNumber implements Repository.
+func (r *entImpl) NextCodeNumber(ctx *appctx.Context, tenantID uuid.UUID, period string) (int, error) {
+	conn := r.client.GetConn(ctx)
+
+	// The first order of the period creates the counter, later ones bump it
+	id, err := conn.OrderCodeSequence.
+		Create().
+		SetTenantID(tenantID).
+		SetPeriod(period).
+		SetLastNumber(1).
+		OnConflictColumns(ordercodesequence.FieldTenantID, ordercodesequence.FieldPeriod).
+		Update(func(u *ent.OrderCodeSequenceUpsert) {
+			u.AddLastNumber(1)
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	seq, err := conn.OrderCodeSequence.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return seq.LastNumber, nil
+}
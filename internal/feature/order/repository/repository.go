@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/pkg/pagination"
 
@@ -12,6 +14,27 @@ import (
 type Repository interface {
 	Create(ctx *appctx.Context, o *domain.Order) (*domain.Order, error)
 	FindById(ctx *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error)
+	FindByTrackingToken(ctx *appctx.Context, token string, q *query.OrderQuery) (*domain.Order, error)
 	List(ctx *appctx.Context, q *query.ListOrderQuery) (*pagination.PageData[domain.Order], error)
 	Update(ctx *appctx.Context, o *domain.Order) (*domain.Order, error)
+
+	// FindIDsByCode returns the IDs of the orders in scope with the code, one
+	// per tenant at most. tenantID narrows them down to one tenant.
+	FindIDsByCode(ctx *appctx.Context, code string, tenantID *uuid.UUID) ([]uuid.UUID, error)
+
+	// NextCodeNumber counts another order of the tenant in the code period and
+	// returns its number. The counter stays locked until the surrounding
+	// transaction ends, so concurrent orders are numbered one after another
+	// and a rolled back order leaves no gap.
+	NextCodeNumber(ctx *appctx.Context, tenantID uuid.UUID, period string) (int, error)
+
+	// ListSalesBetween returns the tenant's orders placed in [from, to) that
+	// made a sale, i.e. were not cancelled, failed or refunded.
+	ListSalesBetween(ctx *appctx.Context, tenantID uuid.UUID, from, to time.Time) ([]*domain.Order, error)
+
+	// ListUnflaggedOverdue returns the orders of every tenant still being
+	// worked on past their due time whose SLA breach is not yet recorded.
+	ListUnflaggedOverdue(ctx *appctx.Context, now time.Time) ([]*domain.Order, error)
+
+	CreateEvent(ctx *appctx.Context, e *domain.OrderEvent) (*domain.OrderEvent, error)
 }
@@ -1,45 +1,110 @@
 package order
 
 import (
+	"context"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/order/handler"
+	"github.com/umardev500/laundry/internal/feature/order/worker"
+	"github.com/umardev500/laundry/internal/infra/database/redis"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+)
+
+// Tracking link limits used when the config leaves them out.
+const (
+	defaultTrackingMaxRequests = 30
+	defaultTrackingWindow      = time.Minute
 )
 
 // Routes defines all HTTP routes for the Order feature.
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
+	monitor  *worker.SLAMonitor
+	redis    *redis.RedisClient
+	config   *config.Config
 }
 
-// Ensure Routes implements router.RouteRegistrar.
-var _ router.RouteRegistrar = (*Routes)(nil)
+// Ensure Routes implements router.RouteRegistrar and router.BackgroundWorker.
+var (
+	_ router.RouteRegistrar   = (*Routes)(nil)
+	_ router.BackgroundWorker = (*Routes)(nil)
+)
 
 // RegisterRoutes registers all endpoints for orders.
 func (r *Routes) RegisterRoutes(router fiber.Router) {
+	// Public tracking link, limited per IP so tokens cannot be guessed by brute force
+	maxRequests, window := r.trackingLimit()
+	router.Get("/track/:token", middleware.RateLimit(r.redis, "track", maxRequests, window), r.handler.Track)
+
 	orders := router.Group("orders")
 
-	orders.Use(middleware.CheckAuth(r.config))
+	orders.Use(middleware.CheckAuth(r.keys, r.sessions))
 
-	// Since currently we only have List functionality
-	orders.Get("/", r.handler.List)
-	orders.Post("/guest", r.handler.GuestOrder)
-	orders.Post("/preview", r.handler.Preview)
-	orders.Get("/:id", r.handler.FindByID)
-	orders.Get("/:id/history", r.handler.History)
-	orders.Patch("/:id/status/:status", r.handler.UpdateStatus)
+	orders.Get("/", middleware.RequirePermission(r.authz, "view_order"), r.handler.List)
+	orders.Post("/", middleware.RequirePermission(r.authz, "create_order"), r.handler.Create)
+	orders.Post("/guest", middleware.RequirePermission(r.authz, "create_order"), r.handler.GuestOrder)
+	orders.Post("/preview", middleware.RequirePermission(r.authz, "create_order"), r.handler.Preview)
+	orders.Get("/tax-summary", middleware.RequirePermission(r.authz, "view_tax_summary"), r.handler.TaxSummary)
+	orders.Get("/by-code/:code", middleware.RequirePermission(r.authz, "view_order"), r.handler.FindByCode)
+	orders.Get("/:id", middleware.RequirePermission(r.authz, "view_order"), r.handler.FindByID)
+	orders.Get("/:id/history", middleware.RequirePermission(r.authz, "view_order"), r.handler.History)
+	orders.Patch("/:id/status/:status", middleware.RequirePermission(r.authz, "update_order"), r.handler.UpdateStatus)
+	orders.Post("/:id/payments", middleware.RequirePermission(r.authz, "update_order"), r.handler.AddPayment)
+	orders.Post("/:id/payments/settle", middleware.RequirePermission(r.authz, "update_order"), r.handler.SettlePayment)
+	orders.Post("/:id/payments/:payment_id/settle", middleware.RequirePermission(r.authz, "update_order"), r.handler.SettlePayment)
 
 	// If more handlers like Get, Create, Update, Delete are added later, register here
 	// orders.Put("/:id", r.handler.Update)
 	// orders.Delete("/:id", r.handler.Delete)
 }
 
+// trackingLimit returns how many tracking lookups an IP gets per window.
+func (r *Routes) trackingLimit() (int64, time.Duration) {
+	maxRequests := int64(defaultTrackingMaxRequests)
+	if r.config.Tracking.MaxRequestsPerIP > 0 {
+		maxRequests = r.config.Tracking.MaxRequestsPerIP
+	}
+
+	window := defaultTrackingWindow
+	if r.config.Tracking.WindowSeconds > 0 {
+		window = time.Duration(r.config.Tracking.WindowSeconds) * time.Second
+	}
+
+	return maxRequests, window
+}
+
+// RunBackground flags orders not ready by their due time until ctx is done.
+func (r *Routes) RunBackground(ctx context.Context) {
+	r.monitor.Run(ctx)
+}
+
 // NewRoutes creates a new Routes instance.
-func NewRoutes(h *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(
+	h *handler.Handler,
+	keys *jwtkeys.KeySet,
+	sessions authContract.SessionService,
+	authz rbacContract.AuthorizationService,
+	monitor *worker.SLAMonitor,
+	redisClient *redis.RedisClient,
+	cfg *config.Config,
+) *Routes {
 	return &Routes{
-		handler: h,
-		config:  config,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+		monitor:  monitor,
+		redis:    redisClient,
+		config:   cfg,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/umardev500/laundry/ent/order"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 	"github.com/umardev500/laundry/pkg/utils"
 )
@@ -33,7 +34,7 @@ func (s *OrderSeeder) Seed(ctx context.Context) error {
 		TenantID     uuid.UUID
 		UserID       *uuid.UUID
 		Status       types.OrderStatus
-		TotalAmount  float64
+		TotalAmount  money.Money
 		GuestName    *string
 		GuestEmail   *string
 		GuestPhone   *string
@@ -44,14 +45,14 @@ func (s *OrderSeeder) Seed(ctx context.Context) error {
 			TenantID:    uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
 			UserID:      utils.NilIfUUIDZero(userID2),
 			Status:      types.OrderStatusCompleted,
-			TotalAmount: 25.0,
+			TotalAmount: money.FromMajor(25),
 		},
 		{
 			ID:           uuid.MustParse("bbbbbbbb-2222-2222-2222-bbbbbbbb2222"),
 			TenantID:     uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
 			UserID:       nil,
 			Status:       types.OrderStatusCompleted,
-			TotalAmount:  40.0,
+			TotalAmount:  money.FromMajor(40),
 			GuestName:    utils.NilIfZero("Jane Smith", ""),
 			GuestEmail:   utils.NilIfZero("jane@example.com", ""),
 			GuestPhone:   utils.NilIfZero("123-456-7890", ""),
@@ -62,14 +63,14 @@ func (s *OrderSeeder) Seed(ctx context.Context) error {
 			TenantID:    uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
 			UserID:      utils.NilIfUUIDZero(userID1),
 			Status:      types.OrderStatusPending,
-			TotalAmount: 25.0,
+			TotalAmount: money.FromMajor(25),
 		},
 		{
 			ID:          uuid.MustParse("dddddddd-4444-4444-4444-dddddddd4444"),
 			TenantID:    uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
 			UserID:      utils.NilIfUUIDZero(userID4),
 			Status:      types.OrderStatusCancelled,
-			TotalAmount: 25.0,
+			TotalAmount: money.FromMajor(25),
 		},
 	}
 
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/order/contract"
@@ -13,18 +15,32 @@ import (
 	"github.com/umardev500/laundry/internal/feature/order/query"
 	"github.com/umardev500/laundry/internal/feature/order/repository"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/security"
 	"github.com/umardev500/laundry/pkg/types"
 
+	modifierContract "github.com/umardev500/laundry/internal/feature/modifier/contract"
 	orderItemContract "github.com/umardev500/laundry/internal/feature/orderitem/contract"
 	orderStatusHistoryContract "github.com/umardev500/laundry/internal/feature/orderstatushistory/contract"
 	orderStatusHistoryDomain "github.com/umardev500/laundry/internal/feature/orderstatushistory/domain"
 	paymentContract "github.com/umardev500/laundry/internal/feature/payment/contract"
 	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
 	paymentMethodContract "github.com/umardev500/laundry/internal/feature/paymentmethod/contract"
+	promotionContract "github.com/umardev500/laundry/internal/feature/promotion/contract"
+	promotionDomain "github.com/umardev500/laundry/internal/feature/promotion/domain"
 	serviceContract "github.com/umardev500/laundry/internal/feature/service/contract"
+	serviceDomain "github.com/umardev500/laundry/internal/feature/service/domain"
+	tenantContract "github.com/umardev500/laundry/internal/feature/tenant/contract"
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+	walletContract "github.com/umardev500/laundry/internal/feature/wallet/contract"
+	walletDomain "github.com/umardev500/laundry/internal/feature/wallet/domain"
 )
 
+// trackingTokenBytes is the entropy of an order's public tracking token.
+const trackingTokenBytes = 24
+
 // orderService implements OrderService interface.
 type orderService struct {
 	repo                 repository.Repository
@@ -34,6 +50,11 @@ type orderService struct {
 	paymentService       paymentContract.Service
 	paymentMethodService paymentMethodContract.Service
 	statusHistoryService orderStatusHistoryContract.StatusHistoryService
+	userService          userContract.Service
+	walletService        walletContract.Service
+	promotionService     promotionContract.Service
+	tenantService        tenantContract.Service
+	modifierService      modifierContract.Service
 }
 
 // NewOrderService creates a new OrderService.
@@ -45,6 +66,11 @@ func NewOrderService(
 	paymentService paymentContract.Service,
 	paymentMethodService paymentMethodContract.Service,
 	statusHistoryService orderStatusHistoryContract.StatusHistoryService,
+	userService userContract.Service,
+	walletService walletContract.Service,
+	promotionService promotionContract.Service,
+	tenantService tenantContract.Service,
+	modifierService modifierContract.Service,
 ) contract.OrderService {
 	return &orderService{
 		repo:                 repo,
@@ -54,64 +80,113 @@ func NewOrderService(
 		paymentService:       paymentService,
 		paymentMethodService: paymentMethodService,
 		statusHistoryService: statusHistoryService,
+		userService:          userService,
+		walletService:        walletService,
+		promotionService:     promotionService,
+		tenantService:        tenantService,
+		modifierService:      modifierService,
 	}
 }
 
 // Preview implements contract.OrderService.
 // It calculates the total amount and details for an order before payment or creation.
 func (s *orderService) Preview(ctx *appctx.Context, o *domain.Order) (*domain.Order, error) {
-	// 1️⃣ Get service availability
+	// 1️⃣ Services, prices and promotions belong to the tenant ordered at
+	if tenantID := ctx.TenantID(); tenantID != nil {
+		o.TenantID = *tenantID
+	}
+	if o.TenantID == uuid.Nil {
+		return nil, domain.ErrOrderTenantRequired
+	}
+	if ctx.Scope() == appctx.ScopeUser {
+		o.UserID = ctx.UserID()
+	}
+
+	// 2️⃣ Get service availability; services of other tenants are unavailable
 	serviceIDs := o.GetServiceIDs()
-	availability, err := s.service.AreItemsAvailable(ctx, serviceIDs)
+	availability, err := s.service.AreItemsAvailable(ctx, o.TenantID, serviceIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	// 2️⃣ If some services are not available, return which ones
+	// 3️⃣ If some services are not available, return which ones
 	if !availability.AllAvailable() {
 		return nil, domain.NewServiceUnavailableError(availability.UnavailableIDs())
 	}
 
-	// 3️⃣ Place the order temporarily (calculate totals but don’t persist)
-	if err := o.Place(availability.AvailableServices); err != nil {
+	if err := s.applyTenantSettings(ctx, o); err != nil {
 		return nil, err
 	}
 
-	// 4️⃣ You can set a default status for the preview
+	// 4️⃣ Place the order temporarily (calculate totals but don’t persist)
+	modifiers, err := s.modifierService.GetAvailable(ctx, o.GetModifierIDs())
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Place(availability.AvailableServices, modifiers); err != nil {
+		return nil, err
+	}
+
+	// 5️⃣ Take off the promo codes and automatic promotions
+	if _, err := s.applyPromotions(ctx, o, availability.AvailableServices); err != nil {
+		return nil, err
+	}
+
+	// 6️⃣ You can set a default status for the preview
 	o.Status = types.OrderStatusPreview
 
 	return o, nil
 }
 
 // CreatePayment implements contract.OrderService.
-func (s *orderService) CreatePayment(ctx *appctx.Context, o *domain.Order) (*paymentDomain.Payment, error) {
-	pymnt := o.Payment
-	receivedAmount := pymnt.ReceivedAmount
-	pm := pymnt.PaymentMethodID
-
+func (s *orderService) CreatePayment(ctx *appctx.Context, o *domain.Order, payment *paymentDomain.Payment) (*paymentDomain.Payment, error) {
 	// Get payment method
-	m, err := s.paymentMethodService.GetByID(ctx, pm)
+	m, err := s.paymentMethodService.GetByID(ctx, payment.PaymentMethodID)
 	if err != nil {
 		return nil, err
 	}
 
-	payment := o.Payment
-	payment.TenantID = ctx.TenantID()
+	if err := o.AllocatePayment(payment, m.Type); err != nil {
+		return nil, err
+	}
+
+	payment.TenantID = &o.TenantID
+	payment.UserID = o.UserID
 	payment.RefID = o.ID
 	payment.RefType = types.PaymentTypeOrder
-	payment.Amount = o.TotalAmount
 	payment.Status = types.PaymentStatusPending
 
-	if m.Type == types.PaymentMethodCash {
-		if receivedAmount == nil || *receivedAmount < o.TotalAmount {
+	switch m.Type {
+	case types.PaymentMethodCash:
+		received := payment.Amount
+		if payment.ReceivedAmount != nil {
+			received = *payment.ReceivedAmount
+		}
+		if received < payment.Amount {
 			return nil, paymentDomain.ErrInsufficientPayment
 		}
 
 		now := time.Now()
 		payment.Status = types.PaymentStatusPaid
-		change := *receivedAmount - o.TotalAmount
+		change := received - payment.Amount
+		payment.ReceivedAmount = &received
 		payment.ChangeAmount = &change
 		payment.PaidAt = &now
+	case types.PaymentMethodWallet:
+		// Wallet payments are debited below, in the same transaction
+		if o.UserID == nil {
+			return nil, walletDomain.ErrWalletCustomerRequired
+		}
+
+		now := time.Now()
+		var change money.Money
+		payment.Status = types.PaymentStatusPaid
+		payment.ReceivedAmount = &payment.Amount
+		payment.ChangeAmount = &change
+		payment.PaidAt = &now
+	default:
+		// Non-cash payments record what was received once they are settled.
+		payment.ReceivedAmount = nil
 	}
 
 	if err := payment.Validate(); err != nil {
@@ -123,98 +198,269 @@ func (s *orderService) CreatePayment(ctx *appctx.Context, o *domain.Order) (*pay
 		return nil, err
 	}
 
+	if m.Type == types.PaymentMethodWallet {
+		if _, err := s.walletService.Spend(ctx, o.TenantID, *o.UserID, payment.Amount, payment.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	o.Payments = append(o.Payments, payment)
 	return payment, nil
 }
 
 // GuestOrder implements contract.OrderService.
 func (s *orderService) GuestOrder(ctx *appctx.Context, o *domain.Order) (*domain.Order, error) {
-	serviceIDs := o.GetServiceIDs()
-	availability, err := s.service.AreItemsAvailable(ctx, serviceIDs)
+	// Staff take guest orders for their own tenant; everyone else names it
+	if tenantID := ctx.TenantID(); tenantID != nil {
+		o.TenantID = *tenantID
+	}
+
+	return s.place(ctx, o)
+}
+
+// Create implements contract.OrderService.
+func (s *orderService) Create(ctx *appctx.Context, o *domain.Order) (*domain.Order, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, types.ErrTenantIDRequired
+	}
+	o.TenantID = *tenantID
+
+	if o.UserID != nil {
+		if err := s.checkCustomer(ctx, *o.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.place(ctx, o)
+}
+
+// List returns a paginated list of orders.
+func (s *orderService) List(ctx *appctx.Context, q *query.ListOrderQuery) (*pagination.PageData[domain.Order], error) {
+	q.Normalize()
+
+	// The repository already handles scope filtering (tenant/user/admin)
+	return s.repo.List(ctx, q)
+}
+
+// FindByID implements contract.OrderService.
+func (s *orderService) FindByID(ctx *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error) {
+	q.Normalize()
+
+	return s.findExisting(ctx, id, q)
+}
+
+// FindByCode implements contract.OrderService.
+func (s *orderService) FindByCode(ctx *appctx.Context, code string, q *query.OrderQuery) (*domain.Order, error) {
+	q.Normalize()
+
+	ids, err := s.repo.FindIDsByCode(ctx, strings.ToUpper(strings.TrimSpace(code)), q.TenantID)
 	if err != nil {
 		return nil, err
 	}
 
-	if !availability.AllAvailable() {
-		return nil, domain.NewServiceUnavailableError(availability.UnavailableIDs())
+	switch len(ids) {
+	case 0:
+		return nil, domain.ErrOrderNotFound
+	case 1:
+		return s.findExisting(ctx, ids[0], q)
+	default:
+		return nil, domain.ErrAmbiguousOrderCode
 	}
+}
 
-	// Init defaults
-	o.InitDefaults()
-
-	// Validate the input
-	if err := o.Validate(); err != nil {
+// Track implements contract.OrderService.
+func (s *orderService) Track(ctx *appctx.Context, token string) (*domain.Order, error) {
+	o, err := s.repo.FindByTrackingToken(ctx, token, &query.OrderQuery{
+		IncludeItems:    true,
+		IncludePayments: true,
+		IncludeStatuses: true,
+		StatusOrder:     query.StatusesOrderAsc,
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrOrderNotFound
+		}
 		return nil, err
 	}
 
-	// Validate
-	if err := o.Validate(); err != nil {
+	return o, nil
+}
+
+// UpdateStatus implements contract.OrderService.
+func (s *orderService) UpdateStatus(ctx *appctx.Context, o *domain.Order) (*domain.Order, error) {
+	var updateOrder *domain.Order
+	var err error
+
+	// Payments are needed to check the balance before completing
+	order, err := s.findExisting(ctx, o.ID, &query.OrderQuery{IncludePayments: true})
+	if err != nil {
 		return nil, err
 	}
 
-	if err := o.Place(availability.AvailableServices); err != nil {
+	if err := order.UpdateStatus(o.Status); err != nil {
 		return nil, err
 	}
 
-	var result *domain.Order
+	// Becoming ready late breaches the SLA too
+	breached := order.CheckSLA(time.Now())
+
 	err = s.client.WithTransaction(ctx, func(txCtx context.Context) error {
-		var err error
 		newCtx := appctx.New(txCtx)
 
-		// Create the order
-		result, err = s.repo.Create(newCtx, o)
+		updateOrder, err = s.repo.Update(newCtx, order)
 		if err != nil {
 			return err
 		}
 
-		// 🔹 Attach the generated OrderID to each order item before creating them
-		o.AttachOrderID(result.ID)
+		// --- sync with payments ---
+		if err := s.syncPaymentStatus(newCtx, order, o.Status); err != nil {
+			return err
+		}
 
-		// Create the order items
-		createdItems, err := s.orderItemService.Create(newCtx, o.Items)
+		// Create order status history
+		_, err = s.statusHistoryService.Create(newCtx, &orderStatusHistoryDomain.OrderStatusHistory{
+			OrderID: order.ID,
+			Status:  order.Status,
+		})
 		if err != nil {
 			return err
 		}
 
-		// Assign the created items to the result
-		result.Items = createdItems
-		result.Payment = o.Payment // Assign the payment details from the input order
+		if breached {
+			if _, err := s.repo.CreateEvent(newCtx, domain.NewSLABreachedEvent(order)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updateOrder, nil
+}
+
+// RecordSLABreaches implements contract.OrderService.
+func (s *orderService) RecordSLABreaches(ctx *appctx.Context, now time.Time) (int, error) {
+	orders, err := s.repo.ListUnflaggedOverdue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
 
-		// Create the payment
-		p, err := s.CreatePayment(newCtx, result)
+	recorded := 0
+	for _, order := range orders {
+		if !order.CheckSLA(now) {
+			continue
+		}
+
+		err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+			newCtx := appctx.New(txCtx)
+
+			if _, err := s.repo.Update(newCtx, order); err != nil {
+				return err
+			}
+
+			_, err := s.repo.CreateEvent(newCtx, domain.NewSLABreachedEvent(order))
+			return err
+		})
+		if err != nil {
+			return recorded, err
+		}
+		recorded++
+	}
+
+	return recorded, nil
+}
+
+// AddPayment implements contract.OrderService.
+func (s *orderService) AddPayment(ctx *appctx.Context, id uuid.UUID, payment *paymentDomain.Payment) (*domain.Order, error) {
+	var result *domain.Order
+
+	err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		order, err := s.findExisting(newCtx, id, &query.OrderQuery{IncludePayments: true})
 		if err != nil {
 			return err
 		}
 
-		// Map payment → order status
-		newOrderStatus := types.MapPaymentToOrderStatus(p.Status, result.Status)
+		if _, err := s.CreatePayment(newCtx, order, payment); err != nil {
+			return err
+		}
+
+		if err := s.advanceWithPayments(newCtx, order); err != nil {
+			return err
+		}
 
-		// Only update if status changed
-		if newOrderStatus != result.Status {
-			result.Status = newOrderStatus
+		result = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.openCharges(ctx, result)
+
+	return result, nil
+}
+
+// SettlePayment implements contract.OrderService.
+func (s *orderService) SettlePayment(ctx *appctx.Context, id uuid.UUID, paymentID *uuid.UUID, receivedAmount *money.Money) (*domain.Order, error) {
+	return s.settle(ctx, id, paymentID, func(ctx *appctx.Context, p *paymentDomain.Payment) (*paymentDomain.Payment, error) {
+		received := p.Amount
+		if receivedAmount != nil {
+			received = *receivedAmount
 		}
 
-		// Update the order with the payment ID
-		result.PaymentID = &p.ID
-		_, err = s.repo.Update(newCtx, result)
+		return s.paymentService.MarkPaid(ctx, p.ID, received)
+	})
+}
+
+// SettleCharge implements contract.OrderService.
+func (s *orderService) SettleCharge(ctx *appctx.Context, id uuid.UUID, paymentID uuid.UUID) (*domain.Order, error) {
+	return s.settle(ctx, id, &paymentID, func(ctx *appctx.Context, p *paymentDomain.Payment) (*paymentDomain.Payment, error) {
+		return s.paymentService.SettleCharge(ctx, p.ID, p.Amount)
+	})
+}
+
+// settle completes one of the order's pending payments with complete and moves
+// the order to the status its payments call for, in one transaction.
+func (s *orderService) settle(
+	ctx *appctx.Context,
+	id uuid.UUID,
+	paymentID *uuid.UUID,
+	complete func(ctx *appctx.Context, p *paymentDomain.Payment) (*paymentDomain.Payment, error),
+) (*domain.Order, error) {
+	var result *domain.Order
+
+	err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		order, err := s.findExisting(newCtx, id, &query.OrderQuery{IncludePayments: true})
 		if err != nil {
 			return err
 		}
 
-		// Assign the payment details from the created payment
-		result.Payment = p
+		payment, err := order.PendingPayment(paymentID)
+		if err != nil {
+			return err
+		}
 
-		// Create order status history
-		_, err = s.statusHistoryService.Create(newCtx, &orderStatusHistoryDomain.OrderStatusHistory{
-			OrderID: result.ID,
-			Status:  result.Status,
-		})
+		p, err := complete(newCtx, payment)
 		if err != nil {
 			return err
 		}
+		order.ReplacePayment(p)
 
+		if err := s.advanceWithPayments(newCtx, order); err != nil {
+			return err
+		}
+
+		result = order
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
@@ -222,60 +468,147 @@ func (s *orderService) GuestOrder(ctx *appctx.Context, o *domain.Order) (*domain
 	return result, nil
 }
 
-// List returns a paginated list of orders.
-func (s *orderService) List(ctx *appctx.Context, q *query.ListOrderQuery) (*pagination.PageData[domain.Order], error) {
-	q.Normalize()
+// TaxSummary implements contract.OrderService.
+func (s *orderService) TaxSummary(ctx *appctx.Context, month time.Time) (*domain.TaxSummary, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, types.ErrTenantIDRequired
+	}
 
-	// The repository already handles scope filtering (tenant/user/admin)
-	return s.repo.List(ctx, q)
-}
+	orders, err := s.repo.ListSalesBetween(ctx, *tenantID, month, month.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
 
-// FindByID implements contract.OrderService.
-func (s *orderService) FindByID(ctx *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error) {
-	q.Normalize()
+	summary := &domain.TaxSummary{
+		TenantID:    *tenantID,
+		Month:       month,
+		GeneratedAt: time.Now(),
+		Rates:       []*domain.TaxRateTotals{},
+	}
+	summary.Summarize(orders)
 
-	return s.findExisting(ctx, id, q)
+	return summary, nil
 }
 
-// UpdateStatus implements contract.OrderService.
-func (s *orderService) UpdateStatus(ctx *appctx.Context, o *domain.Order) (*domain.Order, error) {
-	var updateOrder *domain.Order
-	var err error
+// -----------------------
+// Helper methods
+// -----------------------
+
+// place checks availability, prices the order and stores it together with its
+// code, items, payment and first status history entry in one transaction.
+func (s *orderService) place(ctx *appctx.Context, o *domain.Order) (*domain.Order, error) {
+	if o.TenantID == uuid.Nil {
+		return nil, domain.ErrOrderTenantRequired
+	}
 
-	order, err := s.findExisting(ctx, o.ID, nil)
+	// Only the tenant's own services can be ordered and priced
+	serviceIDs := o.GetServiceIDs()
+	availability, err := s.service.AreItemsAvailable(ctx, o.TenantID, serviceIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := order.UpdateStatus(o.Status); err != nil {
+	if !availability.AllAvailable() {
+		return nil, domain.NewServiceUnavailableError(availability.UnavailableIDs())
+	}
+
+	// Init defaults
+	o.InitDefaults()
+
+	// Validate the input
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyTenantSettings(ctx, o); err != nil {
 		return nil, err
 	}
 
+	modifiers, err := s.modifierService.GetAvailable(ctx, o.GetModifierIDs())
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Place(availability.AvailableServices, modifiers); err != nil {
+		return nil, err
+	}
+
+	cart, err := s.applyPromotions(ctx, o, availability.AvailableServices)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anyone with the tracking link can follow the order, so it must not be guessable
+	token, err := security.RandomToken(trackingTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	o.TrackingToken = &token
+
+	// Payments are allocated once the order exists and its total is known.
+	requested := o.Payments
+	o.Payments = nil
+
+	var result *domain.Order
 	err = s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
 		newCtx := appctx.New(txCtx)
 
-		updateOrder, err = s.repo.Update(newCtx, order)
+		// Number the order
+		period := o.CodePeriod(time.Now())
+		seq, err := s.repo.NextCodeNumber(newCtx, o.TenantID, period)
 		if err != nil {
 			return err
 		}
+		o.AssignCode(period, seq)
 
-		// Find order by id include payment
-		updatedOrder, err := s.findExisting(newCtx, order.ID, &query.OrderQuery{
-			IncludePayment: true,
-		})
+		// Create the order
+		result, err = s.repo.Create(newCtx, o)
 		if err != nil {
 			return err
 		}
 
-		// --- sync with payment ---
-		if err := s.syncPaymentStatus(newCtx, updatedOrder, o.Status); err != nil {
+		// 🔹 Attach the generated OrderID to each order item before creating them
+		o.AttachOrderID(result.ID)
+
+		// Create the order items
+		createdItems, err := s.orderItemService.Create(newCtx, o.Items)
+		if err != nil {
 			return err
 		}
 
+		// Assign the created items to the result
+		result.Items = createdItems
+
+		// Count the promotions against their usage limits
+		if len(o.Discounts) > 0 {
+			if err := s.promotionService.Redeem(newCtx, result.ID, cart, o.Discounts); err != nil {
+				return err
+			}
+		}
+		result.Discounts = o.Discounts
+
+		// Create the payments taken with the order, in the requested order
+		result.Payments = []*paymentDomain.Payment{}
+		for _, p := range requested {
+			if _, err := s.CreatePayment(newCtx, result, p); err != nil {
+				return err
+			}
+		}
+
+		// Map settled payments → order status
+		if newOrderStatus := statusAfterPayments(result); newOrderStatus != result.Status {
+			result.Status = newOrderStatus
+
+			if _, err := s.repo.Update(newCtx, result); err != nil {
+				return err
+			}
+		}
+
 		// Create order status history
 		_, err = s.statusHistoryService.Create(newCtx, &orderStatusHistoryDomain.OrderStatusHistory{
-			OrderID: updatedOrder.ID,
-			Status:  updatedOrder.Status,
+			OrderID: result.ID,
+			Status:  result.Status,
 		})
 		if err != nil {
 			return err
@@ -283,36 +616,135 @@ func (s *orderService) UpdateStatus(ctx *appctx.Context, o *domain.Order) (*doma
 
 		return nil
 	})
+
 	if err != nil {
 		return nil, err
 	}
 
-	return updateOrder, nil
+	s.openCharges(ctx, result)
+
+	return result, nil
 }
 
-// -----------------------
-// Helper methods
-// -----------------------
+// openCharges opens the gateway charges of the order's online payments once
+// they are committed, so a rolled back order never leaves a payable charge
+// behind. The order stands when the gateway fails; refreshing the payment
+// opens its charge later.
+func (s *orderService) openCharges(ctx *appctx.Context, o *domain.Order) {
+	for i, p := range o.Payments {
+		if !p.IsOnline() || p.GatewayChargeID != nil || p.Status != types.PaymentStatusPending {
+			continue
+		}
 
-func (s *orderService) syncPaymentStatus(ctx *appctx.Context, order *domain.Order, status types.OrderStatus) error {
-	if order.Payment == nil {
-		return fmt.Errorf("payment is nil")
+		charged, err := s.paymentService.OpenCharge(ctx, p.ID)
+		if err != nil {
+			log.Error().Err(err).Str("payment_id", p.ID.String()).Msg("Failed to open gateway charge")
+			continue
+		}
+
+		o.Payments[i] = charged
 	}
+}
 
-	payment := order.Payment
-	var newPaymentStatus types.PaymentStatus = types.MapOrderToPaymentStatus(status, payment.Status)
+// applyTenantSettings copies the tenant's PPN and service charge onto the
+// order, so they are applied when it is priced and kept with it, along with
+// the business hours its due time is worked out with.
+func (s *orderService) applyTenantSettings(ctx *appctx.Context, o *domain.Order) error {
+	tenant, err := s.tenantService.GetByID(ctx, o.TenantID)
+	if err != nil {
+		return err
+	}
+
+	o.Tax = tenant.Tax
+	o.Calendar = tenant.Calendar
+	o.CodeFormat = tenant.OrderCode
+	return nil
+}
+
+// applyPromotions works out the discounts of the entered promo codes and the
+// automatic promotions and books them on the priced order. It returns the cart
+// they were worked out on.
+func (s *orderService) applyPromotions(ctx *appctx.Context, o *domain.Order, availableServices []*serviceDomain.Service) (*promotionDomain.Cart, error) {
+	cart := o.Cart(availableServices)
+
+	discounts, err := s.promotionService.Evaluate(ctx, cart, o.PromoCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	o.ApplyDiscounts(discounts)
+	return cart, nil
+}
+
+// checkCustomer ensures the order is placed for an existing, active customer.
+func (s *orderService) checkCustomer(ctx *appctx.Context, userID uuid.UUID) error {
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrUserNotFound) || errors.Is(err, userDomain.ErrUserDeleted) {
+			return domain.ErrCustomerNotFound
+		}
+		return err
+	}
+
+	if user.Status != types.UserStatusActive {
+		return domain.ErrCustomerInactive
+	}
+
+	return nil
+}
+
+// advanceWithPayments moves the order along after its payments changed and
+// records the new status.
+func (s *orderService) advanceWithPayments(ctx *appctx.Context, order *domain.Order) error {
+	newOrderStatus := statusAfterPayments(order)
+	if newOrderStatus == order.Status {
+		return nil
+	}
+
+	if err := order.UpdateStatus(newOrderStatus); err != nil {
+		return err
+	}
+
+	if _, err := s.repo.Update(ctx, order); err != nil {
+		return err
+	}
+
+	_, err := s.statusHistoryService.Create(ctx, &orderStatusHistoryDomain.OrderStatusHistory{
+		OrderID: order.ID,
+		Status:  order.Status,
+	})
+	return err
+}
+
+// syncPaymentStatus applies the order status to the payments it affects, e.g.
+// cancelling an order cancels its pending payments. Payments that cannot make
+// the transition, like an already settled deposit, are left as they are.
+func (s *orderService) syncPaymentStatus(ctx *appctx.Context, order *domain.Order, status types.OrderStatus) error {
+	for _, payment := range order.Payments {
+		newPaymentStatus := types.MapOrderToPaymentStatus(status, payment.Status)
+
+		//  Only update if different and allowed
+		if payment.Status == newPaymentStatus || !payment.Status.CanTransitionTo(newPaymentStatus) {
+			continue
+		}
 
-	//  Only update if different
-	if payment.Status != newPaymentStatus {
 		payment.Status = newPaymentStatus
-		_, err := s.paymentService.UpdateStatus(ctx, payment)
-		if err != nil {
+		if _, err := s.paymentService.UpdateStatus(ctx, payment); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// statusAfterPayments returns the order status implied by its settled payments.
+// A deposit is enough to confirm the order.
+func statusAfterPayments(o *domain.Order) types.OrderStatus {
+	if o.PaidAmount() > 0 {
+		return types.MapPaymentToOrderStatus(types.PaymentStatusPaid, o.Status)
+	}
+	return o.Status
+}
+
 // findExisting ensures the payment exists, is not soft-deleted, and belongs to tenant
 func (s *orderService) findExisting(ctx *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error) {
 	if q == nil {
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/order/domain"
+	"github.com/umardev500/laundry/internal/feature/order/query"
+	"github.com/umardev500/laundry/internal/feature/order/repository"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+
+	orderStatusHistoryContract "github.com/umardev500/laundry/internal/feature/orderstatushistory/contract"
+	orderStatusHistoryDomain "github.com/umardev500/laundry/internal/feature/orderstatushistory/domain"
+	paymentContract "github.com/umardev500/laundry/internal/feature/payment/contract"
+	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+)
+
+// memoryOrders is an in-memory order repository. Payments are read from the
+// payment stub, as the ent repository reads them from their own table.
+type memoryOrders struct {
+	repository.Repository
+	orders   map[uuid.UUID]domain.Order
+	payments *memoryPayments
+}
+
+func (m *memoryOrders) FindById(_ *appctx.Context, id uuid.UUID, q *query.OrderQuery) (*domain.Order, error) {
+	o, ok := m.orders[id]
+	if !ok {
+		return nil, &ent.NotFoundError{}
+	}
+
+	o.Payments = nil
+	if q != nil && q.IncludePayments {
+		for _, p := range m.payments.payments {
+			if p.RefID == id {
+				found := *p
+				o.Payments = append(o.Payments, &found)
+			}
+		}
+	}
+	return &o, nil
+}
+
+func (m *memoryOrders) Update(_ *appctx.Context, o *domain.Order) (*domain.Order, error) {
+	stored := *o
+	stored.Payments = nil
+	m.orders[o.ID] = stored
+	return o, nil
+}
+
+// memoryPayments settles payments in memory.
+type memoryPayments struct {
+	paymentContract.Service
+	payments map[uuid.UUID]*paymentDomain.Payment
+}
+
+func (m *memoryPayments) MarkPaid(_ *appctx.Context, id uuid.UUID, receivedAmount money.Money) (*paymentDomain.Payment, error) {
+	p := m.payments[id]
+	p.Status = types.PaymentStatusPaid
+	p.ReceivedAmount = &receivedAmount
+	paid := *p
+	return &paid, nil
+}
+
+func (m *memoryPayments) UpdateStatus(_ *appctx.Context, p *paymentDomain.Payment) (*paymentDomain.Payment, error) {
+	m.payments[p.ID].Status = p.Status
+	updated := *m.payments[p.ID]
+	return &updated, nil
+}
+
+// discardHistory drops status history entries.
+type discardHistory struct {
+	orderStatusHistoryContract.StatusHistoryService
+}
+
+func (discardHistory) Create(_ *appctx.Context, sh *orderStatusHistoryDomain.OrderStatusHistory) (*orderStatusHistoryDomain.OrderStatusHistory, error) {
+	return sh, nil
+}
+
+func TestSplitPaymentCompletesOrder(t *testing.T) {
+	ctx := appctx.New(context.Background())
+	orderID := uuid.New()
+
+	deposit := &paymentDomain.Payment{ID: uuid.New(), RefID: orderID, RefType: types.PaymentTypeOrder, Amount: 40_000, Status: types.PaymentStatusPending}
+	rest := &paymentDomain.Payment{ID: uuid.New(), RefID: orderID, RefType: types.PaymentTypeOrder, Amount: 60_000, Status: types.PaymentStatusPending}
+
+	payments := &memoryPayments{payments: map[uuid.UUID]*paymentDomain.Payment{deposit.ID: deposit, rest.ID: rest}}
+	orders := &memoryOrders{
+		orders:   map[uuid.UUID]domain.Order{orderID: {ID: orderID, TotalAmount: 100_000, Status: types.OrderStatusPending}},
+		payments: payments,
+	}
+
+	s := &orderService{
+		repo:                 orders,
+		client:               entdb.NewNopClient(),
+		paymentService:       payments,
+		statusHistoryService: discardHistory{},
+	}
+
+	steps := []struct {
+		name      string
+		run       func() (*domain.Order, error)
+		wantErr   error
+		wantState types.OrderPaymentState
+		wantOwed  money.Money
+		wantOrder types.OrderStatus
+	}{
+		{
+			name:      "deposit confirms the order",
+			run:       func() (*domain.Order, error) { return s.SettlePayment(ctx, orderID, &deposit.ID, nil) },
+			wantState: types.OrderPaymentStatePartiallyPaid,
+			wantOwed:  60_000,
+			wantOrder: types.OrderStatusConfirmed,
+		},
+		{
+			name: "laundry is delivered",
+			run: func() (*domain.Order, error) {
+				o := orders.orders[orderID]
+				o.Status = types.OrderStatusDelivered
+				orders.orders[orderID] = o
+				return s.FindByID(ctx, orderID, &query.OrderQuery{IncludePayments: true})
+			},
+			wantState: types.OrderPaymentStatePartiallyPaid,
+			wantOwed:  60_000,
+			wantOrder: types.OrderStatusDelivered,
+		},
+		{
+			name: "cannot complete with a balance",
+			run: func() (*domain.Order, error) {
+				return s.UpdateStatus(ctx, &domain.Order{ID: orderID, Status: types.OrderStatusCompleted})
+			},
+			wantErr: domain.ErrOutstandingBalance,
+		},
+		{
+			name:      "rest settles the balance",
+			run:       func() (*domain.Order, error) { return s.SettlePayment(ctx, orderID, &rest.ID, nil) },
+			wantState: types.OrderPaymentStatePaid,
+			wantOwed:  0,
+			wantOrder: types.OrderStatusDelivered,
+		},
+		{
+			name: "completes once paid",
+			run: func() (*domain.Order, error) {
+				return s.UpdateStatus(ctx, &domain.Order{ID: orderID, Status: types.OrderStatusCompleted})
+			},
+			wantState: types.OrderPaymentStatePaid,
+			wantOwed:  0,
+			wantOrder: types.OrderStatusCompleted,
+		},
+	}
+
+	for _, step := range steps {
+		got, err := step.run()
+		if step.wantErr != nil {
+			if !errors.Is(err, step.wantErr) {
+				t.Fatalf("%s: error = %v, want %v", step.name, err, step.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+
+		if got.Status != step.wantOrder {
+			t.Errorf("%s: order status = %s, want %s", step.name, got.Status, step.wantOrder)
+		}
+		if got.PaymentState() != step.wantState || got.Balance() != step.wantOwed {
+			t.Errorf("%s: payment = %s owing %s, want %s owing %s", step.name, got.PaymentState(), got.Balance(), step.wantState, step.wantOwed)
+		}
+	}
+
+	if stored := orders.orders[orderID]; stored.Status != types.OrderStatusCompleted {
+		t.Errorf("stored order status = %s, want %s", stored.Status, types.OrderStatusCompleted)
+	}
+}
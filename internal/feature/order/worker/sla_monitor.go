@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/internal/feature/order/contract"
+)
+
+// defaultCheckInterval is how often overdue orders are looked for when the
+// config does not say.
+const defaultCheckInterval = 5 * time.Minute
+
+// SLAMonitor periodically flags orders not ready by their due time, so each
+// breach gets an SLA_BREACHED event.
+type SLAMonitor struct {
+	service  contract.OrderService
+	interval time.Duration
+}
+
+// NewSLAMonitor creates a new SLAMonitor.
+func NewSLAMonitor(service contract.OrderService, cfg *config.Config) *SLAMonitor {
+	interval := defaultCheckInterval
+	if cfg.SLA.CheckIntervalSeconds > 0 {
+		interval = time.Duration(cfg.SLA.CheckIntervalSeconds) * time.Second
+	}
+
+	return &SLAMonitor{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run checks for breaches right away and then every interval until ctx is done.
+func (m *SLAMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *SLAMonitor) check(ctx context.Context) {
+	recorded, err := m.service.RecordSLABreaches(appctx.New(ctx), time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to record SLA breaches")
+		return
+	}
+
+	if recorded > 0 {
+		log.Info().Int("orders", recorded).Msg("Recorded SLA breaches")
+	}
+}
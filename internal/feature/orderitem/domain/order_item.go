@@ -4,16 +4,32 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type OrderItem struct {
-	ID          uuid.UUID
-	OrderID     uuid.UUID
-	ServiceID   uuid.UUID
-	Quantity    float64
-	Price       float64
-	Subtotal    float64
-	TotalAmount float64
+	ID                  uuid.UUID
+	OrderID             uuid.UUID
+	ServiceID           uuid.UUID
+	Quantity            float64
+	BilledQuantity      float64     // quantity charged for after the pricing rules
+	Price               money.Money // base price per unit
+	SurchargeAmount     money.Money // what an express or same-day turnaround adds to the subtotal
+	ModifierAmount      money.Money // what the modifiers add to the subtotal
+	Subtotal            money.Money // what the billed quantity costs under the pricing rules, modifiers included
+	PricingApplied      []pricing.Applied
+	Modifiers           []*OrderItemModifier
+	DiscountAmount      money.Money // share of the order's promotions
+	ServiceChargeAmount money.Money
+	TaxAmount           money.Money // PPN
+	TotalAmount         money.Money
+
+	Pricing    *pricing.Rules     // service pricing rules the subtotal is worked out with, not stored
+	Turnaround *turnaround.Option // turnaround of the order for this service, not stored
+	Tax        tax.Settings       // rules the totals are worked out with, not stored
 }
 
 // Validate ensures the order item has valid values before saving.
@@ -38,15 +54,47 @@ func (i *OrderItem) Validate() error {
 		return errors.New("price cannot be negative")
 	}
 
+	if i.DiscountAmount < 0 {
+		return errors.New("discount cannot be negative")
+	}
+
 	return nil
 }
 
-// CalculateTotals recalculates the Subtotal and TotalAmount based on Quantity and Price.
+// CalculateTotals recalculates the Subtotal and TotalAmount based on Quantity, Price,
+// the service's pricing rules, the turnaround surcharge and the chosen modifiers, rounding half away from
+// zero to the nearest minor unit. The discount never
+// takes the item below zero; service charge and PPN are worked out on what is
+// left of it.
 func (i *OrderItem) CalculateTotals() {
 	if i == nil {
 		return
 	}
 
-	i.Subtotal = i.Price * i.Quantity
-	i.TotalAmount = i.Subtotal // 💡 If you later add taxes or discounts, adjust here
+	quote := i.Pricing.Quote(i.Price, i.Quantity)
+	i.BilledQuantity = quote.Quantity
+	i.PricingApplied = quote.Applied
+
+	i.SurchargeAmount = 0
+	if i.Turnaround != nil {
+		i.SurchargeAmount = i.Turnaround.Surcharge(quote.Amount)
+	}
+
+	i.ModifierAmount = 0
+	for _, m := range i.Modifiers {
+		m.CalculateAmount(quote.Amount, quote.Quantity)
+		i.ModifierAmount += m.Amount
+	}
+	i.Subtotal = quote.Amount + i.SurchargeAmount + i.ModifierAmount
+	i.DiscountAmount = money.Min(i.DiscountAmount, i.Subtotal)
+
+	b := i.Tax.Apply(i.Subtotal - i.DiscountAmount)
+	i.ServiceChargeAmount = b.ServiceCharge
+	i.TaxAmount = b.Tax
+	i.TotalAmount = b.Total
+}
+
+// TaxableAmount returns the DPP, what the item costs before service charge and PPN.
+func (i *OrderItem) TaxableAmount() money.Money {
+	return i.TotalAmount - i.ServiceChargeAmount - i.TaxAmount
 }
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// OrderItemModifier is a modifier chosen for an order item, with its price
+// copied from the modifier when the order was placed.
+type OrderItemModifier struct {
+	ID          uuid.UUID
+	OrderItemID uuid.UUID
+	ModifierID  uuid.UUID
+	Name        string
+	Type        types.ModifierType
+	Price       money.Money // fixed price, per billed unit when PerUnit
+	PerUnit     bool
+	Percent     float64     // percentage of the item's service price
+	Amount      money.Money // what it added to the item's subtotal
+}
+
+// CalculateAmount works out what the modifier adds to an item whose service
+// costs base for the billed quantity.
+func (m *OrderItemModifier) CalculateAmount(base money.Money, billedQuantity float64) {
+	switch {
+	case m.Type == types.ModifierTypePercent:
+		m.Amount = base.Percent(m.Percent)
+	case m.PerUnit:
+		m.Amount = m.Price.Mul(billedQuantity)
+	default:
+		m.Amount = m.Price
+	}
+}
@@ -1,14 +1,38 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/types"
+)
 
 // OrderItemResponse represents the order item returned in API responses.
 type OrderItemResponse struct {
-	ID          uuid.UUID `json:"id"`
-	OrderID     uuid.UUID `json:"order_id"`
-	ServiceID   uuid.UUID `json:"service_id"`
-	Quantity    float64   `json:"quantity"`
-	Price       float64   `json:"price"`
-	Subtotal    float64   `json:"subtotal"`
-	TotalAmount float64   `json:"total_amount"`
+	ID                  uuid.UUID                    `json:"id"`
+	OrderID             uuid.UUID                    `json:"order_id"`
+	ServiceID           uuid.UUID                    `json:"service_id"`
+	Quantity            float64                      `json:"quantity"`
+	BilledQuantity      float64                      `json:"billed_quantity"`
+	PricingApplied      []pricing.Applied            `json:"pricing_applied,omitempty"`
+	Modifiers           []*OrderItemModifierResponse `json:"modifiers,omitempty"`
+	Price               money.Money                  `json:"price"`
+	SurchargeAmount     money.Money                  `json:"surcharge_amount"`
+	ModifierAmount      money.Money                  `json:"modifier_amount"`
+	Subtotal            money.Money                  `json:"subtotal"`
+	DiscountAmount      money.Money                  `json:"discount_amount"`
+	ServiceChargeAmount money.Money                  `json:"service_charge_amount"`
+	TaxAmount           money.Money                  `json:"tax_amount"`
+	TotalAmount         money.Money                  `json:"total_amount"`
+}
+
+// OrderItemModifierResponse represents a modifier chosen for an order item.
+type OrderItemModifierResponse struct {
+	ModifierID uuid.UUID          `json:"modifier_id"`
+	Name       string             `json:"name"`
+	Type       types.ModifierType `json:"type"`
+	Price      money.Money        `json:"price,omitempty"`
+	PerUnit    bool               `json:"per_unit,omitempty"`
+	Percent    float64            `json:"percent,omitempty"`
+	Amount     money.Money        `json:"amount"`
 }
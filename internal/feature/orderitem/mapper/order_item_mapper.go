@@ -5,6 +5,7 @@ import (
 	"github.com/umardev500/laundry/internal/feature/orderitem/domain"
 	"github.com/umardev500/laundry/internal/feature/orderitem/dto"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
 )
 
 // FromEnt converts an Ent OrderItem model to a domain OrderItem.
@@ -14,13 +15,21 @@ func FromEnt(e *ent.OrderItem) *domain.OrderItem {
 	}
 
 	return &domain.OrderItem{
-		ID:          e.ID,
-		OrderID:     e.OrderID,
-		ServiceID:   e.ServiceID,
-		Quantity:    e.Quantity,
-		Price:       e.Price,
-		Subtotal:    e.Subtotal,
-		TotalAmount: e.TotalAmount,
+		ID:                  e.ID,
+		OrderID:             e.OrderID,
+		ServiceID:           e.ServiceID,
+		Quantity:            e.Quantity,
+		BilledQuantity:      billedQuantity(e),
+		PricingApplied:      e.PricingApplied,
+		Modifiers:           ModifiersFromEnt(e.Edges.Modifiers),
+		Price:               e.Price,
+		SurchargeAmount:     e.SurchargeAmount,
+		ModifierAmount:      e.ModifierAmount,
+		Subtotal:            e.Subtotal,
+		DiscountAmount:      e.DiscountAmount,
+		ServiceChargeAmount: e.ServiceChargeAmount,
+		TaxAmount:           e.TaxAmount,
+		TotalAmount:         e.TotalAmount,
 	}
 }
 
@@ -33,6 +42,30 @@ func FromEntList(ents []*ent.OrderItem) []*domain.OrderItem {
 	return items
 }
 
+// ModifiersFromEnt converts the Ent modifiers of an order item to domain
+// OrderItemModifiers.
+func ModifiersFromEnt(ents []*ent.OrderItemModifier) []*domain.OrderItemModifier {
+	if ents == nil {
+		return nil
+	}
+
+	modifiers := make([]*domain.OrderItemModifier, len(ents))
+	for i, e := range ents {
+		modifiers[i] = &domain.OrderItemModifier{
+			ID:          e.ID,
+			OrderItemID: e.OrderItemID,
+			ModifierID:  e.ModifierID,
+			Name:        e.Name,
+			Type:        types.ModifierType(e.Type),
+			Price:       e.Price,
+			PerUnit:     e.PerUnit,
+			Percent:     e.Percent,
+			Amount:      e.Amount,
+		}
+	}
+	return modifiers
+}
+
 // ToResponse converts a domain OrderItem to a response DTO.
 func ToResponse(d *domain.OrderItem) *dto.OrderItemResponse {
 	if d == nil {
@@ -40,13 +73,21 @@ func ToResponse(d *domain.OrderItem) *dto.OrderItemResponse {
 	}
 
 	return &dto.OrderItemResponse{
-		ID:          d.ID,
-		OrderID:     d.OrderID,
-		ServiceID:   d.ServiceID,
-		Quantity:    d.Quantity,
-		Price:       d.Price,
-		Subtotal:    d.Subtotal,
-		TotalAmount: d.TotalAmount,
+		ID:                  d.ID,
+		OrderID:             d.OrderID,
+		ServiceID:           d.ServiceID,
+		Quantity:            d.Quantity,
+		BilledQuantity:      d.BilledQuantity,
+		PricingApplied:      d.PricingApplied,
+		Modifiers:           ToModifierResponseList(d.Modifiers),
+		Price:               d.Price,
+		SurchargeAmount:     d.SurchargeAmount,
+		ModifierAmount:      d.ModifierAmount,
+		Subtotal:            d.Subtotal,
+		DiscountAmount:      d.DiscountAmount,
+		ServiceChargeAmount: d.ServiceChargeAmount,
+		TaxAmount:           d.TaxAmount,
+		TotalAmount:         d.TotalAmount,
 	}
 }
 
@@ -75,3 +116,33 @@ func ToResponsePage(data *pagination.PageData[domain.OrderItem]) *pagination.Pag
 		Total: data.Total,
 	}
 }
+
+// ToModifierResponseList converts the modifiers of an order item to response DTOs.
+func ToModifierResponseList(modifiers []*domain.OrderItemModifier) []*dto.OrderItemModifierResponse {
+	if modifiers == nil {
+		return nil
+	}
+
+	res := make([]*dto.OrderItemModifierResponse, len(modifiers))
+	for i, m := range modifiers {
+		res[i] = &dto.OrderItemModifierResponse{
+			ModifierID: m.ModifierID,
+			Name:       m.Name,
+			Type:       m.Type,
+			Price:      m.Price,
+			PerUnit:    m.PerUnit,
+			Percent:    m.Percent,
+			Amount:     m.Amount,
+		}
+	}
+	return res
+}
+
+// billedQuantity falls back to the ordered quantity for items placed before
+// pricing rules were recorded.
+func billedQuantity(e *ent.OrderItem) float64 {
+	if e.BilledQuantity == nil {
+		return e.Quantity
+	}
+	return *e.BilledQuantity
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/orderitemmodifier"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/orderitem/domain"
 	"github.com/umardev500/laundry/internal/feature/orderitem/mapper"
@@ -30,8 +32,15 @@ func (r *entImpl) Create(ctx *appctx.Context, items []*domain.OrderItem) ([]*dom
 			SetOrderID(item.OrderID).
 			SetServiceID(item.ServiceID).
 			SetQuantity(item.Quantity).
+			SetBilledQuantity(item.BilledQuantity).
+			SetPricingApplied(item.PricingApplied).
 			SetPrice(item.Price).
+			SetSurchargeAmount(item.SurchargeAmount).
+			SetModifierAmount(item.ModifierAmount).
 			SetSubtotal(item.Subtotal).
+			SetDiscountAmount(item.DiscountAmount).
+			SetServiceChargeAmount(item.ServiceChargeAmount).
+			SetTaxAmount(item.TaxAmount).
 			SetTotalAmount(item.TotalAmount)
 
 		bulk = append(bulk, builder)
@@ -42,5 +51,37 @@ func (r *entImpl) Create(ctx *appctx.Context, items []*domain.OrderItem) ([]*dom
 		return nil, err
 	}
 
+	// Store the chosen modifiers against the created items
+	var modifierBulk []*ent.OrderItemModifierCreate
+	for i, item := range items {
+		for _, m := range item.Modifiers {
+			modifierBulk = append(modifierBulk, conn.OrderItemModifier.Create().
+				SetOrderItemID(created[i].ID).
+				SetModifierID(m.ModifierID).
+				SetName(m.Name).
+				SetType(orderitemmodifier.Type(m.Type)).
+				SetPrice(m.Price).
+				SetPerUnit(m.PerUnit).
+				SetPercent(m.Percent).
+				SetAmount(m.Amount))
+		}
+	}
+
+	if len(modifierBulk) > 0 {
+		modifiers, err := conn.OrderItemModifier.CreateBulk(modifierBulk...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[uuid.UUID]*ent.OrderItem, len(created))
+		for _, e := range created {
+			byID[e.ID] = e
+		}
+		for _, m := range modifiers {
+			e := byID[m.OrderItemID]
+			e.Edges.Modifiers = append(e.Edges.Modifiers, m)
+		}
+	}
+
 	return mapper.FromEntList(created), nil
 }
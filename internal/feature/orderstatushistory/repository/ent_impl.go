@@ -55,7 +55,7 @@ func (r *entStatusHistoryImpl) FindById(ctx *appctx.Context, id uuid.UUID, q *qu
 	if q.IncludeOrder {
 		qb = qb.WithOrder(func(oq *ent.OrderQuery) {
 			if q.IncludeOrderRef {
-				oq.WithItems().WithPayment()
+				oq.WithItems().WithPayments()
 			}
 		})
 	}
@@ -89,7 +89,7 @@ func (r *entStatusHistoryImpl) List(ctx *appctx.Context, q *query.OrderStatusHis
 	if q.IncludeOrder {
 		qb = qb.WithOrder(func(oq *ent.OrderQuery) {
 			if q.IncludeOrderRef {
-				oq.WithItems().WithPayment()
+				oq.WithItems().WithPayments()
 			}
 		})
 	}
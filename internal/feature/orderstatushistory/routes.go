@@ -2,22 +2,37 @@ package orderstatushistory
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/feature/orderstatushistory/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
 
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("order-status-history")
-	group.Get("/", r.handler.List)
-	group.Get("/:id", r.handler.GetByID)
+
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+	group.Get("/", middleware.RequirePermission(r.authz, "view_order"), r.handler.List)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_order"), r.handler.GetByID)
 }
 
-func NewRoutes(h *handler.Handler) *Routes {
-	return &Routes{handler: h}
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
 }
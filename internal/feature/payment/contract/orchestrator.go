@@ -1,12 +1,27 @@
 package contract
 
 import (
+	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	orderDomain "github.com/umardev500/laundry/internal/feature/order/domain"
 	"github.com/umardev500/laundry/internal/feature/payment/domain"
+	"github.com/umardev500/laundry/pkg/money"
 )
 
 type Orchestrator interface {
 	// SyncOrder syncs payment details with related entities like orders.
 	SyncOrder(ctx *appctx.Context, ord *orderDomain.Order, pay *domain.Payment) error
+
+	// SettlePayment marks a pending payment as paid and, for order payments,
+	// moves the order along. A nil receivedAmount means the exact amount due.
+	SettlePayment(ctx *appctx.Context, id uuid.UUID, receivedAmount *money.Money) (*domain.Payment, error)
+
+	// HandleWebhook verifies a payment gateway notification and applies the
+	// reported charge status once, however often the gateway delivers it.
+	HandleWebhook(ctx *appctx.Context, payload []byte, signature string) error
+
+	// RefreshPayment asks the gateway for the charge status of an online
+	// payment and applies it, for when a notification went missing. A payment
+	// whose charge could not be opened after it was committed gets it opened.
+	RefreshPayment(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error)
 }
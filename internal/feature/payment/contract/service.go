@@ -5,14 +5,21 @@ import (
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/payment/domain"
 	"github.com/umardev500/laundry/internal/feature/payment/query"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/pagination"
 )
 
 // Service defines the business logic for payments.
 type Service interface {
-	// Create a new payment
+	// Create a new payment. Online payments are stored pending without a
+	// gateway charge; call OpenCharge once the payment is committed.
 	Create(ctx *appctx.Context, payment *domain.Payment) (*domain.Payment, error)
 
+	// OpenCharge opens the gateway charge of a pending online payment. It is
+	// safe to retry: payments that already have a charge are returned as is
+	// and the gateway deduplicates on the payment ID.
+	OpenCharge(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error)
+
 	// Update an existing payment
 	Update(ctx *appctx.Context, payment *domain.Payment) (*domain.Payment, error)
 
@@ -22,14 +29,33 @@ type Service interface {
 	// GetByID retrieves a payment by its ID
 	GetByID(ctx *appctx.Context, id uuid.UUID, q *query.FindPaymentByIdQuery) (*domain.Payment, error)
 
+	// GetByIDForUpdate retrieves a payment by its ID and locks it until the
+	// surrounding transaction ends, so checks against its amount cannot race.
+	GetByIDForUpdate(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error)
+
 	// Delete a payment by its ID
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 
 	// List retrieves paginated payments with filters
 	List(ctx *appctx.Context, q *query.ListPaymentQuery) (*pagination.PageData[domain.Payment], error)
 
-	// MarkPaid completes a payment (cash or other methods)
-	MarkPaid(ctx *appctx.Context, id uuid.UUID, receivedAmount float64) (*domain.Payment, error)
+	// FindByChargeID retrieves the payment collected by a gateway charge
+	FindByChargeID(ctx *appctx.Context, chargeID string) (*domain.Payment, error)
+
+	// RecordWebhookEvent stores a processed gateway notification. It returns
+	// domain.ErrDuplicateWebhookEvent when the event was seen before.
+	RecordWebhookEvent(ctx *appctx.Context, e *domain.WebhookEvent) error
+
+	// QRIS builds the dynamic QRIS code that pays a pending transfer payment
+	QRIS(ctx *appctx.Context, id uuid.UUID) (*domain.QRISCode, error)
+
+	// MarkPaid completes a payment (cash or other methods). Online payments
+	// are refused with domain.ErrSettledByGateway.
+	MarkPaid(ctx *appctx.Context, id uuid.UUID, receivedAmount money.Money) (*domain.Payment, error)
+
+	// SettleCharge completes an online payment whose charge the gateway
+	// reported paid. Only the webhook and refresh flows call it.
+	SettleCharge(ctx *appctx.Context, id uuid.UUID, paidAmount money.Money) (*domain.Payment, error)
 
 	// Purge a payment by its ID
 	Purge(ctx *appctx.Context, id uuid.UUID) error
@@ -17,4 +17,12 @@ var (
 	ErrInvalidChangeAmount       = errors.New("invalid change amount")
 	ErrPaidAtWithoutPaidStatus   = errors.New("paid_at timestamp provided without 'paid' status")
 	ErrPaidStatusWithoutPaidAt   = errors.New("'paid' status requires a paid_at timestamp")
+	ErrInvalidPaymentChannel     = errors.New("invalid payment channel")
+	ErrChannelMethodMismatch     = errors.New("payment channel does not match the payment method")
+	ErrNotOnlinePayment          = errors.New("payment is not collected through the payment gateway")
+	ErrSettledByGateway          = errors.New("online payments are settled by the payment gateway")
+	ErrDuplicateWebhookEvent     = errors.New("webhook event already processed")
+	ErrGatewayAmountMismatch     = errors.New("gateway amount does not match the payment")
+	ErrQRISUnavailable           = errors.New("qris codes are only available for pending transfer payments")
+	ErrQRISNotConfigured         = errors.New("tenant has no qris merchant configuration")
 )
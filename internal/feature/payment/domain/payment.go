@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 
 	paymentMethodDomain "github.com/umardev500/laundry/internal/feature/paymentmethod/domain"
@@ -20,12 +21,18 @@ type Payment struct {
 	RefType         types.PaymentType
 	PaymentMethodID uuid.UUID
 	PaymentMethod   *paymentMethodDomain.PaymentMethod
-	Amount          float64
-	ReceivedAmount  *float64
-	ChangeAmount    *float64
+	Amount          money.Money
+	ReceivedAmount  *money.Money
+	ChangeAmount    *money.Money
 	Notes           string
 	Status          types.PaymentStatus
+	Channel         *types.PaymentChannel // set for payments collected by the gateway
+	GatewayChargeID *string
+	VANumber        *string
+	PaymentURL      *string
+	ExpiresAt       *time.Time
 	PaidAt          *time.Time
+	CashShiftID     *uuid.UUID // drawer shift that took a cash payment
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       *time.Time
@@ -67,6 +74,10 @@ func (p *Payment) Validate() error {
 		return ErrInvalidPaymentType
 	}
 
+	if p.Channel != nil && !p.Channel.IsValid() {
+		return ErrInvalidPaymentChannel
+	}
+
 	// Validate amount
 	if p.Amount <= 0 {
 		return ErrInvalidAmount
@@ -116,7 +127,9 @@ func (p *Payment) Validate() error {
 // Actions / Mutations
 // -------------------------
 
-func (p *Payment) CompleteCashPayment(received float64) error {
+// CompleteCashPayment settles a pending payment, moving it through
+// PROCESSING to PAID and recording the received amount and change.
+func (p *Payment) CompleteCashPayment(received money.Money) error {
 	if p.Status != types.PaymentStatusPending {
 		return ErrOnlyPendingPayments
 	}
@@ -125,13 +138,16 @@ func (p *Payment) CompleteCashPayment(received float64) error {
 		return ErrInsufficientPayment
 	}
 
-	now := time.Now()
+	for _, next := range []types.PaymentStatus{types.PaymentStatusProcessing, types.PaymentStatusPaid} {
+		if err := p.UpdateStatus(next); err != nil {
+			return err
+		}
+	}
+
 	p.ReceivedAmount = &received
 	change := received - p.Amount
 	p.ChangeAmount = &change
-	p.Status = types.PaymentStatusPaid
-	p.PaidAt = &now
-	p.UpdatedAt = now
+	p.UpdatedAt = time.Now()
 
 	return nil
 }
@@ -139,9 +155,9 @@ func (p *Payment) CompleteCashPayment(received float64) error {
 // Update updates mutable fields of a payment
 func (p *Payment) Update(
 	paymentMethodID uuid.UUID,
-	amount float64,
+	amount money.Money,
 	notes string,
-	receivedAmount *float64,
+	receivedAmount *money.Money,
 ) error {
 	if p.IsDeleted() {
 		return ErrPaymentDeleted
@@ -213,6 +229,11 @@ func (p *Payment) SoftDelete() {
 // Helpers
 // -------------------------
 
+// IsOnline reports whether the payment is collected through the payment gateway.
+func (p *Payment) IsOnline() bool {
+	return p.Channel != nil
+}
+
 // IsDeleted returns true if the payment is deleted.
 func (p *Payment) IsDeleted() bool {
 	return p.DeletedAt != nil
@@ -221,7 +242,7 @@ func (p *Payment) IsDeleted() bool {
 // BelongsToTenant checks whether the service belongs to the tenant in context.
 func (p *Payment) BelongsToTenant(ctx *appctx.Context) bool {
 	if ctx.Scope() == appctx.ScopeTenant {
-		return ctx.TenantID() != nil && p.TenantID == ctx.TenantID()
+		return ctx.TenantID() != nil && p.TenantID != nil && *p.TenantID == *ctx.TenantID()
 	}
 	return true
 }
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// QRISCode is a dynamic QRIS code that pays a single payment. Reference is
+// the label the acquirer reports back, decodable to PaymentID.
+type QRISCode struct {
+	PaymentID uuid.UUID
+	Reference string
+	Amount    money.Money
+	Payload   string
+}
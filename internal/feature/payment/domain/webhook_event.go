@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEvent is a gateway notification that has been processed.
+type WebhookEvent struct {
+	ID        uuid.UUID
+	EventID   string
+	ChargeID  string
+	Status    string
+	PaymentID *uuid.UUID
+	CreatedAt time.Time
+}
@@ -2,10 +2,30 @@ package dto
 
 import (
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/feature/payment/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+	"github.com/umardev500/laundry/pkg/utils/deref"
 )
 
+// CreatePaymentRequest describes one payment towards an order. Without an
+// amount the payment covers the outstanding balance, or for cash the part of
+// it paid for by the received amount. A channel has the payment collected
+// online through the payment gateway.
 type CreatePaymentRequest struct {
-	PaymentMethodID uuid.UUID `json:"payment_method_id" validate:"required"`
-	ReceivedAmount  *float64  `json:"received_amount,omitempty" validate:"omitempty,gte=0"`
-	Notes           *string   `json:"notes,omitempty" validate:"omitempty,max=255"`
+	PaymentMethodID uuid.UUID             `json:"payment_method_id" validate:"required"`
+	Amount          *money.Money          `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	ReceivedAmount  *money.Money          `json:"received_amount,omitempty" validate:"omitempty,gte=0"`
+	Channel         *types.PaymentChannel `json:"channel,omitempty"`
+	Notes           *string               `json:"notes,omitempty" validate:"omitempty,max=255"`
+}
+
+func (r *CreatePaymentRequest) ToDomain() *domain.Payment {
+	return &domain.Payment{
+		PaymentMethodID: r.PaymentMethodID,
+		Amount:          deref.Money(r.Amount),
+		ReceivedAmount:  r.ReceivedAmount,
+		Channel:         r.Channel,
+		Notes:           deref.String(r.Notes),
+	}
 }
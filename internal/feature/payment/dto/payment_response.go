@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 
 	paymentMethodDto "github.com/umardev500/laundry/internal/feature/paymentmethod/dto"
@@ -18,12 +19,17 @@ type PaymentResponse struct {
 	RefType         types.PaymentType                       `json:"ref_type"`
 	PaymentMethodID uuid.UUID                               `json:"payment_method_id,omitempty"`
 	PaymentMethod   *paymentMethodDto.PaymentMethodResponse `json:"payment_method,omitempty"`
-	Amount          float64                                 `json:"amount"`
-	ReceivedAmount  *float64                                `json:"received_amount,omitempty"`
-	ChangeAmount    *float64                                `json:"change_amount,omitempty"`
+	Amount          money.Money                             `json:"amount"`
+	ReceivedAmount  *money.Money                            `json:"received_amount,omitempty"`
+	ChangeAmount    *money.Money                            `json:"change_amount,omitempty"`
 	Notes           string                                  `json:"notes,omitempty"`
 	Status          types.PaymentStatus                     `json:"status"`
+	Channel         *types.PaymentChannel                   `json:"channel,omitempty"`
+	VANumber        *string                                 `json:"va_number,omitempty"`
+	PaymentURL      *string                                 `json:"payment_url,omitempty"`
+	ExpiresAt       *time.Time                              `json:"expires_at,omitempty"`
 	PaidAt          *time.Time                              `json:"paid_at,omitempty"`
+	CashShiftID     *uuid.UUID                              `json:"cash_shift_id,omitempty"`
 	CreatedAt       time.Time                               `json:"created_at"`
 	UpdatedAt       time.Time                               `json:"updated_at"`
 	DeletedAt       *time.Time                              `json:"deleted_at,omitempty"`
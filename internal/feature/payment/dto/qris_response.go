@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// QRISResponse is the raw QRIS payload, for clients that render the code themselves.
+type QRISResponse struct {
+	PaymentID uuid.UUID   `json:"payment_id"`
+	Reference string      `json:"reference"`
+	Amount    money.Money `json:"amount"`
+	Payload   string      `json:"payload"`
+}
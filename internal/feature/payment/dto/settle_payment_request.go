@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/money"
+
+// SettlePaymentRequest records a pending payment as received. Without
+// ReceivedAmount the exact amount due is assumed.
+type SettlePaymentRequest struct {
+	ReceivedAmount *money.Money `json:"received_amount,omitempty" validate:"omitempty,gte=0"`
+}
@@ -10,23 +10,28 @@ import (
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/payment/contract"
 	"github.com/umardev500/laundry/internal/feature/payment/domain"
+	"github.com/umardev500/laundry/internal/feature/payment/dto"
 	"github.com/umardev500/laundry/internal/feature/payment/mapper"
 	"github.com/umardev500/laundry/internal/feature/payment/query"
+	"github.com/umardev500/laundry/internal/infra/paymentgateway"
 	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/qris"
 	"github.com/umardev500/laundry/pkg/validator"
 
 	orderMapper "github.com/umardev500/laundry/internal/feature/order/mapper"
 )
 
 type Handler struct {
-	service   contract.Service
-	validator *validator.Validator
+	service      contract.Service
+	orchestrator contract.Orchestrator
+	validator    *validator.Validator
 }
 
-func NewHandler(s contract.Service, v *validator.Validator) *Handler {
+func NewHandler(s contract.Service, o contract.Orchestrator, v *validator.Validator) *Handler {
 	return &Handler{
-		service:   s,
-		validator: v,
+		service:      s,
+		orchestrator: o,
+		validator:    v,
 	}
 }
 
@@ -81,6 +86,101 @@ func (h *Handler) List(c *fiber.Ctx) error {
 	)
 }
 
+// Settle POST /api/payments/:id/settle
+func (h *Handler) Settle(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid payment ID")
+	}
+
+	var req dto.SettlePaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	p, err := h.orchestrator.SettlePayment(ctx, id, req.ReceivedAmount)
+	if err != nil {
+		return handlePaymentError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(p, h.refToResponse))
+}
+
+// Refresh POST /api/payments/:id/refresh
+func (h *Handler) Refresh(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid payment ID")
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	p, err := h.orchestrator.RefreshPayment(ctx, id)
+	if err != nil {
+		return handlePaymentError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(p, h.refToResponse))
+}
+
+// QRIS GET /api/payments/:id/qris?format=png|svg|json&size=
+func (h *Handler) QRIS(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid payment ID")
+	}
+
+	var q query.QRISQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+	q.Normalize()
+
+	ctx := appctx.New(c.UserContext())
+
+	code, err := h.service.QRIS(ctx, id)
+	if err != nil {
+		return handlePaymentError(c, err)
+	}
+
+	var image []byte
+	switch q.Format {
+	case query.QRISFormatJSON:
+		return httpx.JSON(c, fiber.StatusOK, mapper.ToQRISResponse(code))
+	case query.QRISFormatSVG:
+		image, err = qris.SVG(code.Payload, q.Size)
+		c.Type("svg")
+	default:
+		image, err = qris.PNG(code.Payload, q.Size)
+		c.Type("png")
+	}
+	if err != nil {
+		return httpx.InternalServerError(c, err.Error())
+	}
+
+	// Codes carry the amount and must not be reused once the payment changes
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(fiber.StatusOK).Send(image)
+}
+
+// Webhook POST /api/payments/webhook
+func (h *Handler) Webhook(c *fiber.Ctx) error {
+	ctx := appctx.New(c.UserContext())
+
+	err := h.orchestrator.HandleWebhook(ctx, c.Body(), c.Get(paymentgateway.SignatureHeader))
+	if err != nil {
+		return handlePaymentError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
+
 // -----------------------
 // Helper methods
 // -----------------------
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/payment/domain"
+	"github.com/umardev500/laundry/internal/infra/paymentgateway"
+	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/types"
+
+	cashShiftDomain "github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	orderDomain "github.com/umardev500/laundry/internal/feature/order/domain"
+)
+
+// handlePaymentError centralizes HTTP error mapping for payment module
+func handlePaymentError(c *fiber.Ctx, err error) error {
+	switch {
+	case errorsx.IsInvalidTransitionErr[types.PaymentStatus](err),
+		errorsx.IsInvalidTransitionErr[types.OrderStatus](err):
+		return httpx.JSONErrorWithData(
+			c,
+			fiber.StatusBadRequest,
+			"invalid status transition",
+			err,
+			err,
+		)
+
+	case errors.Is(err, domain.ErrPaymentNotFound),
+		errors.Is(err, orderDomain.ErrOrderNotFound):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrPaymentDeleted),
+		errors.Is(err, domain.ErrUnauthorizedPaymentAccess),
+		errors.Is(err, orderDomain.ErrOrderDeleted),
+		errors.Is(err, orderDomain.ErrUnauthorizedOrderAccess),
+		errors.Is(err, cashShiftDomain.ErrCashShiftRequiresStaff):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, paymentgateway.ErrInvalidSignature):
+		return httpx.Unauthorized(c, err.Error())
+
+	case errors.Is(err, domain.ErrOnlyPendingPayments),
+		errors.Is(err, domain.ErrSettledByGateway),
+		errors.Is(err, cashShiftDomain.ErrNoOpenCashShift),
+		errors.Is(err, domain.ErrGatewayAmountMismatch),
+		errors.Is(err, domain.ErrQRISUnavailable),
+		errors.Is(err, domain.ErrQRISNotConfigured):
+		return httpx.Conflict(c, err.Error())
+
+	case errors.Is(err, domain.ErrInsufficientPayment),
+		errors.Is(err, domain.ErrNotOnlinePayment),
+		errors.Is(err, paymentgateway.ErrInvalidEvent):
+		return httpx.BadRequest(c, err.Error())
+
+	case errors.Is(err, paymentgateway.ErrChargeNotFound):
+		return httpx.NotFound(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
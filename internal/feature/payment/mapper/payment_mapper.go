@@ -30,8 +30,14 @@ func FromEnt(e *ent.Payment) *domain.Payment {
 		ChangeAmount:    &e.ChangeAmount,
 		Notes:           e.Notes,
 		Status:          types.PaymentStatus(e.Status),
+		Channel:         (*types.PaymentChannel)(e.Channel),
+		GatewayChargeID: e.GatewayChargeID,
+		VANumber:        e.VaNumber,
+		PaymentURL:      e.PaymentURL,
+		ExpiresAt:       e.ExpiresAt,
 		PaymentMethod:   paymentMethodMapper.FromEnt(e.Edges.PaymentMethod),
 		PaidAt:          e.PaidAt,
+		CashShiftID:     e.CashShiftID,
 		CreatedAt:       e.CreatedAt,
 		UpdatedAt:       e.UpdatedAt,
 		DeletedAt:       e.DeletedAt,
@@ -71,8 +77,13 @@ func ToResponse(d *domain.Payment, refMapper types.RefMapper) *dto.PaymentRespon
 		ChangeAmount:    d.ChangeAmount,
 		Notes:           d.Notes,
 		Status:          d.Status,
+		Channel:         d.Channel,
+		VANumber:        d.VANumber,
+		PaymentURL:      d.PaymentURL,
+		ExpiresAt:       d.ExpiresAt,
 		PaymentMethod:   paymentMethodMapper.ToResponse(d.PaymentMethod),
 		PaidAt:          d.PaidAt,
+		CashShiftID:     d.CashShiftID,
 		CreatedAt:       d.CreatedAt,
 		UpdatedAt:       d.UpdatedAt,
 		DeletedAt:       d.DeletedAt,
@@ -106,6 +117,20 @@ func ToResponsePage(data *pagination.PageData[domain.Payment], refMapper types.R
 	}
 }
 
+// ToQRISResponse converts a QRIS code to its JSON response
+func ToQRISResponse(q *domain.QRISCode) *dto.QRISResponse {
+	if q == nil {
+		return nil
+	}
+
+	return &dto.QRISResponse{
+		PaymentID: q.PaymentID,
+		Reference: q.Reference,
+		Amount:    q.Amount,
+		Payload:   q.Payload,
+	}
+}
+
 // getRef returns the ref of a payment
 func getRef(e *ent.Payment) any {
 	if e == nil {
@@ -2,12 +2,17 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/payment/contract"
 	"github.com/umardev500/laundry/internal/feature/payment/domain"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/internal/infra/paymentgateway"
+	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 
 	orderServiceContract "github.com/umardev500/laundry/internal/feature/order/contract"
@@ -17,13 +22,20 @@ import (
 type paymentToOrder struct {
 	orderService orderServiceContract.OrderService
 	service      contract.Service
+	gateway      paymentgateway.PaymentGateway
 	client       *entdb.Client
 }
 
-func NewPaymentToOrder(orderService orderServiceContract.OrderService, service contract.Service, client *entdb.Client) contract.Orchestrator {
+func NewPaymentToOrder(
+	orderService orderServiceContract.OrderService,
+	service contract.Service,
+	gateway paymentgateway.PaymentGateway,
+	client *entdb.Client,
+) contract.Orchestrator {
 	return &paymentToOrder{
 		orderService: orderService,
 		service:      service,
+		gateway:      gateway,
 		client:       client,
 	}
 }
@@ -70,3 +82,159 @@ func (p *paymentToOrder) SyncOrder(ctx *appctx.Context, ord *orderDomain.Order,
 
 	return nil
 }
+
+func (p *paymentToOrder) SettlePayment(ctx *appctx.Context, id uuid.UUID, receivedAmount *money.Money) (*domain.Payment, error) {
+	pay, err := p.service.GetByID(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Order payments are settled through the order so its status follows.
+	if pay.RefType == types.PaymentTypeOrder {
+		ord, err := p.orderService.SettlePayment(ctx, pay.RefID, &pay.ID, receivedAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, settled := range ord.Payments {
+			if settled.ID == pay.ID {
+				return settled, nil
+			}
+		}
+		return nil, domain.ErrPaymentNotFound
+	}
+
+	received := pay.Amount
+	if receivedAmount != nil {
+		received = *receivedAmount
+	}
+
+	return p.service.MarkPaid(ctx, id, received)
+}
+
+func (p *paymentToOrder) HandleWebhook(ctx *appctx.Context, payload []byte, signature string) error {
+	event, err := p.gateway.ParseEvent(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	err = p.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		pay, err := p.service.FindByChargeID(newCtx, event.Charge.ID)
+		if err != nil {
+			return err
+		}
+
+		// Recording the event first rolls back with the rest when applying fails,
+		// so a retried delivery is processed again.
+		err = p.service.RecordWebhookEvent(newCtx, &domain.WebhookEvent{
+			EventID:   event.ID,
+			ChargeID:  event.Charge.ID,
+			Status:    string(event.Charge.Status),
+			PaymentID: &pay.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return p.applyCharge(newCtx, pay, &event.Charge)
+	})
+	if errors.Is(err, domain.ErrDuplicateWebhookEvent) {
+		return nil
+	}
+
+	return err
+}
+
+func (p *paymentToOrder) RefreshPayment(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error) {
+	pay, err := p.service.GetByID(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The charge is opened after the payment commits; when that failed the
+	// refresh opens it now
+	if pay.IsOnline() && pay.GatewayChargeID == nil {
+		return p.service.OpenCharge(ctx, id)
+	}
+
+	if pay.GatewayChargeID == nil {
+		return nil, domain.ErrNotOnlinePayment
+	}
+
+	charge, err := p.gateway.GetCharge(ctx, *pay.GatewayChargeID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		return p.applyCharge(appctx.New(txCtx), pay, charge)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.service.GetByID(ctx, id, nil)
+}
+
+// applyCharge moves the payment to the status of its gateway charge. Paid order
+// payments are settled through the order so its balance and status follow.
+func (p *paymentToOrder) applyCharge(ctx *appctx.Context, pay *domain.Payment, charge *paymentgateway.Charge) error {
+	target, ok := chargeStatusToPayment[charge.Status]
+	if !ok {
+		return fmt.Errorf("%w: unknown charge status %q", paymentgateway.ErrInvalidEvent, charge.Status)
+	}
+
+	// Refunds are tracked by the refund workflow, which moves the payment once
+	// it has been refunded in full.
+	if pay.Status == target || target == types.PaymentStatusRefunded {
+		return nil
+	}
+
+	if charge.Amount != pay.Amount {
+		return domain.ErrGatewayAmountMismatch
+	}
+
+	if target == types.PaymentStatusPaid {
+		// A charge paid after it was reported failed is still accepted.
+		if pay.Status == types.PaymentStatusFailed {
+			if _, err := p.service.UpdateStatus(ctx, &domain.Payment{ID: pay.ID, Status: types.PaymentStatusPending}); err != nil {
+				return err
+			}
+			pay.Status = types.PaymentStatusPending
+		}
+
+		if pay.Status == types.PaymentStatusPending {
+			if pay.RefType == types.PaymentTypeOrder {
+				_, err := p.orderService.SettleCharge(ctx, pay.RefID, pay.ID)
+				return err
+			}
+
+			_, err := p.service.SettleCharge(ctx, pay.ID, pay.Amount)
+			return err
+		}
+	}
+
+	path := pay.Status.PathTo(target)
+	if path == nil {
+		return errorsx.NewErrInvalidStatusTransition(string(pay.Status), string(target), pay.Status.AllowedNextStatuses())
+	}
+
+	for _, next := range path {
+		if _, err := p.service.UpdateStatus(ctx, &domain.Payment{ID: pay.ID, Status: next}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chargeStatusToPayment maps gateway charge statuses to payment statuses.
+var chargeStatusToPayment = map[paymentgateway.ChargeStatus]types.PaymentStatus{
+	paymentgateway.ChargeStatusPending:  types.PaymentStatusPending,
+	paymentgateway.ChargeStatusPaid:     types.PaymentStatusPaid,
+	paymentgateway.ChargeStatusFailed:   types.PaymentStatusFailed,
+	paymentgateway.ChargeStatusExpired:  types.PaymentStatusCancelled,
+	paymentgateway.ChargeStatusRefunded: types.PaymentStatusRefunded,
+}
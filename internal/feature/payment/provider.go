@@ -12,6 +12,7 @@ import (
 var ProviderSet = wire.NewSet(
 	handler.NewHandler,
 	repository.NewEntPaymentRepository,
+	repository.NewWebhookEventRepository,
 	service.NewPaymentService,
 	orchestrator.NewPaymentToOrder,
 	NewRoutes,
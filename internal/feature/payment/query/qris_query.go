@@ -0,0 +1,34 @@
+package query
+
+import "github.com/umardev500/laundry/pkg/qris"
+
+type QRISFormat string
+
+const (
+	QRISFormatPNG  QRISFormat = "png"
+	QRISFormatSVG  QRISFormat = "svg"
+	QRISFormatJSON QRISFormat = "json"
+)
+
+// QRISQuery selects how a payment's QRIS code is returned.
+type QRISQuery struct {
+	Format QRISFormat `query:"format"`
+	Size   int        `query:"size"`
+}
+
+const maxQRISSize = 1024
+
+func (q *QRISQuery) Normalize() {
+	switch q.Format {
+	case QRISFormatSVG, QRISFormatJSON:
+	default:
+		q.Format = QRISFormatPNG
+	}
+
+	if q.Size <= 0 {
+		q.Size = qris.DefaultImageSize
+	}
+	if q.Size > maxQRISSize {
+		q.Size = maxQRISSize
+	}
+}
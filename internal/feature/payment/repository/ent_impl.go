@@ -12,6 +12,7 @@ import (
 	"github.com/umardev500/laundry/internal/feature/payment/query"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
 )
 
 // EntPaymentRepository implements domain.Payment repository using Ent
@@ -28,7 +29,8 @@ func NewEntPaymentRepository(client *entdb.Client) Repository {
 
 // Create inserts a new payment
 func (r *EntPaymentRepository) Create(ctx *appctx.Context, p *domain.Payment) (*domain.Payment, error) {
-	entPayment, err := r.client.Client.Payment.
+	conn := r.client.GetConn(ctx)
+	builder := conn.Payment.
 		Create().
 		SetNillableUserID(p.UserID).
 		SetNillableTenantID(p.TenantID).
@@ -43,7 +45,17 @@ func (r *EntPaymentRepository) Create(ctx *appctx.Context, p *domain.Payment) (*
 		SetNillableReceivedAmount(p.ReceivedAmount).
 		SetNillableChangeAmount(p.ChangeAmount).
 		SetNillablePaidAt(p.PaidAt).
-		Save(ctx)
+		SetNillableCashShiftID(p.CashShiftID)
+
+	if p.Channel != nil {
+		builder.SetChannel(payment.Channel(*p.Channel))
+	}
+
+	if p.RefType == types.PaymentTypeOrder {
+		builder.SetOrderID(p.RefID)
+	}
+
+	entPayment, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +65,8 @@ func (r *EntPaymentRepository) Create(ctx *appctx.Context, p *domain.Payment) (*
 
 // Update modifies an existing payment
 func (r *EntPaymentRepository) Update(ctx *appctx.Context, p *domain.Payment) (*domain.Payment, error) {
-	entPayment, err := r.client.Client.Payment.
+	conn := r.client.GetConn(ctx)
+	entPayment, err := conn.Payment.
 		UpdateOneID(p.ID).
 		SetPaymentMethodID(p.PaymentMethodID).
 		SetAmount(p.Amount).
@@ -63,6 +76,11 @@ func (r *EntPaymentRepository) Update(ctx *appctx.Context, p *domain.Payment) (*
 		SetNillableReceivedAmount(p.ReceivedAmount).
 		SetNillableChangeAmount(p.ChangeAmount).
 		SetNillablePaidAt(p.PaidAt).
+		SetNillableGatewayChargeID(p.GatewayChargeID).
+		SetNillableVaNumber(p.VANumber).
+		SetNillablePaymentURL(p.PaymentURL).
+		SetNillableExpiresAt(p.ExpiresAt).
+		SetNillableCashShiftID(p.CashShiftID).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -71,6 +89,25 @@ func (r *EntPaymentRepository) Update(ctx *appctx.Context, p *domain.Payment) (*
 	return mapper.FromEnt(entPayment), nil
 }
 
+// FindByChargeID returns the payment collected by a gateway charge
+func (r *EntPaymentRepository) FindByChargeID(ctx *appctx.Context, chargeID string) (*domain.Payment, error) {
+	conn := r.client.GetConn(ctx)
+
+	qb := conn.Payment.
+		Query().
+		Where(
+			payment.GatewayChargeIDEQ(chargeID),
+			payment.DeletedAtIsNil(),
+		)
+
+	entPayment, err := r.applyScope(ctx, qb).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entPayment), nil
+}
+
 // FindById returns a payment by its ID
 func (r *EntPaymentRepository) FindById(ctx *appctx.Context, id uuid.UUID, q *query.FindPaymentByIdQuery) (*domain.Payment, error) {
 	if q == nil {
@@ -105,6 +142,23 @@ func (r *EntPaymentRepository) FindById(ctx *appctx.Context, id uuid.UUID, q *qu
 	return mapper.FromEnt(entPayment), nil
 }
 
+// FindByIdForUpdate implements Repository.
+func (r *EntPaymentRepository) FindByIdForUpdate(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error) {
+	conn := r.client.GetConn(ctx)
+
+	qb := conn.Payment.
+		Query().
+		Where(payment.IDEQ(id))
+	qb = r.applyScope(ctx, qb)
+
+	entPayment, err := qb.ForUpdate().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entPayment), nil
+}
+
 // Delete performs a hard delete
 func (r *EntPaymentRepository) Delete(ctx *appctx.Context, id uuid.UUID) error {
 	err := r.client.Client.Payment.
@@ -18,8 +18,21 @@ type Repository interface {
 	// FindById returns a payment by its ID.
 	FindById(ctx *appctx.Context, id uuid.UUID, q *query.FindPaymentByIdQuery) (*domain.Payment, error)
 
+	// FindByIdForUpdate returns a payment by its ID and locks its row until
+	// the surrounding transaction ends.
+	FindByIdForUpdate(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error)
+
+	// FindByChargeID returns the payment collected by a gateway charge.
+	FindByChargeID(ctx *appctx.Context, chargeID string) (*domain.Payment, error)
+
 	// Delete deletes a payment by its ID.
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 
 	List(ctx *appctx.Context, f *query.ListPaymentQuery) (*pagination.PageData[domain.Payment], error)
 }
+
+// WebhookEventRepository records processed payment gateway notifications.
+type WebhookEventRepository interface {
+	// Create stores the event, or returns domain.ErrDuplicateWebhookEvent if it was already recorded.
+	Create(ctx *appctx.Context, e *domain.WebhookEvent) error
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/payment/domain"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+)
+
+type webhookEventRepoEnt struct {
+	client *entdb.Client
+}
+
+// NewWebhookEventRepository returns a new Ent-based webhook event repository.
+func NewWebhookEventRepository(client *entdb.Client) WebhookEventRepository {
+	return &webhookEventRepoEnt{client: client}
+}
+
+// Create implements WebhookEventRepository.
+func (r *webhookEventRepoEnt) Create(ctx *appctx.Context, e *domain.WebhookEvent) error {
+	conn := r.client.GetConn(ctx)
+
+	err := conn.PaymentWebhookEvent.
+		Create().
+		SetEventID(e.EventID).
+		SetChargeID(e.ChargeID).
+		SetStatus(e.Status).
+		SetNillablePaymentID(e.PaymentID).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.ErrDuplicateWebhookEvent
+	}
+	return err
+}
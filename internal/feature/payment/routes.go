@@ -4,13 +4,18 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/payment/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -18,14 +23,23 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("payments")
 
-	group.Use(middleware.CheckAuth(r.config))
-	group.Get("/", r.handler.List)
-	group.Get("/:id", r.handler.FindById)
+	// Gateway notifications are authenticated by their signature, so this is
+	// registered ahead of the auth middleware.
+	group.Post("/webhook", r.handler.Webhook)
+
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+	group.Get("/", middleware.RequirePermission(r.authz, "view_order"), r.handler.List)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_order"), r.handler.FindById)
+	group.Get("/:id/qris", middleware.RequirePermission(r.authz, "view_order"), r.handler.QRIS)
+	group.Post("/:id/settle", middleware.RequirePermission(r.authz, "update_order"), r.handler.Settle)
+	group.Post("/:id/refresh", middleware.RequirePermission(r.authz, "update_order"), r.handler.Refresh)
 }
 
-func NewRoutes(h *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: h,
-		config:  config,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/umardev500/laundry/ent/payment"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 	"github.com/umardev500/laundry/pkg/utils"
 )
@@ -39,9 +40,9 @@ func (s *PaymentSeeder) Seed(ctx context.Context) error {
 		RefID           uuid.UUID
 		RefType         types.PaymentType
 		PaymentMethodID uuid.UUID
-		Amount          float64
-		ReceivedAmount  *float64
-		ChangeAmount    *float64
+		Amount          money.Money
+		ReceivedAmount  *money.Money
+		ChangeAmount    *money.Money
 		Status          types.PaymentStatus
 		PaidAt          *time.Time
 	}{
@@ -52,7 +53,7 @@ func (s *PaymentSeeder) Seed(ctx context.Context) error {
 			RefID:           uuid.MustParse("cccccccc-3333-3333-3333-cccccccc3333"), // order ID
 			RefType:         types.PaymentTypeOrder,
 			PaymentMethodID: cashID,
-			Amount:          25.0,
+			Amount:          money.FromMajor(25),
 			Status:          types.PaymentStatusPending,
 		},
 		{
@@ -62,13 +63,13 @@ func (s *PaymentSeeder) Seed(ctx context.Context) error {
 			RefID:           uuid.MustParse("bbbbbbbb-2222-2222-2222-bbbbbbbb2222"),
 			RefType:         types.PaymentTypeOrder,
 			PaymentMethodID: cashID,
-			Amount:          40.0,
-			ReceivedAmount: func() *float64 {
-				amount := 50.0
+			Amount:          money.FromMajor(40),
+			ReceivedAmount: func() *money.Money {
+				amount := money.FromMajor(50)
 				return &amount
 			}(),
-			ChangeAmount: func() *float64 {
-				amount := 40.0
+			ChangeAmount: func() *money.Money {
+				amount := money.FromMajor(40)
 				return &amount
 			}(),
 			Status: types.PaymentStatusPaid,
@@ -81,13 +82,13 @@ func (s *PaymentSeeder) Seed(ctx context.Context) error {
 			RefID:           uuid.MustParse("dddddddd-4444-4444-4444-dddddddd4444"),
 			RefType:         types.PaymentTypeOrder,
 			PaymentMethodID: cashID,
-			Amount:          45.0,
-			ReceivedAmount: func() *float64 {
-				amount := 100.0
+			Amount:          money.FromMajor(45),
+			ReceivedAmount: func() *money.Money {
+				amount := money.FromMajor(100)
 				return &amount
 			}(),
-			ChangeAmount: func() *float64 {
-				amount := 65.0
+			ChangeAmount: func() *money.Money {
+				amount := money.FromMajor(65)
 				return &amount
 			}(),
 			Status: types.PaymentStatusPaid,
@@ -1,26 +1,42 @@
 package service
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/payment/contract"
 	"github.com/umardev500/laundry/internal/feature/payment/domain"
 	"github.com/umardev500/laundry/internal/feature/payment/query"
 	"github.com/umardev500/laundry/internal/feature/payment/repository"
+	"github.com/umardev500/laundry/internal/infra/paymentgateway"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/qris"
+	"github.com/umardev500/laundry/pkg/types"
 
+	cashShiftContract "github.com/umardev500/laundry/internal/feature/cashshift/contract"
 	paymentMethodContract "github.com/umardev500/laundry/internal/feature/paymentmethod/contract"
+	tenantContract "github.com/umardev500/laundry/internal/feature/tenant/contract"
 )
 
 // PaymentServiceImpl implements PaymentService
 type PaymentServiceImpl struct {
+	config               *config.Config
 	repo                 repository.Repository
+	eventRepo            repository.WebhookEventRepository
+	gateway              paymentgateway.PaymentGateway
 	paymentMethodService paymentMethodContract.Service
+	tenantService        tenantContract.Service
+	cashShiftService     cashShiftContract.Service
 }
 
+// defaultChargeExpiry bounds how long an online charge can be paid when not configured.
+const defaultChargeExpiry = 24 * time.Hour
+
 // List implements contract.Service.
 func (s *PaymentServiceImpl) List(ctx *appctx.Context, q *query.ListPaymentQuery) (*pagination.PageData[domain.Payment], error) {
 	if q == nil {
@@ -33,12 +49,22 @@ func (s *PaymentServiceImpl) List(ctx *appctx.Context, q *query.ListPaymentQuery
 
 // NewPaymentService creates a new PaymentService
 func NewPaymentService(
+	config *config.Config,
 	repo repository.Repository,
+	eventRepo repository.WebhookEventRepository,
+	gateway paymentgateway.PaymentGateway,
 	paymentMethodService paymentMethodContract.Service,
+	tenantService tenantContract.Service,
+	cashShiftService cashShiftContract.Service,
 ) contract.Service {
 	return &PaymentServiceImpl{
+		config:               config,
 		repo:                 repo,
+		eventRepo:            eventRepo,
+		gateway:              gateway,
 		paymentMethodService: paymentMethodService,
+		tenantService:        tenantService,
+		cashShiftService:     cashShiftService,
 	}
 }
 
@@ -49,17 +75,63 @@ func (s *PaymentServiceImpl) Create(ctx *appctx.Context, p *domain.Payment) (*do
 	}
 
 	// Check for payment method
-	_, err := s.paymentMethodService.GetByID(ctx, p.PaymentMethodID)
+	m, err := s.paymentMethodService.GetByID(ctx, p.PaymentMethodID)
 	if err != nil {
 		return nil, err
 	}
 
+	if p.IsOnline() && p.Channel.Method() != m.Type {
+		return nil, domain.ErrChannelMethodMismatch
+	}
+
 	// Create a new payment
 	p.Create()
 
+	if m.Type == types.PaymentMethodCash && p.Status == types.PaymentStatusPaid {
+		if err := s.bookToCashShift(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.repo.Create(ctx, p)
 }
 
+// OpenCharge implements contract.Service.
+func (s *PaymentServiceImpl) OpenCharge(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error) {
+	p, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.IsOnline() {
+		return nil, domain.ErrNotOnlinePayment
+	}
+
+	if p.GatewayChargeID != nil || p.Status != types.PaymentStatusPending {
+		return p, nil
+	}
+
+	return s.charge(ctx, p)
+}
+
+// FindByChargeID implements contract.Service.
+func (s *PaymentServiceImpl) FindByChargeID(ctx *appctx.Context, chargeID string) (*domain.Payment, error) {
+	p, err := s.repo.FindByChargeID(ctx, chargeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// RecordWebhookEvent implements contract.Service.
+func (s *PaymentServiceImpl) RecordWebhookEvent(ctx *appctx.Context, e *domain.WebhookEvent) error {
+	return s.eventRepo.Create(ctx, e)
+}
+
 // Update an existing payment
 func (s *PaymentServiceImpl) Update(ctx *appctx.Context, p *domain.Payment) (*domain.Payment, error) {
 	existing, err := s.findExisting(ctx, p.ID)
@@ -104,6 +176,12 @@ func (s *PaymentServiceImpl) GetByID(ctx *appctx.Context, id uuid.UUID, q *query
 	return s.findExisting(ctx, id)
 }
 
+// GetByIDForUpdate implements contract.Service.
+func (s *PaymentServiceImpl) GetByIDForUpdate(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error) {
+	p, err := s.repo.FindByIdForUpdate(ctx, id)
+	return s.checkExisting(ctx, p, err)
+}
+
 // Delete a payment by its ID (soft delete)
 func (s *PaymentServiceImpl) Delete(ctx *appctx.Context, id uuid.UUID) error {
 	existing, err := s.findExisting(ctx, id)
@@ -127,28 +205,164 @@ func (s *PaymentServiceImpl) Purge(ctx *appctx.Context, id uuid.UUID) error {
 	return s.repo.Delete(ctx, existing.ID)
 }
 
+// QRIS implements contract.Service.
+func (s *PaymentServiceImpl) QRIS(ctx *appctx.Context, id uuid.UUID) (*domain.QRISCode, error) {
+	p, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Online payments are collected by the gateway instead
+	if p.Status != types.PaymentStatusPending || p.IsOnline() {
+		return nil, domain.ErrQRISUnavailable
+	}
+
+	m, err := s.paymentMethodService.GetByID(ctx, p.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	if m.Type != types.PaymentMethodTransfer {
+		return nil, domain.ErrQRISUnavailable
+	}
+
+	if p.TenantID == nil {
+		return nil, domain.ErrQRISNotConfigured
+	}
+	tenant, err := s.tenantService.GetByID(ctx, *p.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.QRIS == nil {
+		return nil, domain.ErrQRISNotConfigured
+	}
+
+	// The payment is confirmed through the usual settle or status transitions
+	reference := qris.EncodeReference(p.ID)
+	payload, err := qris.Payload{
+		Merchant:  *tenant.QRIS,
+		Amount:    p.Amount,
+		Reference: reference,
+	}.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.QRISCode{
+		PaymentID: p.ID,
+		Reference: reference,
+		Amount:    p.Amount,
+		Payload:   payload,
+	}, nil
+}
+
 // MarkPaid marks a payment as paid (cash or other method)
-func (s *PaymentServiceImpl) MarkPaid(ctx *appctx.Context, id uuid.UUID, receivedAmount float64) (*domain.Payment, error) {
+func (s *PaymentServiceImpl) MarkPaid(ctx *appctx.Context, id uuid.UUID, receivedAmount money.Money) (*domain.Payment, error) {
 	p, err := s.findExisting(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	// Only the gateway can say an online payment was paid
+	if p.IsOnline() {
+		return nil, domain.ErrSettledByGateway
+	}
+
 	if err := p.CompleteCashPayment(receivedAmount); err != nil {
 		return nil, err
 	}
 
+	m, err := s.paymentMethodService.GetByID(ctx, p.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Type == types.PaymentMethodCash {
+		if err := s.bookToCashShift(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+
 	p.UpdatedAt = time.Now()
 	return s.repo.Update(ctx, p)
 }
 
+// SettleCharge implements contract.Service.
+func (s *PaymentServiceImpl) SettleCharge(ctx *appctx.Context, id uuid.UUID, paidAmount money.Money) (*domain.Payment, error) {
+	p, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.IsOnline() {
+		return nil, domain.ErrNotOnlinePayment
+	}
+
+	if err := p.CompleteCashPayment(paidAmount); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, p)
+}
+
 // -----------------------
 // Helper methods
 // -----------------------
 
+// bookToCashShift puts cash taken by a staff member in their open drawer
+// shift so it is counted when the shift closes. Cash cannot be taken without
+// an open shift.
+func (s *PaymentServiceImpl) bookToCashShift(ctx *appctx.Context, p *domain.Payment) error {
+	shiftID, err := s.cashShiftService.OpenShiftID(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.CashShiftID = &shiftID
+	return nil
+}
+
+// charge opens a gateway charge for the payment and stores its payment
+// instructions. The payment ID is the idempotency key, so a retry after a
+// failed update does not open a second charge.
+func (s *PaymentServiceImpl) charge(ctx *appctx.Context, p *domain.Payment) (*domain.Payment, error) {
+	expiry := defaultChargeExpiry
+	if s.config.Payment.ChargeExpirySeconds > 0 {
+		expiry = time.Duration(s.config.Payment.ChargeExpirySeconds) * time.Second
+	}
+
+	c, err := s.gateway.CreateCharge(ctx, paymentgateway.ChargeRequest{
+		ReferenceID: p.ID.String(),
+		Amount:      p.Amount,
+		Channel:     *p.Channel,
+		Description: fmt.Sprintf("%s payment %s", p.RefType, p.RefID),
+		ExpiresAt:   time.Now().UTC().Add(expiry),
+
+		IdempotencyKey: p.ID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.GatewayChargeID = &c.ID
+	p.ExpiresAt = c.ExpiresAt
+	if c.VANumber != "" {
+		p.VANumber = &c.VANumber
+	}
+	if c.PaymentURL != "" {
+		p.PaymentURL = &c.PaymentURL
+	}
+
+	return s.repo.Update(ctx, p)
+}
+
 // findExisting ensures the payment exists, is not soft-deleted, and belongs to tenant
 func (s *PaymentServiceImpl) findExisting(ctx *appctx.Context, id uuid.UUID) (*domain.Payment, error) {
 	p, err := s.repo.FindById(ctx, id, nil)
+	return s.checkExisting(ctx, p, err)
+}
+
+// checkExisting maps a payment lookup to domain errors and checks tenant ownership
+func (s *PaymentServiceImpl) checkExisting(ctx *appctx.Context, p *domain.Payment, err error) (*domain.Payment, error) {
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, domain.ErrPaymentNotFound
@@ -9,7 +9,7 @@ import (
 type CreatePaymentMethodRequest struct {
 	Name        string              `json:"name" validate:"required"`
 	Description *string             `json:"description,omitempty"`
-	Type        types.PaymentMethod `json:"type" validate:"required,oneof=cash card transfer"`
+	Type        types.PaymentMethod `json:"type" validate:"required,oneof=cash card transfer wallet"`
 }
 
 func (r CreatePaymentMethodRequest) ToDomain() *domain.PaymentMethod {
@@ -2,26 +2,41 @@ package paymentmethod
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/feature/paymentmethod/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
 
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("payment-methods")
-	group.Post("/", r.handler.Create)
-	group.Get("/", r.handler.List)
-	group.Get("/:id", r.handler.Get)
-	group.Put("/:id", r.handler.Update)
-	group.Delete("/:id", r.handler.Delete)
-	group.Delete("/:id/purge", r.handler.Purge)
+
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+	group.Post("/", middleware.RequirePermission(r.authz, "create_payment_method"), r.handler.Create)
+	group.Get("/", middleware.RequirePermission(r.authz, "view_payment_method"), r.handler.List)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_payment_method"), r.handler.Get)
+	group.Put("/:id", middleware.RequirePermission(r.authz, "update_payment_method"), r.handler.Update)
+	group.Delete("/:id", middleware.RequirePermission(r.authz, "delete_payment_method"), r.handler.Delete)
+	group.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_payment_method"), r.handler.Purge)
 }
 
-func NewRoutes(h *handler.Handler) *Routes {
-	return &Routes{handler: h}
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
 }
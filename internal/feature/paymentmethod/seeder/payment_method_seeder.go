@@ -48,6 +48,12 @@ func (s *PaymentMethodSeeder) Seed(ctx context.Context) error {
 			Description: "Bank Transfer payment",
 			Type:        types.PaymentMethodTransfer,
 		},
+		{
+			ID:          uuid.MustParse("44444444-1111-1111-1111-111111111111"),
+			Name:        "Wallet",
+			Description: "Prepaid customer wallet",
+			Type:        types.PaymentMethodWallet,
+		},
 	}
 
 	for _, m := range methods {
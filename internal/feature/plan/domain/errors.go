@@ -6,6 +6,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/money"
 )
 
 type PlanError struct {
@@ -36,7 +37,8 @@ func NewPlanError(err error) PlanError {
 // HandlePlanError centralizes common error handling for plan operations.
 func HandlePlanError(c *fiber.Ctx, err error) error {
 	switch {
-	case errors.Is(err, ErrPlanNotActive):
+	case errors.Is(err, ErrPlanNotActive),
+		errors.Is(err, money.ErrUnsupportedCurrency):
 		return httpx.BadRequest(c, err.Error())
 
 	case errors.Is(err, ErrPlanDeleted),
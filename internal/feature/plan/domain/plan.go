@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -12,7 +13,8 @@ type Plan struct {
 	ID              uuid.UUID
 	Name            string
 	Description     *string
-	Price           float64
+	Price           money.Money
+	Currency        money.Currency
 	BillingInterval types.BillingInterval
 	Features        *PlanFeatures
 	Active          bool
@@ -22,10 +24,11 @@ type Plan struct {
 }
 
 // NewPlan creates a new Plan with default values.
-func NewPlan(name string, description *string, price float64, billingInterval types.BillingInterval, features *PlanFeatures) *Plan {
+func NewPlan(name string, description *string, price money.Money, billingInterval types.BillingInterval, features *PlanFeatures) *Plan {
 	return &Plan{
 		Name:            name,
 		Price:           price,
+		Currency:        money.DefaultCurrency,
 		BillingInterval: billingInterval,
 		Features:        features,
 	}
@@ -42,6 +45,9 @@ func (p *Plan) Update(update *Plan) {
 	if update.Price != 0 {
 		p.Price = update.Price
 	}
+	if update.Currency != "" {
+		p.Currency = update.Currency.Normalize()
+	}
 	if update.BillingInterval != "" {
 		p.BillingInterval = update.BillingInterval.Normalize()
 	}
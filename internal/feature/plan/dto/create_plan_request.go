@@ -3,6 +3,7 @@ package dto
 import (
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/plan/domain"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 	"github.com/umardev500/laundry/pkg/utils"
 )
@@ -25,7 +26,8 @@ func (f *PlanFeaturesDTO) ToDomain() *domain.PlanFeatures {
 type CreatePlanRequest struct {
 	Name            string           `json:"name" validate:"required,min=2,max=200"`
 	Description     string           `json:"description,omitempty" validate:"omitempty,max=1024"`
-	Price           float64          `json:"price,omitempty"`
+	Price           money.Money      `json:"price,omitempty"`
+	Currency        money.Currency   `json:"currency,omitempty" validate:"omitempty,len=3"`
 	BillingInterval string           `json:"billing_interval,omitempty"`
 	Features        *PlanFeaturesDTO `json:"features,omitempty"` // structured JSON
 }
@@ -36,6 +38,7 @@ func (r *CreatePlanRequest) ToDomain(ctx *appctx.Context) *domain.Plan {
 		Name:            r.Name,
 		Description:     utils.NilIfZero(r.Description, ""),
 		Price:           r.Price,
+		Currency:        r.Currency.Normalize(),
 		BillingInterval: types.BillingInterval(r.BillingInterval),
 		Features:        r.Features.ToDomain(),
 	}
@@ -4,17 +4,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
 )
 
 // PlanResponse represents the Plan data returned to clients.
 type PlanResponse struct {
-	ID              uuid.UUID `json:"id"`
-	Name            string    `json:"name"`
-	Description     *string   `json:"description,omitempty"`
-	Price           float64   `json:"price"`
-	BillingInterval string    `json:"billing_interval"`
-	Features        any       `json:"features,omitempty"` // structured features
-	Active          bool      `json:"active"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Description     *string        `json:"description,omitempty"`
+	Price           money.Money    `json:"price"`
+	Currency        money.Currency `json:"currency"`
+	BillingInterval string         `json:"billing_interval"`
+	Features        any            `json:"features,omitempty"` // structured features
+	Active          bool           `json:"active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
 }
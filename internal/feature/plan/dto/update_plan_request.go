@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/plan/domain"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -11,7 +12,8 @@ import (
 type UpdatePlanRequest struct {
 	Name            string                `json:"name,omitempty" validate:"omitempty,min=2,max=200"`
 	Description     string                `json:"description,omitempty" validate:"omitempty,max=1024"`
-	Price           float64               `json:"price,omitempty"`
+	Price           money.Money           `json:"price,omitempty"`
+	Currency        money.Currency        `json:"currency,omitempty" validate:"omitempty,len=3"`
 	BillingInterval types.BillingInterval `json:"billing_interval,omitempty"`
 	Features        *PlanFeaturesDTO      `json:"features,omitempty"`
 }
@@ -24,6 +26,7 @@ func (r *UpdatePlanRequest) ToDomain(ctx *appctx.Context, id uuid.UUID) *domain.
 		Name:            r.Name,
 		Description:     &r.Description,
 		Price:           r.Price,
+		Currency:        r.Currency,
 		BillingInterval: r.BillingInterval,
 		Features:        r.Features.ToDomain(),
 	}
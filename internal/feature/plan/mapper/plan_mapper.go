@@ -33,6 +33,7 @@ func FromEnt(e *ent.Plan) *domain.Plan {
 		Name:            e.Name,
 		Description:     e.Description,
 		Price:           e.Price,
+		Currency:        e.Currency,
 		BillingInterval: types.BillingInterval(e.BillingInterval),
 		Features:        features,
 		Active:          e.Active,
@@ -61,6 +62,7 @@ func ToResponse(d *domain.Plan) *dto.PlanResponse {
 		Name:            d.Name,
 		Description:     d.Description,
 		Price:           d.Price,
+		Currency:        d.Currency,
 		BillingInterval: string(d.BillingInterval),
 		Features:        d.Features,
 		Active:          d.Active,
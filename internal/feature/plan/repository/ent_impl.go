@@ -38,6 +38,7 @@ func (r *entImpl) Create(ctx *appctx.Context, p *domain.Plan) (*domain.Plan, err
 		SetName(p.Name).
 		SetNillableDescription(p.Description).
 		SetPrice(p.Price).
+		SetCurrency(p.Currency.Normalize()).
 		SetBillingInterval(plan.BillingInterval(p.BillingInterval)).
 		SetFeatures(featuresMap).
 		SetActive(p.Active).
@@ -89,6 +90,7 @@ func (r *entImpl) Update(ctx *appctx.Context, p *domain.Plan) (*domain.Plan, err
 		SetName(p.Name).
 		SetNillableDescription(p.Description).
 		SetPrice(p.Price).
+		SetCurrency(p.Currency.Normalize()).
 		SetBillingInterval(plan.BillingInterval(p.BillingInterval)).
 		SetFeatures(featuresMap).
 		SetActive(p.Active)
@@ -4,13 +4,18 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/plan/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -18,23 +23,25 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("plans")
 
-	group.Use(middleware.CheckAuth(r.config))
-	group.Post("/", r.handler.Create)
-	group.Get("/", r.handler.List)
-	group.Get("/:id", r.handler.Get)
-	group.Put("/:id", r.handler.Update)
-	group.Delete("/:id", r.handler.Delete)
-	group.Delete("/:id/purge", r.handler.Purge)
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+	group.Post("/", middleware.RequirePermission(r.authz, "create_plan"), r.handler.Create)
+	group.Get("/", middleware.RequirePermission(r.authz, "view_plan"), r.handler.List)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_plan"), r.handler.Get)
+	group.Put("/:id", middleware.RequirePermission(r.authz, "update_plan"), r.handler.Update)
+	group.Delete("/:id", middleware.RequirePermission(r.authz, "delete_plan"), r.handler.Delete)
+	group.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_plan"), r.handler.Purge)
 
 	// Status endpoints
-	group.Patch("/:id/activate", r.handler.Activate)
-	group.Patch("/:id/deactivate", r.handler.Deactivate)
-	group.Patch("/:id/restore", r.handler.Restore)
+	group.Patch("/:id/activate", middleware.RequirePermission(r.authz, "update_plan"), r.handler.Activate)
+	group.Patch("/:id/deactivate", middleware.RequirePermission(r.authz, "update_plan"), r.handler.Deactivate)
+	group.Patch("/:id/restore", middleware.RequirePermission(r.authz, "update_plan"), r.handler.Restore)
 }
 
-func NewRoutes(h *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: h,
-		config:  config,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
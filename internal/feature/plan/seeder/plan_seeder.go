@@ -10,6 +10,7 @@ import (
 
 	"github.com/umardev500/laundry/ent/plan"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -26,11 +27,56 @@ func (s *PlanSeeder) Seed(ctx context.Context) error {
 
 	conn := s.client.GetConn(ctx)
 
+	// Tenant-facing permissions that tenant roles may be granted (see rbac PermissionSeeder).
+	tenantPermissions := []uuid.UUID{
+		uuid.MustParse("aaaaaaaa-1111-1111-1111-aaaaaaaaaaaa"), // create_user
+		uuid.MustParse("bbbbbbbb-1111-1111-1111-bbbbbbbbbbbb"), // create_role
+		uuid.MustParse("bbbbbbbb-2222-2222-2222-bbbbbbbbbbbb"), // update_role
+		uuid.MustParse("bbbbbbbb-3333-3333-3333-bbbbbbbbbbbb"), // view_role
+		uuid.MustParse("bbbbbbbb-4444-4444-4444-bbbbbbbbbbbb"), // delete_role
+		uuid.MustParse("cccccccc-3333-3333-3333-cccccccccccc"), // view_permission
+		uuid.MustParse("dddddddd-5555-5555-5555-dddddddddddd"), // view_tenant_user
+		uuid.MustParse("dddddddd-6666-6666-6666-dddddddddddd"), // create_tenant_user
+		uuid.MustParse("dddddddd-7777-7777-7777-dddddddddddd"), // update_tenant_user
+		uuid.MustParse("dddddddd-8888-8888-8888-dddddddddddd"), // delete_tenant_user
+		uuid.MustParse("eeeeeeee-1111-1111-1111-eeeeeeeeeeee"), // create_order
+		uuid.MustParse("eeeeeeee-2222-2222-2222-eeeeeeeeeeee"), // update_order
+		uuid.MustParse("eeeeeeee-4444-4444-4444-eeeeeeeeeeee"), // view_order
+		uuid.MustParse("ffffffff-1111-1111-1111-ffffffffffff"), // view_machine
+		uuid.MustParse("ffffffff-2222-2222-2222-ffffffffffff"), // create_machine
+		uuid.MustParse("ffffffff-3333-3333-3333-ffffffffffff"), // update_machine
+		uuid.MustParse("ffffffff-4444-4444-4444-ffffffffffff"), // delete_machine
+		uuid.MustParse("a1a1a1a1-1111-1111-1111-a1a1a1a1a1a1"), // view_service
+		uuid.MustParse("a1a1a1a1-2222-2222-2222-a1a1a1a1a1a1"), // create_service
+		uuid.MustParse("a1a1a1a1-3333-3333-3333-a1a1a1a1a1a1"), // update_service
+		uuid.MustParse("a1a1a1a1-4444-4444-4444-a1a1a1a1a1a1"), // delete_service
+		uuid.MustParse("a2a2a2a2-7777-7777-7777-a2a2a2a2a2a2"), // view_payment_method
+		uuid.MustParse("a3a3a3a3-4444-4444-4444-a3a3a3a3a3a3"), // view_plan
+		uuid.MustParse("a2a2a2a2-4444-4444-4444-a2a2a2a2a2a2"), // view_refund
+		uuid.MustParse("a2a2a2a2-5555-5555-5555-a2a2a2a2a2a2"), // request_refund
+		uuid.MustParse("a2a2a2a2-6666-6666-6666-a2a2a2a2a2a2"), // approve_refund
+		uuid.MustParse("a5a5a5a5-1111-1111-1111-a5a5a5a5a5a5"), // operate_cash_shift
+		uuid.MustParse("a5a5a5a5-2222-2222-2222-a5a5a5a5a5a5"), // view_cash_shift
+		uuid.MustParse("a6a6a6a6-1111-1111-1111-a6a6a6a6a6a6"), // view_wallet
+		uuid.MustParse("a6a6a6a6-2222-2222-2222-a6a6a6a6a6a6"), // top_up_wallet
+		uuid.MustParse("a6a6a6a6-3333-3333-3333-a6a6a6a6a6a6"), // adjust_wallet
+		uuid.MustParse("a7a7a7a7-1111-1111-1111-a7a7a7a7a7a7"), // view_promotion
+		uuid.MustParse("a7a7a7a7-2222-2222-2222-a7a7a7a7a7a7"), // create_promotion
+		uuid.MustParse("a7a7a7a7-3333-3333-3333-a7a7a7a7a7a7"), // update_promotion
+		uuid.MustParse("a7a7a7a7-4444-4444-4444-a7a7a7a7a7a7"), // delete_promotion
+		uuid.MustParse("eeeeeeee-3333-3333-3333-eeeeeeeeeeee"), // view_tax_summary
+		uuid.MustParse("a8a8a8a8-1111-1111-1111-a8a8a8a8a8a8"), // view_modifier
+		uuid.MustParse("a8a8a8a8-2222-2222-2222-a8a8a8a8a8a8"), // create_modifier
+		uuid.MustParse("a8a8a8a8-3333-3333-3333-a8a8a8a8a8a8"), // update_modifier
+		uuid.MustParse("a8a8a8a8-4444-4444-4444-a8a8a8a8a8a8"), // delete_modifier
+		uuid.MustParse("a4a4a4a4-1111-1111-1111-a4a4a4a4a4a4"), // view_subscription
+	}
+
 	plans := []struct {
 		ID              uuid.UUID
 		Name            string
 		Description     string
-		Price           float64
+		Price           money.Money
 		BillingInterval types.BillingInterval
 		Features        map[string]any
 		Active          bool
@@ -40,25 +86,25 @@ func (s *PlanSeeder) Seed(ctx context.Context) error {
 			ID:              uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
 			Name:            "Basic",
 			Description:     "Basic plan with limited features",
-			Price:           9.99,
+			Price:           money.MustParse("9.99"),
 			BillingInterval: types.BillingIntervalMonthly,
 			Features: map[string]any{
 				"max_users": 1,
 			},
 			Active:      true,
-			Permissions: []uuid.UUID{uuid.MustParse("aaaaaaaa-1111-1111-1111-aaaaaaaaaaaa")}, // Example permission ID
+			Permissions: tenantPermissions,
 		},
 		{
 			ID:              uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
 			Name:            "Premium",
 			Description:     "Premium plan with full features",
-			Price:           29.99,
+			Price:           money.MustParse("29.99"),
 			BillingInterval: types.BillingIntervalMonthly,
 			Features: map[string]any{
 				"max_users": 10,
 			},
 			Active:      false,
-			Permissions: []uuid.UUID{uuid.MustParse("aaaaaaaa-1111-1111-1111-aaaaaaaaaaaa")}, // Example permission ID
+			Permissions: tenantPermissions,
 		},
 	}
 
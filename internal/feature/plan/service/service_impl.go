@@ -74,6 +74,10 @@ func (s *planService) Create(ctx *appctx.Context, p *domain.Plan) (*domain.Plan,
 		return nil, domain.ErrPlanAlreadyExists
 	}
 
+	if err := p.Currency.Validate(); err != nil {
+		return nil, err
+	}
+
 	if p.ID == uuid.Nil {
 		p.ID = uuid.New()
 	}
@@ -113,6 +117,12 @@ func (s *planService) Update(ctx *appctx.Context, p *domain.Plan) (*domain.Plan,
 		return nil, err
 	}
 
+	if p.Currency != "" {
+		if err := p.Currency.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	existing.Update(p) // update non-nil fields
 
 	return s.repo.Update(ctx, existing)
@@ -2,12 +2,20 @@ package platformuser
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/feature/platformuser/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 // Ensure Routes implements the RouteRegistrar interface
@@ -17,17 +25,21 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	pu := router.Group("platform-users")
 
-	pu.Post("/", r.handler.Create)
-	pu.Get("/", r.handler.List)
-	pu.Get("/:id", r.handler.Get)
-	pu.Delete("/:id", r.handler.Delete)
-	pu.Delete("/:id/purge", r.handler.Purge)
-	pu.Patch("/:id/status/:status", r.handler.UpdateStatus)
+	pu.Use(middleware.CheckAuth(r.keys, r.sessions))
+	pu.Post("/", middleware.RequirePermission(r.authz, "create_platform_user"), r.handler.Create)
+	pu.Get("/", middleware.RequirePermission(r.authz, "view_platform_user"), r.handler.List)
+	pu.Get("/:id", middleware.RequirePermission(r.authz, "view_platform_user"), r.handler.Get)
+	pu.Delete("/:id", middleware.RequirePermission(r.authz, "delete_platform_user"), r.handler.Delete)
+	pu.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_platform_user"), r.handler.Purge)
+	pu.Patch("/:id/status/:status", middleware.RequirePermission(r.authz, "update_platform_user"), r.handler.UpdateStatus)
 }
 
 // NewRoutes returns a new Routes instance
-func NewRoutes(handler *handler.Handler) *Routes {
+func NewRoutes(handler *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: handler,
+		handler:  handler,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
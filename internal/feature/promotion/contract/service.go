@@ -0,0 +1,26 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/internal/feature/promotion/query"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// Service defines the business logic for promotions
+type Service interface {
+	Create(ctx *appctx.Context, p *domain.Promotion) (*domain.Promotion, error)
+	List(ctx *appctx.Context, q *query.ListPromotionQuery) (*pagination.PageData[domain.Promotion], error)
+	GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.Promotion, error)
+	Update(ctx *appctx.Context, id uuid.UUID, u *domain.PromotionUpdate) (*domain.Promotion, error)
+	Delete(ctx *appctx.Context, id uuid.UUID) error // soft delete
+
+	// Evaluate works out the discounts for a cart: the promotions behind the
+	// codes, which must all apply, plus the automatic ones that fit.
+	Evaluate(ctx *appctx.Context, cart *domain.Cart, codes []string) ([]*domain.Discount, error)
+
+	// Redeem records the discounts as used by the order and counts them
+	// against the usage limits. Call it inside the order transaction.
+	Redeem(ctx *appctx.Context, orderID uuid.UUID, cart *domain.Cart, discounts []*domain.Discount) error
+}
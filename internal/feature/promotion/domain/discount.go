@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Cart is what promotions are evaluated against: the priced lines of an order.
+type Cart struct {
+	TenantID uuid.UUID
+	UserID   *uuid.UUID // nil for guests
+	Lines    []CartLine
+}
+
+// CartLine is one priced order item.
+type CartLine struct {
+	ServiceID         uuid.UUID
+	ServiceCategoryID *uuid.UUID
+	Subtotal          money.Money
+}
+
+// Subtotal returns the cart total before discounts.
+func (c *Cart) Subtotal() money.Money {
+	var total money.Money
+	for _, l := range c.Lines {
+		total += l.Subtotal
+	}
+	return total
+}
+
+// Discount is what one promotion takes off an order.
+type Discount struct {
+	PromotionID uuid.UUID
+	Code        *string
+	Name        string
+	Scope       types.PromotionScope
+	Amount      money.Money
+	Lines       []money.Money // share of each cart line, nil when loaded back from a redemption
+}
+
+// TotalDiscount adds up the discounts.
+func TotalDiscount(discounts []*Discount) money.Money {
+	var total money.Money
+	for _, d := range discounts {
+		total += d.Amount
+	}
+	return total
+}
+
+// Apply works out the discounts of the promotions on the cart. Item
+// promotions go first, then order promotions; each one takes its cut of what
+// the previous ones left so the cart never drops below zero. Promotions that
+// end up taking nothing off are left out.
+func Apply(cart *Cart, promotions []*Promotion) []*Discount {
+	ordered := slices.Clone(promotions)
+	slices.SortStableFunc(ordered, func(a, b *Promotion) int {
+		return scopeRank(a.Scope) - scopeRank(b.Scope)
+	})
+
+	remaining := make([]money.Money, len(cart.Lines))
+	for i, l := range cart.Lines {
+		remaining[i] = l.Subtotal
+	}
+
+	discounts := []*Discount{}
+	for _, p := range ordered {
+		d := &Discount{
+			PromotionID: p.ID,
+			Code:        p.Code,
+			Name:        p.Name,
+			Scope:       p.Scope,
+		}
+
+		// Spread the discount over the lines it covers, in proportion to what is left of them
+		weights := make([]money.Money, len(remaining))
+		for i, l := range cart.Lines {
+			if p.appliesTo(l) {
+				weights[i] = remaining[i]
+			}
+		}
+		d.Amount = p.discountOn(money.Sum(weights...))
+		if !d.Amount.IsPositive() {
+			continue
+		}
+
+		d.Lines = d.Amount.Allocate(weights)
+		for i := range remaining {
+			remaining[i] -= d.Lines[i]
+		}
+
+		discounts = append(discounts, d)
+	}
+
+	return discounts
+}
+
+// Select decides which promotions go together. Requested promotions must all
+// be used, so an exclusive one cannot be requested with anything else, and
+// automatic promotions only join stackable requested ones. Without requested
+// promotions the automatic ones compete: all stackable ones together or a
+// single exclusive one, whichever takes more off.
+func Select(cart *Cart, requested, automatic []*Promotion) ([]*Promotion, error) {
+	if len(requested) > 1 {
+		for _, p := range requested {
+			if !p.Stackable {
+				return nil, fmt.Errorf("%w: %s", ErrPromotionNotStackable, p.Name)
+			}
+		}
+	}
+	if len(requested) == 1 && !requested[0].Stackable {
+		return requested, nil
+	}
+
+	best := slices.Clone(requested)
+	var exclusive []*Promotion
+	for _, p := range automatic {
+		if p.Stackable {
+			best = append(best, p)
+		} else {
+			exclusive = append(exclusive, p)
+		}
+	}
+	if len(requested) > 0 {
+		return best, nil
+	}
+
+	bestAmount := TotalDiscount(Apply(cart, best))
+	for _, p := range exclusive {
+		if amount := TotalDiscount(Apply(cart, []*Promotion{p})); amount > bestAmount {
+			best, bestAmount = []*Promotion{p}, amount
+		}
+	}
+
+	return best, nil
+}
+
+// scopeRank orders item promotions before order promotions.
+func scopeRank(s types.PromotionScope) int {
+	if s == types.PromotionScopeOrder {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "errors"
+
+var (
+	ErrPromotionNotFound       = errors.New("promotion not found")
+	ErrPromotionDeleted        = errors.New("promotion has been deleted")
+	ErrPromotionTenantRequired = errors.New("promotions are managed per tenant")
+	ErrPromoCodeTaken          = errors.New("promo code is already used by another promotion")
+	ErrInvalidPercentOff       = errors.New("percent promotions need a percent_off above 0 and at most 100")
+	ErrInvalidAmountOff        = errors.New("fixed promotions need an amount_off above 0")
+	ErrPromotionTargetRequired = errors.New("service promotions need a service_id and category promotions a service_category_id")
+	ErrInvalidPromotionWindow  = errors.New("promotion must end after it starts")
+	ErrInvalidUsageLimit       = errors.New("usage limits must be greater than zero")
+	ErrPromoCodeNotFound       = errors.New("promo code not found")
+	ErrPromotionInactive       = errors.New("promotion is not active")
+	ErrPromotionNotStarted     = errors.New("promotion has not started yet")
+	ErrPromotionExpired        = errors.New("promotion has expired")
+	ErrPromotionUsedUp         = errors.New("promotion has reached its usage limit")
+	ErrCustomerLimitReached    = errors.New("customer has already used this promotion the maximum number of times")
+	ErrPromotionMembersOnly    = errors.New("promotion is for registered customers only")
+	ErrMinSpendNotMet          = errors.New("order does not reach the promotion's minimum spend")
+	ErrPromotionNotApplicable  = errors.New("promotion does not apply to any item of the order")
+	ErrPromotionNotStackable   = errors.New("promotion cannot be combined with other promotions")
+)
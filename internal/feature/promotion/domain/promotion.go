@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Promotion is a tenant's discount rule. Promotions with a code apply when a
+// customer enters it; promotions without one apply automatically.
+type Promotion struct {
+	ID                 uuid.UUID
+	TenantID           uuid.UUID
+	Code               *string
+	Name               string
+	Description        *string
+	Type               types.PromotionType
+	PercentOff         float64
+	AmountOff          money.Money
+	Scope              types.PromotionScope
+	ServiceID          *uuid.UUID
+	ServiceCategoryID  *uuid.UUID
+	MinSpend           money.Money
+	MaxUses            *int
+	MaxUsesPerCustomer *int
+	UsedCount          int
+	MembersOnly        bool
+	Stackable          bool
+	StartsAt           *time.Time
+	EndsAt             *time.Time
+	Active             bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          *time.Time
+}
+
+// PromotionUpdate holds the fields to change on a promotion; nil fields are kept.
+type PromotionUpdate struct {
+	Code               *string
+	Name               *string
+	Description        *string
+	Type               *types.PromotionType
+	PercentOff         *float64
+	AmountOff          *money.Money
+	Scope              *types.PromotionScope
+	ServiceID          *uuid.UUID
+	ServiceCategoryID  *uuid.UUID
+	MinSpend           *money.Money
+	MaxUses            *int
+	MaxUsesPerCustomer *int
+	MembersOnly        *bool
+	Stackable          *bool
+	StartsAt           *time.Time
+	EndsAt             *time.Time
+	Active             *bool
+}
+
+// NormalizeCode trims and upper-cases a promo code so lookups ignore case.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Normalize tidies the code and enums and fills in the default scope.
+func (p *Promotion) Normalize() {
+	if p.Code != nil {
+		code := NormalizeCode(*p.Code)
+		p.Code = &code
+		if code == "" {
+			p.Code = nil
+		}
+	}
+
+	p.Type = p.Type.Normalize()
+	p.Scope = p.Scope.Normalize()
+	if p.Scope == "" {
+		p.Scope = types.PromotionScopeOrder
+	}
+
+	// Only the target of the scope is kept
+	if p.Scope != types.PromotionScopeService {
+		p.ServiceID = nil
+	}
+	if p.Scope != types.PromotionScopeCategory {
+		p.ServiceCategoryID = nil
+	}
+}
+
+// Validate checks the promotion is a rule that can be applied.
+func (p *Promotion) Validate() error {
+	switch p.Type {
+	case types.PromotionTypePercent:
+		if p.PercentOff <= 0 || p.PercentOff > 100 {
+			return ErrInvalidPercentOff
+		}
+	case types.PromotionTypeFixed:
+		if !p.AmountOff.IsPositive() {
+			return ErrInvalidAmountOff
+		}
+	}
+
+	if (p.Scope == types.PromotionScopeService && p.ServiceID == nil) ||
+		(p.Scope == types.PromotionScopeCategory && p.ServiceCategoryID == nil) {
+		return ErrPromotionTargetRequired
+	}
+
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return ErrInvalidPromotionWindow
+	}
+
+	if (p.MaxUses != nil && *p.MaxUses <= 0) || (p.MaxUsesPerCustomer != nil && *p.MaxUsesPerCustomer <= 0) {
+		return ErrInvalidUsageLimit
+	}
+
+	return nil
+}
+
+// Update applies the non-nil fields of u.
+func (p *Promotion) Update(u *PromotionUpdate) {
+	if u.Code != nil {
+		p.Code = u.Code
+	}
+	if u.Name != nil {
+		p.Name = *u.Name
+	}
+	if u.Description != nil {
+		p.Description = u.Description
+	}
+	if u.Type != nil {
+		p.Type = *u.Type
+	}
+	if u.PercentOff != nil {
+		p.PercentOff = *u.PercentOff
+	}
+	if u.AmountOff != nil {
+		p.AmountOff = *u.AmountOff
+	}
+	if u.Scope != nil {
+		p.Scope = *u.Scope
+	}
+	if u.ServiceID != nil {
+		p.ServiceID = u.ServiceID
+	}
+	if u.ServiceCategoryID != nil {
+		p.ServiceCategoryID = u.ServiceCategoryID
+	}
+	if u.MinSpend != nil {
+		p.MinSpend = *u.MinSpend
+	}
+	if u.MaxUses != nil {
+		p.MaxUses = u.MaxUses
+	}
+	if u.MaxUsesPerCustomer != nil {
+		p.MaxUsesPerCustomer = u.MaxUsesPerCustomer
+	}
+	if u.MembersOnly != nil {
+		p.MembersOnly = *u.MembersOnly
+	}
+	if u.Stackable != nil {
+		p.Stackable = *u.Stackable
+	}
+	if u.StartsAt != nil {
+		p.StartsAt = u.StartsAt
+	}
+	if u.EndsAt != nil {
+		p.EndsAt = u.EndsAt
+	}
+	if u.Active != nil {
+		p.Active = *u.Active
+	}
+}
+
+// IsAutomatic reports whether the promotion applies without a code.
+func (p *Promotion) IsAutomatic() bool {
+	return p.Code == nil
+}
+
+// IsDeleted returns true if the promotion has been soft-deleted.
+func (p *Promotion) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+// SoftDelete marks the promotion as deleted.
+func (p *Promotion) SoftDelete() {
+	now := time.Now()
+	p.DeletedAt = &now
+}
+
+// BelongsToTenant reports whether the promotion is run by the tenant.
+func (p *Promotion) BelongsToTenant(tenantID uuid.UUID) bool {
+	return p.TenantID == tenantID
+}
+
+// CheckAvailable reports why the promotion cannot be used at the given time, if it cannot.
+func (p *Promotion) CheckAvailable(now time.Time) error {
+	switch {
+	case !p.Active || p.IsDeleted():
+		return ErrPromotionInactive
+	case p.StartsAt != nil && now.Before(*p.StartsAt):
+		return ErrPromotionNotStarted
+	case p.EndsAt != nil && !now.Before(*p.EndsAt):
+		return ErrPromotionExpired
+	case p.MaxUses != nil && p.UsedCount >= *p.MaxUses:
+		return ErrPromotionUsedUp
+	}
+	return nil
+}
+
+// CheckEligible reports why the promotion cannot be used on the cart, if it
+// cannot. customerUses is how often the cart's customer already used it.
+func (p *Promotion) CheckEligible(cart *Cart, now time.Time, customerUses int) error {
+	if err := p.CheckAvailable(now); err != nil {
+		return err
+	}
+
+	if (p.MembersOnly || p.MaxUsesPerCustomer != nil) && cart.UserID == nil {
+		return ErrPromotionMembersOnly
+	}
+	if p.MaxUsesPerCustomer != nil && customerUses >= *p.MaxUsesPerCustomer {
+		return ErrCustomerLimitReached
+	}
+
+	if cart.Subtotal() < p.MinSpend {
+		return ErrMinSpendNotMet
+	}
+
+	for _, l := range cart.Lines {
+		if p.appliesTo(l) {
+			return nil
+		}
+	}
+	return ErrPromotionNotApplicable
+}
+
+// appliesTo reports whether the promotion covers the cart line.
+func (p *Promotion) appliesTo(l CartLine) bool {
+	switch p.Scope {
+	case types.PromotionScopeService:
+		return p.ServiceID != nil && l.ServiceID == *p.ServiceID
+	case types.PromotionScopeCategory:
+		return p.ServiceCategoryID != nil && l.ServiceCategoryID != nil && *l.ServiceCategoryID == *p.ServiceCategoryID
+	default:
+		return true
+	}
+}
+
+// discountOn returns what the promotion takes off the base amount.
+func (p *Promotion) discountOn(base money.Money) money.Money {
+	if p.Type == types.PromotionTypePercent {
+		return base.Percent(p.PercentOff)
+	}
+	return money.Min(p.AmountOff, base)
+}
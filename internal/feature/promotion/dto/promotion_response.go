@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// PromotionResponse represents a promotion returned to clients
+type PromotionResponse struct {
+	ID                 uuid.UUID            `json:"id"`
+	TenantID           uuid.UUID            `json:"tenant_id"`
+	Code               *string              `json:"code,omitempty"`
+	Name               string               `json:"name"`
+	Description        *string              `json:"description,omitempty"`
+	Type               types.PromotionType  `json:"type"`
+	PercentOff         float64              `json:"percent_off,omitempty"`
+	AmountOff          money.Money          `json:"amount_off,omitempty"`
+	Scope              types.PromotionScope `json:"scope"`
+	ServiceID          *uuid.UUID           `json:"service_id,omitempty"`
+	ServiceCategoryID  *uuid.UUID           `json:"service_category_id,omitempty"`
+	MinSpend           money.Money          `json:"min_spend"`
+	MaxUses            *int                 `json:"max_uses,omitempty"`
+	MaxUsesPerCustomer *int                 `json:"max_uses_per_customer,omitempty"`
+	UsedCount          int                  `json:"used_count"`
+	MembersOnly        bool                 `json:"members_only"`
+	Stackable          bool                 `json:"stackable"`
+	StartsAt           *time.Time           `json:"starts_at,omitempty"`
+	EndsAt             *time.Time           `json:"ends_at,omitempty"`
+	Active             bool                 `json:"active"`
+	CreatedAt          time.Time            `json:"created_at"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+	DeletedAt          *time.Time           `json:"deleted_at,omitempty"`
+}
+
+// DiscountResponse is a promotion applied to an order, as shown on receipts
+type DiscountResponse struct {
+	PromotionID uuid.UUID   `json:"promotion_id"`
+	Code        *string     `json:"code,omitempty"`
+	Name        string      `json:"name"`
+	Amount      money.Money `json:"amount"`
+}
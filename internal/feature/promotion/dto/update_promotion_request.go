@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// UpdatePromotionRequest is the payload for changing a promotion; omitted fields are kept.
+type UpdatePromotionRequest struct {
+	Code               *string      `json:"code,omitempty" validate:"omitempty,min=3,max=32,alphanum"`
+	Name               *string      `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Description        *string      `json:"description,omitempty" validate:"omitempty,max=255"`
+	Type               *string      `json:"type,omitempty" validate:"omitempty,oneof=percent fixed"`
+	PercentOff         *float64     `json:"percent_off,omitempty" validate:"omitempty,gt=0,lte=100"`
+	AmountOff          *money.Money `json:"amount_off,omitempty" validate:"omitempty,gt=0"`
+	Scope              *string      `json:"scope,omitempty" validate:"omitempty,oneof=order service category"`
+	ServiceID          *uuid.UUID   `json:"service_id,omitempty"`
+	ServiceCategoryID  *uuid.UUID   `json:"service_category_id,omitempty"`
+	MinSpend           *money.Money `json:"min_spend,omitempty" validate:"omitempty,gte=0"`
+	MaxUses            *int         `json:"max_uses,omitempty" validate:"omitempty,gt=0"`
+	MaxUsesPerCustomer *int         `json:"max_uses_per_customer,omitempty" validate:"omitempty,gt=0"`
+	MembersOnly        *bool        `json:"members_only,omitempty"`
+	Stackable          *bool        `json:"stackable,omitempty"`
+	StartsAt           *time.Time   `json:"starts_at,omitempty"`
+	EndsAt             *time.Time   `json:"ends_at,omitempty"`
+	Active             *bool        `json:"active,omitempty"`
+}
+
+// ToDomain converts the request to a domain.PromotionUpdate
+func (r *UpdatePromotionRequest) ToDomain() *domain.PromotionUpdate {
+	u := &domain.PromotionUpdate{
+		Code:               r.Code,
+		Name:               r.Name,
+		Description:        r.Description,
+		PercentOff:         r.PercentOff,
+		AmountOff:          r.AmountOff,
+		ServiceID:          r.ServiceID,
+		ServiceCategoryID:  r.ServiceCategoryID,
+		MinSpend:           r.MinSpend,
+		MaxUses:            r.MaxUses,
+		MaxUsesPerCustomer: r.MaxUsesPerCustomer,
+		MembersOnly:        r.MembersOnly,
+		Stackable:          r.Stackable,
+		StartsAt:           r.StartsAt,
+		EndsAt:             r.EndsAt,
+		Active:             r.Active,
+	}
+
+	if r.Type != nil {
+		t := types.PromotionType(*r.Type)
+		u.Type = &t
+	}
+	if r.Scope != nil {
+		s := types.PromotionScope(*r.Scope)
+		u.Scope = &s
+	}
+
+	return u
+}
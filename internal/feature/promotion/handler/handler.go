@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/promotion/contract"
+	"github.com/umardev500/laundry/internal/feature/promotion/dto"
+	"github.com/umardev500/laundry/internal/feature/promotion/mapper"
+	"github.com/umardev500/laundry/internal/feature/promotion/query"
+	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/validator"
+)
+
+type Handler struct {
+	service   contract.Service
+	validator *validator.Validator
+}
+
+func NewHandler(s contract.Service, v *validator.Validator) *Handler {
+	return &Handler{
+		service:   s,
+		validator: v,
+	}
+}
+
+// Create POST /api/promotions
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dto.CreatePromotionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	p, err := h.service.Create(ctx, req.ToDomain())
+	if err != nil {
+		return handlePromotionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToResponse(p))
+}
+
+// List GET /api/promotions
+func (h *Handler) List(c *fiber.Ctx) error {
+	var q query.ListPromotionQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	q.Normalize()
+	ctx := appctx.New(c.UserContext())
+
+	page, err := h.service.List(ctx, &q)
+	if err != nil {
+		return handlePromotionError(c, err)
+	}
+
+	return httpx.JSONPaginated(
+		c,
+		fiber.StatusOK,
+		mapper.ToResponsePage(page).Data,
+		httpx.NewPagination(q.Page, q.Limit, page.Total),
+	)
+}
+
+// Get GET /api/promotions/:id
+func (h *Handler) Get(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid promotion ID")
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	p, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		return handlePromotionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(p))
+}
+
+// Update PUT /api/promotions/:id
+func (h *Handler) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid promotion ID")
+	}
+
+	var req dto.UpdatePromotionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	p, err := h.service.Update(ctx, id, req.ToDomain())
+	if err != nil {
+		return handlePromotionError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(p))
+}
+
+// Delete DELETE /api/promotions/:id
+func (h *Handler) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid promotion ID")
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		return handlePromotionError(c, err)
+	}
+
+	return httpx.NoContent(c)
+}
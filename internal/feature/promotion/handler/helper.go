@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/pkg/httpx"
+)
+
+// handlePromotionError centralizes HTTP error mapping for promotion module
+func handlePromotionError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPromotionNotFound),
+		errors.Is(err, domain.ErrPromotionDeleted):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrPromotionTenantRequired):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, domain.ErrPromoCodeTaken):
+		return httpx.Conflict(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidPercentOff),
+		errors.Is(err, domain.ErrInvalidAmountOff),
+		errors.Is(err, domain.ErrPromotionTargetRequired),
+		errors.Is(err, domain.ErrInvalidPromotionWindow),
+		errors.Is(err, domain.ErrInvalidUsageLimit):
+		return httpx.BadRequest(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
@@ -0,0 +1,134 @@
+package mapper
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/internal/feature/promotion/dto"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// FromEnt converts an Ent Promotion to a domain Promotion
+func FromEnt(e *ent.Promotion) *domain.Promotion {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.Promotion{
+		ID:                 e.ID,
+		TenantID:           e.TenantID,
+		Code:               e.Code,
+		Name:               e.Name,
+		Description:        e.Description,
+		Type:               types.PromotionType(e.Type),
+		PercentOff:         e.PercentOff,
+		AmountOff:          e.AmountOff,
+		Scope:              types.PromotionScope(e.Scope),
+		ServiceID:          e.ServiceID,
+		ServiceCategoryID:  e.ServiceCategoryID,
+		MinSpend:           e.MinSpend,
+		MaxUses:            e.MaxUses,
+		MaxUsesPerCustomer: e.MaxUsesPerCustomer,
+		UsedCount:          e.UsedCount,
+		MembersOnly:        e.MembersOnly,
+		Stackable:          e.Stackable,
+		StartsAt:           e.StartsAt,
+		EndsAt:             e.EndsAt,
+		Active:             e.Active,
+		CreatedAt:          e.CreatedAt,
+		UpdatedAt:          e.UpdatedAt,
+		DeletedAt:          e.DeletedAt,
+	}
+}
+
+// FromEntList converts a slice of Ent Promotions to domain Promotions
+func FromEntList(ents []*ent.Promotion) []*domain.Promotion {
+	promotions := make([]*domain.Promotion, len(ents))
+	for i, e := range ents {
+		promotions[i] = FromEnt(e)
+	}
+	return promotions
+}
+
+// DiscountsFromRedemptions converts the Ent redemptions of an order to the
+// discounts they recorded
+func DiscountsFromRedemptions(ents []*ent.PromotionRedemption) []*domain.Discount {
+	if ents == nil {
+		return nil
+	}
+
+	discounts := make([]*domain.Discount, len(ents))
+	for i, e := range ents {
+		discounts[i] = &domain.Discount{
+			PromotionID: e.PromotionID,
+			Code:        e.Code,
+			Name:        e.Name,
+			Amount:      e.Amount,
+		}
+	}
+	return discounts
+}
+
+// ToResponse converts a domain Promotion to a PromotionResponse DTO
+func ToResponse(d *domain.Promotion) *dto.PromotionResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.PromotionResponse{
+		ID:                 d.ID,
+		TenantID:           d.TenantID,
+		Code:               d.Code,
+		Name:               d.Name,
+		Description:        d.Description,
+		Type:               d.Type,
+		PercentOff:         d.PercentOff,
+		AmountOff:          d.AmountOff,
+		Scope:              d.Scope,
+		ServiceID:          d.ServiceID,
+		ServiceCategoryID:  d.ServiceCategoryID,
+		MinSpend:           d.MinSpend,
+		MaxUses:            d.MaxUses,
+		MaxUsesPerCustomer: d.MaxUsesPerCustomer,
+		UsedCount:          d.UsedCount,
+		MembersOnly:        d.MembersOnly,
+		Stackable:          d.Stackable,
+		StartsAt:           d.StartsAt,
+		EndsAt:             d.EndsAt,
+		Active:             d.Active,
+		CreatedAt:          d.CreatedAt,
+		UpdatedAt:          d.UpdatedAt,
+		DeletedAt:          d.DeletedAt,
+	}
+}
+
+// ToResponsePage converts a paginated list of domain Promotions to DTOs
+func ToResponsePage(data *pagination.PageData[domain.Promotion]) *pagination.PageData[dto.PromotionResponse] {
+	res := make([]*dto.PromotionResponse, len(data.Data))
+	for i, p := range data.Data {
+		res[i] = ToResponse(p)
+	}
+
+	return &pagination.PageData[dto.PromotionResponse]{
+		Data:  res,
+		Total: data.Total,
+	}
+}
+
+// ToDiscountResponseList converts applied discounts to DTOs
+func ToDiscountResponseList(discounts []*domain.Discount) []*dto.DiscountResponse {
+	if discounts == nil {
+		return nil
+	}
+
+	res := make([]*dto.DiscountResponse, len(discounts))
+	for i, d := range discounts {
+		res[i] = &dto.DiscountResponse{
+			PromotionID: d.PromotionID,
+			Code:        d.Code,
+			Name:        d.Name,
+			Amount:      d.Amount,
+		}
+	}
+	return res
+}
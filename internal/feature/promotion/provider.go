@@ -0,0 +1,16 @@
+package promotion
+
+import (
+	"github.com/google/wire"
+	"github.com/umardev500/laundry/internal/feature/promotion/handler"
+	"github.com/umardev500/laundry/internal/feature/promotion/repository"
+	"github.com/umardev500/laundry/internal/feature/promotion/service"
+)
+
+// ProviderSet wires Promotion module dependencies
+var ProviderSet = wire.NewSet(
+	handler.NewHandler,
+	repository.NewEntPromotionRepository,
+	service.NewPromotionService,
+	NewRoutes,
+)
@@ -0,0 +1,16 @@
+package query
+
+import "github.com/umardev500/laundry/pkg/pagination"
+
+// ListPromotionQuery defines filters for listing the promotions of a tenant
+type ListPromotionQuery struct {
+	pagination.Query
+	Active         *bool  `query:"active"`          // Only active or inactive promotions (optional)
+	Code           string `query:"code"`            // Exact promo code (optional)
+	IncludeDeleted bool   `query:"include_deleted"` // Include soft-deleted promotions
+}
+
+// Normalize sets default pagination values
+func (q *ListPromotionQuery) Normalize() {
+	q.Query.Normalize(1, 10) // Default page 1, 10 items per page
+}
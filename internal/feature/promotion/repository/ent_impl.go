@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/promotion"
+	"github.com/umardev500/laundry/ent/promotionredemption"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/internal/feature/promotion/mapper"
+	"github.com/umardev500/laundry/internal/feature/promotion/query"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// EntPromotionRepository implements domain.Promotion repository using Ent
+type EntPromotionRepository struct {
+	client *entdb.Client
+}
+
+// NewEntPromotionRepository creates a new repository instance
+func NewEntPromotionRepository(client *entdb.Client) Repository {
+	return &EntPromotionRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new promotion
+func (r *EntPromotionRepository) Create(ctx *appctx.Context, p *domain.Promotion) (*domain.Promotion, error) {
+	conn := r.client.GetConn(ctx)
+	entPromotion, err := conn.Promotion.
+		Create().
+		SetTenantID(p.TenantID).
+		SetNillableCode(p.Code).
+		SetName(p.Name).
+		SetNillableDescription(p.Description).
+		SetType(promotion.Type(p.Type)).
+		SetPercentOff(p.PercentOff).
+		SetAmountOff(p.AmountOff).
+		SetScope(promotion.Scope(p.Scope)).
+		SetNillableServiceID(p.ServiceID).
+		SetNillableServiceCategoryID(p.ServiceCategoryID).
+		SetMinSpend(p.MinSpend).
+		SetNillableMaxUses(p.MaxUses).
+		SetNillableMaxUsesPerCustomer(p.MaxUsesPerCustomer).
+		SetMembersOnly(p.MembersOnly).
+		SetStackable(p.Stackable).
+		SetNillableStartsAt(p.StartsAt).
+		SetNillableEndsAt(p.EndsAt).
+		SetActive(p.Active).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entPromotion), nil
+}
+
+// Update saves the promotion's rule; the usage count is only changed by Redeem
+func (r *EntPromotionRepository) Update(ctx *appctx.Context, p *domain.Promotion) (*domain.Promotion, error) {
+	conn := r.client.GetConn(ctx)
+	builder := conn.Promotion.
+		UpdateOneID(p.ID).
+		SetName(p.Name).
+		SetType(promotion.Type(p.Type)).
+		SetPercentOff(p.PercentOff).
+		SetAmountOff(p.AmountOff).
+		SetScope(promotion.Scope(p.Scope)).
+		SetMinSpend(p.MinSpend).
+		SetMembersOnly(p.MembersOnly).
+		SetStackable(p.Stackable).
+		SetActive(p.Active)
+
+	// Optional fields are cleared when unset
+	if p.Code != nil {
+		builder.SetCode(*p.Code)
+	} else {
+		builder.ClearCode()
+	}
+	if p.Description != nil {
+		builder.SetDescription(*p.Description)
+	} else {
+		builder.ClearDescription()
+	}
+	if p.ServiceID != nil {
+		builder.SetServiceID(*p.ServiceID)
+	} else {
+		builder.ClearServiceID()
+	}
+	if p.ServiceCategoryID != nil {
+		builder.SetServiceCategoryID(*p.ServiceCategoryID)
+	} else {
+		builder.ClearServiceCategoryID()
+	}
+	if p.MaxUses != nil {
+		builder.SetMaxUses(*p.MaxUses)
+	} else {
+		builder.ClearMaxUses()
+	}
+	if p.MaxUsesPerCustomer != nil {
+		builder.SetMaxUsesPerCustomer(*p.MaxUsesPerCustomer)
+	} else {
+		builder.ClearMaxUsesPerCustomer()
+	}
+	if p.StartsAt != nil {
+		builder.SetStartsAt(*p.StartsAt)
+	} else {
+		builder.ClearStartsAt()
+	}
+	if p.EndsAt != nil {
+		builder.SetEndsAt(*p.EndsAt)
+	} else {
+		builder.ClearEndsAt()
+	}
+	if p.DeletedAt != nil {
+		builder.SetDeletedAt(*p.DeletedAt)
+	} else {
+		builder.ClearDeletedAt()
+	}
+
+	entPromotion, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entPromotion), nil
+}
+
+// FindByID retrieves a promotion by ID
+func (r *EntPromotionRepository) FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Promotion, error) {
+	conn := r.client.GetConn(ctx)
+	entPromotion, err := conn.Promotion.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entPromotion), nil
+}
+
+// FindByCode returns the tenant's promotion with the code that is not deleted
+func (r *EntPromotionRepository) FindByCode(ctx *appctx.Context, tenantID uuid.UUID, code string) (*domain.Promotion, error) {
+	conn := r.client.GetConn(ctx)
+	entPromotion, err := conn.Promotion.
+		Query().
+		Where(
+			promotion.TenantIDEQ(tenantID),
+			promotion.CodeEQ(code),
+			promotion.DeletedAtIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entPromotion), nil
+}
+
+// ListAutomatic returns the tenant's active promotions without a code
+func (r *EntPromotionRepository) ListAutomatic(ctx *appctx.Context, tenantID uuid.UUID) ([]*domain.Promotion, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Promotion.
+		Query().
+		Where(
+			promotion.TenantIDEQ(tenantID),
+			promotion.CodeIsNil(),
+			promotion.Active(true),
+			promotion.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(promotion.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntList(ents), nil
+}
+
+// CountCustomerUses counts the redemptions of a promotion by a customer
+func (r *EntPromotionRepository) CountCustomerUses(ctx *appctx.Context, promotionID, userID uuid.UUID) (int, error) {
+	conn := r.client.GetConn(ctx)
+	return conn.PromotionRedemption.
+		Query().
+		Where(
+			promotionredemption.PromotionIDEQ(promotionID),
+			promotionredemption.UserIDEQ(userID),
+		).
+		Count(ctx)
+}
+
+// Redeem counts a use against the promotion's limits and records it for the order
+func (r *EntPromotionRepository) Redeem(ctx *appctx.Context, orderID uuid.UUID, tenantID uuid.UUID, userID *uuid.UUID, d *domain.Discount) error {
+	conn := r.client.GetConn(ctx)
+
+	// The limit check is part of the update so concurrent orders cannot overrun it
+	affected, err := conn.Promotion.
+		Update().
+		Where(
+			promotion.IDEQ(d.PromotionID),
+			promotion.Or(
+				promotion.MaxUsesIsNil(),
+				func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(promotion.FieldUsedCount), s.C(promotion.FieldMaxUses)))
+				},
+			),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrPromotionUsedUp
+	}
+
+	// The update holds the promotion row until the order commits, so concurrent
+	// orders get here one at a time and the count sees each other's redemptions
+	if userID != nil {
+		p, err := conn.Promotion.
+			Query().
+			Where(promotion.IDEQ(d.PromotionID)).
+			Select(promotion.FieldMaxUsesPerCustomer).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		if p.MaxUsesPerCustomer != nil {
+			uses, err := r.CountCustomerUses(ctx, d.PromotionID, *userID)
+			if err != nil {
+				return err
+			}
+			if uses >= *p.MaxUsesPerCustomer {
+				return domain.ErrCustomerLimitReached
+			}
+		}
+	}
+
+	return conn.PromotionRedemption.
+		Create().
+		SetTenantID(tenantID).
+		SetPromotionID(d.PromotionID).
+		SetOrderID(orderID).
+		SetNillableUserID(userID).
+		SetNillableCode(d.Code).
+		SetName(d.Name).
+		SetAmount(d.Amount).
+		Exec(ctx)
+}
+
+// List retrieves paginated promotions with tenant scoping
+func (r *EntPromotionRepository) List(ctx *appctx.Context, q *query.ListPromotionQuery) (*pagination.PageData[domain.Promotion], error) {
+	q.Normalize()
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.Promotion.Query()
+	qb = r.applyScope(ctx, qb)
+
+	if q.Active != nil {
+		qb = qb.Where(promotion.Active(*q.Active))
+	}
+	if q.Code != "" {
+		qb = qb.Where(promotion.CodeEQ(domain.NormalizeCode(q.Code)))
+	}
+	if !q.IncludeDeleted {
+		qb = qb.Where(promotion.DeletedAtIsNil())
+	}
+
+	total, err := qb.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ents, err := qb.
+		Order(ent.Desc(promotion.FieldCreatedAt)).
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagination.PageData[domain.Promotion]{
+		Data:  mapper.FromEntList(ents),
+		Total: total,
+	}, nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// applyScope ensures tenant-level filtering.
+func (r *EntPromotionRepository) applyScope(ctx *appctx.Context, qb *ent.PromotionQuery) *ent.PromotionQuery {
+	switch ctx.Scope() {
+	case appctx.ScopeTenant:
+		qb = qb.Where(promotion.TenantIDEQ(*ctx.TenantID()))
+	case appctx.ScopeAdmin:
+		// no filtering for admin
+	default:
+		// customers do not manage promotions
+		qb = qb.Where(promotion.IDEQ(uuid.Nil))
+	}
+
+	return qb
+}
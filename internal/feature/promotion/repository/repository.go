@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/internal/feature/promotion/query"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// Repository defines persistence operations for promotions
+type Repository interface {
+	Create(ctx *appctx.Context, p *domain.Promotion) (*domain.Promotion, error)
+	Update(ctx *appctx.Context, p *domain.Promotion) (*domain.Promotion, error)
+	FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Promotion, error)
+	List(ctx *appctx.Context, q *query.ListPromotionQuery) (*pagination.PageData[domain.Promotion], error)
+
+	// FindByCode returns the tenant's promotion with the code that is not deleted
+	FindByCode(ctx *appctx.Context, tenantID uuid.UUID, code string) (*domain.Promotion, error)
+
+	// ListAutomatic returns the tenant's active promotions without a code
+	ListAutomatic(ctx *appctx.Context, tenantID uuid.UUID) ([]*domain.Promotion, error)
+
+	// CountCustomerUses counts the redemptions of a promotion by a customer
+	CountCustomerUses(ctx *appctx.Context, promotionID, userID uuid.UUID) (int, error)
+
+	// Redeem counts a use against the promotion's overall and per-customer
+	// limits and records it for the order. Call it inside a transaction.
+	Redeem(ctx *appctx.Context, orderID uuid.UUID, tenantID uuid.UUID, userID *uuid.UUID, d *domain.Discount) error
+}
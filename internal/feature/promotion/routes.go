@@ -0,0 +1,41 @@
+package promotion
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
+	"github.com/umardev500/laundry/internal/app/router"
+	"github.com/umardev500/laundry/internal/feature/promotion/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+)
+
+type Routes struct {
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
+}
+
+var _ router.RouteRegistrar = (*Routes)(nil)
+
+func (r *Routes) RegisterRoutes(router fiber.Router) {
+	group := router.Group("promotions")
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+
+	group.Get("/", middleware.RequirePermission(r.authz, "view_promotion"), r.handler.List)
+	group.Post("/", middleware.RequirePermission(r.authz, "create_promotion"), r.handler.Create)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_promotion"), r.handler.Get)
+	group.Put("/:id", middleware.RequirePermission(r.authz, "update_promotion"), r.handler.Update)
+	group.Delete("/:id", middleware.RequirePermission(r.authz, "delete_promotion"), r.handler.Delete)
+}
+
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
+}
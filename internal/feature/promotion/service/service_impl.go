@@ -0,0 +1,220 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/promotion/contract"
+	"github.com/umardev500/laundry/internal/feature/promotion/domain"
+	"github.com/umardev500/laundry/internal/feature/promotion/query"
+	"github.com/umardev500/laundry/internal/feature/promotion/repository"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+type promotionService struct {
+	repo repository.Repository
+}
+
+// NewPromotionService creates a new promotion service
+func NewPromotionService(repo repository.Repository) contract.Service {
+	return &promotionService{
+		repo: repo,
+	}
+}
+
+// Create implements contract.Service.
+func (s *promotionService) Create(ctx *appctx.Context, p *domain.Promotion) (*domain.Promotion, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, domain.ErrPromotionTenantRequired
+	}
+	p.TenantID = *tenantID
+
+	p.Normalize()
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCodeFree(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, p)
+}
+
+// List implements contract.Service.
+func (s *promotionService) List(ctx *appctx.Context, q *query.ListPromotionQuery) (*pagination.PageData[domain.Promotion], error) {
+	if q == nil {
+		q = &query.ListPromotionQuery{}
+	}
+	q.Normalize()
+
+	return s.repo.List(ctx, q)
+}
+
+// GetByID implements contract.Service.
+func (s *promotionService) GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.Promotion, error) {
+	return s.findExisting(ctx, id)
+}
+
+// Update implements contract.Service.
+func (s *promotionService) Update(ctx *appctx.Context, id uuid.UUID, u *domain.PromotionUpdate) (*domain.Promotion, error) {
+	p, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Update(u)
+	p.Normalize()
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCodeFree(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, p)
+}
+
+// Delete implements contract.Service.
+func (s *promotionService) Delete(ctx *appctx.Context, id uuid.UUID) error {
+	p, err := s.findExisting(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p.SoftDelete()
+	_, err = s.repo.Update(ctx, p)
+	return err
+}
+
+// Evaluate implements contract.Service.
+func (s *promotionService) Evaluate(ctx *appctx.Context, cart *domain.Cart, codes []string) ([]*domain.Discount, error) {
+	now := time.Now()
+
+	var requested []*domain.Promotion
+	for _, code := range normalizeCodes(codes) {
+		p, err := s.repo.FindByCode(ctx, cart.TenantID, code)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%s: %w", code, domain.ErrPromoCodeNotFound)
+			}
+			return nil, err
+		}
+
+		if err := s.checkEligible(ctx, p, cart, now); err != nil {
+			return nil, fmt.Errorf("%s: %w", code, err)
+		}
+		requested = append(requested, p)
+	}
+
+	candidates, err := s.repo.ListAutomatic(ctx, cart.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Automatic promotions that do not fit the cart are simply left out
+	var automatic []*domain.Promotion
+	for _, p := range candidates {
+		if s.checkEligible(ctx, p, cart, now) == nil {
+			automatic = append(automatic, p)
+		}
+	}
+
+	selected, err := domain.Select(cart, requested, automatic)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.Apply(cart, selected), nil
+}
+
+// Redeem implements contract.Service.
+func (s *promotionService) Redeem(ctx *appctx.Context, orderID uuid.UUID, cart *domain.Cart, discounts []*domain.Discount) error {
+	for _, d := range discounts {
+		if err := s.repo.Redeem(ctx, orderID, cart.TenantID, cart.UserID, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// checkEligible checks the promotion against the cart, counting the
+// customer's earlier uses when the promotion limits them. Redeem checks the
+// limit again when the order is placed, where concurrent orders cannot race.
+func (s *promotionService) checkEligible(ctx *appctx.Context, p *domain.Promotion, cart *domain.Cart, now time.Time) error {
+	var uses int
+	if p.MaxUsesPerCustomer != nil && cart.UserID != nil {
+		var err error
+		uses, err = s.repo.CountCustomerUses(ctx, p.ID, *cart.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.CheckEligible(cart, now, uses)
+}
+
+// checkCodeFree ensures no other live promotion of the tenant has the same code.
+func (s *promotionService) checkCodeFree(ctx *appctx.Context, p *domain.Promotion) error {
+	if p.Code == nil {
+		return nil
+	}
+
+	existing, err := s.repo.FindByCode(ctx, p.TenantID, *p.Code)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID != p.ID {
+		return domain.ErrPromoCodeTaken
+	}
+
+	return nil
+}
+
+// findExisting fetches a promotion of the tenant in context that is not deleted.
+func (s *promotionService) findExisting(ctx *appctx.Context, id uuid.UUID) (*domain.Promotion, error) {
+	p, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrPromotionNotFound
+		}
+		return nil, err
+	}
+
+	if ctx.Scope() != appctx.ScopeAdmin {
+		tenantID := ctx.TenantID()
+		if tenantID == nil || !p.BelongsToTenant(*tenantID) {
+			return nil, domain.ErrPromotionNotFound
+		}
+	}
+	if p.IsDeleted() {
+		return nil, domain.ErrPromotionDeleted
+	}
+
+	return p, nil
+}
+
+// normalizeCodes tidies the codes and drops blanks and repeats.
+func normalizeCodes(codes []string) []string {
+	var res []string
+	for _, c := range codes {
+		c = domain.NormalizeCode(c)
+		if c != "" && !slices.Contains(res, c) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
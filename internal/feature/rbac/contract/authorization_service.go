@@ -0,0 +1,13 @@
+package contract
+
+import (
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/rbac/domain"
+)
+
+// AuthorizationService resolves what the caller in context is allowed to do.
+type AuthorizationService interface {
+	// ResolvePermissions returns the permissions granted to the caller through
+	// their platform role (admin scope) or tenant role (tenant scope).
+	ResolvePermissions(ctx *appctx.Context) (domain.PermissionSet, error)
+}
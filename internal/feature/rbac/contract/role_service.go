@@ -15,6 +15,12 @@ type Service interface {
 	GetByID(ctx *appctx.Context, id uuid.UUID) (*domain.Role, error)
 	GetByName(ctx *appctx.Context, name string) (*domain.Role, error)
 	Update(ctx *appctx.Context, role *domain.Role) (*domain.Role, error)
+	SetMFARequired(ctx *appctx.Context, id uuid.UUID, required bool) (*domain.Role, error)
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 	Purge(ctx *appctx.Context, id uuid.UUID) error
+
+	// Permission assignment
+	ListPermissions(ctx *appctx.Context, id uuid.UUID) ([]*domain.Permission, error)
+	GrantPermissions(ctx *appctx.Context, id uuid.UUID, permissionIDs []uuid.UUID) ([]*domain.Permission, error)
+	RevokePermissions(ctx *appctx.Context, id uuid.UUID, permissionIDs []uuid.UUID) ([]*domain.Permission, error)
 }
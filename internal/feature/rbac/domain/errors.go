@@ -11,6 +11,7 @@ var (
 	ErrEmptyRoleName          = fmt.Errorf("role name cannot be empty")
 	ErrUnauthorizedRoleAccess = fmt.Errorf("unauthorized access to role")
 	ErrMissingTenantID        = fmt.Errorf("tenant ID cannot be nil")
+	ErrMissingUserID          = fmt.Errorf("user ID cannot be nil")
 )
 
 var (
@@ -23,4 +24,6 @@ var (
 	ErrPermissionAlreadyExists = fmt.Errorf("permission already exists")
 	ErrPermissionNotFound      = fmt.Errorf("permission not found")
 	ErrPermissionDeleted       = fmt.Errorf("permission has been deleted")
+	ErrPermissionDenied        = fmt.Errorf("permission denied")
+	ErrPermissionNotInPlan     = fmt.Errorf("permission is not included in the tenant's subscription plan")
 )
@@ -0,0 +1,30 @@
+package domain
+
+// PermissionSet holds the names of the permissions granted to a caller.
+type PermissionSet map[string]struct{}
+
+// NewPermissionSet builds a PermissionSet from a list of permission names.
+func NewPermissionSet(names []string) PermissionSet {
+	set := make(PermissionSet, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// Has reports whether the given permission has been granted.
+func (s PermissionSet) Has(name string) bool {
+	_, ok := s[name]
+	return ok
+}
+
+// Missing returns the permissions from names that have not been granted.
+func (s PermissionSet) Missing(names ...string) []string {
+	var missing []string
+	for _, name := range names {
+		if !s.Has(name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -12,6 +12,7 @@ type Role struct {
 	TenantID    *uuid.UUID
 	Name        string
 	Description string
+	MFARequired bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   *time.Time
@@ -28,6 +29,12 @@ func (r *Role) Update(name, description string) {
 	r.UpdatedAt = time.Now().UTC()
 }
 
+// SetMFARequired sets whether members with this role must pass a second factor to sign in.
+func (r *Role) SetMFARequired(required bool) {
+	r.MFARequired = required
+	r.UpdatedAt = time.Now().UTC()
+}
+
 // SoftDelete marks the role as deleted without removing the record.
 func (r *Role) SoftDelete() {
 	now := time.Now().UTC()
@@ -8,6 +8,7 @@ import (
 type CreateRoleRequest struct {
 	Name        string `json:"name" validate:"required"`
 	Description string `json:"description"`
+	MFARequired bool   `json:"mfa_required"`
 }
 
 // ToDomain converts the request DTO to a domain.Role.
@@ -16,6 +17,7 @@ func (r *CreateRoleRequest) ToDomain(tenantID *uuid.UUID) (*domain.Role, error)
 		TenantID:    tenantID,
 		Name:        r.Name,
 		Description: r.Description,
+		MFARequired: r.MFARequired,
 	}
 	return role, nil
 }
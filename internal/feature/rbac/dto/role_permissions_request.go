@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/google/uuid"
+
+// RolePermissionsRequest carries the permissions to grant to or revoke from a role.
+type RolePermissionsRequest struct {
+	PermissionIDs []uuid.UUID `json:"permission_ids" validate:"required,min=1"`
+}
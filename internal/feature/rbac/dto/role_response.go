@@ -7,4 +7,5 @@ type RoleResponse struct {
 	TenantID    *uuid.UUID `json:"tenant_id"`
 	Name        string     `json:"name"`
 	Description string     `json:"description"`
+	MFARequired bool       `json:"mfa_required"`
 }
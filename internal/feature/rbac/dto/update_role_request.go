@@ -21,3 +21,8 @@ func (r *UpdateRoleRequest) ToDomain(roleID uuid.UUID, tenantID *uuid.UUID) (*do
 	}
 	return role, nil
 }
+
+// UpdateRoleMFARequest toggles whether a role requires a second factor at sign-in.
+type UpdateRoleMFARequest struct {
+	Required *bool `json:"required" validate:"required"`
+}
@@ -99,6 +99,30 @@ func (h *Handler) Update(c *fiber.Ctx) error {
 	return httpx.JSON(c, fiber.StatusOK, mapper.ToRoleResponse(result))
 }
 
+// 🔐 Set whether a Role requires a second factor
+func (h *Handler) UpdateMFA(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.UpdateRoleMFARequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.SetMFARequired(ctx, id, *req.Required)
+	if err != nil {
+		return handleRoleError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToRoleResponse(result))
+}
+
 // 🗑️ Soft Delete a Role
 func (h *Handler) Delete(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
@@ -131,6 +155,70 @@ func (h *Handler) Purge(c *fiber.Ctx) error {
 	return httpx.NoContent(c)
 }
 
+// 🔑 List the permissions granted to a Role
+func (h *Handler) ListPermissions(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.ListPermissions(ctx, id)
+	if err != nil {
+		return handleRoleError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToPermissionResponses(result))
+}
+
+// ➕ Grant permissions to a Role in bulk
+func (h *Handler) GrantPermissions(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.RolePermissionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.GrantPermissions(ctx, id, req.PermissionIDs)
+	if err != nil {
+		return handleRoleError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToPermissionResponses(result))
+}
+
+// ➖ Revoke permissions from a Role in bulk
+func (h *Handler) RevokePermissions(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.RolePermissionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.RevokePermissions(ctx, id, req.PermissionIDs)
+	if err != nil {
+		return handleRoleError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToPermissionResponses(result))
+}
+
 // 📄 List Roles (with pagination, search, and ordering)
 func (h *Handler) List(c *fiber.Ctx) error {
 	var q query.ListRoleQuery
@@ -14,7 +14,8 @@ func handleRoleError(c *fiber.Ctx, err error) error {
 	case errors.Is(err, domain.ErrRoleAlreadyExists):
 		return httpx.Conflict(c, err.Error()) // 409 Conflict
 
-	case errors.Is(err, domain.ErrRoleNotFound):
+	case errors.Is(err, domain.ErrRoleNotFound),
+		errors.Is(err, domain.ErrPermissionNotFound):
 		return httpx.NotFound(c, err.Error()) // 404 Not Found
 
 	case errors.Is(err, domain.ErrEmptyRoleName),
@@ -25,6 +26,9 @@ func handleRoleError(c *fiber.Ctx, err error) error {
 		errors.Is(err, domain.ErrRoleDeleted):
 		return httpx.Forbidden(c, err.Error()) // 403 Forbidden
 
+	case errors.Is(err, domain.ErrPermissionNotInPlan):
+		return httpx.UnprocessableEntity(c, err.Error()) // 422 Unprocessable Entity
+
 	default:
 		return httpx.InternalServerError(c, err.Error()) // 500 fallback
 	}
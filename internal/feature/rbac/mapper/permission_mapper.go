@@ -45,6 +45,14 @@ func ToPermissionResponse(p *domain.Permission) *dto.PermissionResponse {
 	}
 }
 
+func ToPermissionResponses(perms []*domain.Permission) []*dto.PermissionResponse {
+	res := make([]*dto.PermissionResponse, len(perms))
+	for i, p := range perms {
+		res[i] = ToPermissionResponse(p)
+	}
+	return res
+}
+
 func ToPermissionResponsePage(page *pagination.PageData[domain.Permission]) *pagination.PageData[dto.PermissionResponse] {
 	data := make([]*dto.PermissionResponse, len(page.Data))
 	for i, p := range page.Data {
@@ -18,6 +18,7 @@ func FromEntModel(e *ent.Role) *domain.Role {
 		TenantID:    e.TenantID,
 		Name:        e.Name,
 		Description: e.Description,
+		MFARequired: e.MfaRequired,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
 		DeletedAt:   e.DeletedAt,
@@ -39,6 +40,7 @@ func ToRoleResponse(r *domain.Role) *dto.RoleResponse {
 		TenantID:    r.TenantID,
 		Name:        r.Name,
 		Description: r.Description,
+		MFARequired: r.MFARequired,
 	}
 }
 
@@ -21,4 +21,7 @@ var ProviderSet = wire.NewSet(
 	handler.NewPermissionHandler,
 	service.NewPermissionService,
 	repository.NewPermissionRepository,
+
+	service.NewAuthorizationService,
+	repository.NewAuthorizationRepository,
 )
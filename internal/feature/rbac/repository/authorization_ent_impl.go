@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/permission"
+	"github.com/umardev500/laundry/ent/platformuser"
+	"github.com/umardev500/laundry/ent/predicate"
+	"github.com/umardev500/laundry/ent/role"
+	"github.com/umardev500/laundry/ent/tenantuser"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+type authorizationRepoEnt struct {
+	client *entdb.Client
+}
+
+// NewAuthorizationRepository returns a new Ent-based authorization repository.
+func NewAuthorizationRepository(client *entdb.Client) AuthorizationRepository {
+	return &authorizationRepoEnt{client: client}
+}
+
+// FindPlatformPermissions implements AuthorizationRepository.
+func (r *authorizationRepoEnt) FindPlatformPermissions(ctx *appctx.Context, userID uuid.UUID) ([]string, error) {
+	return r.findPermissionNames(ctx,
+		role.TenantIDIsNil(),
+		role.HasPlatformUsersWith(
+			platformuser.UserIDEQ(userID),
+			platformuser.StatusEQ(platformuser.Status(types.PlatformUserStatusActive)),
+			platformuser.DeletedAtIsNil(),
+		),
+	)
+}
+
+// FindTenantPermissions implements AuthorizationRepository.
+func (r *authorizationRepoEnt) FindTenantPermissions(ctx *appctx.Context, userID, tenantID uuid.UUID) ([]string, error) {
+	return r.findPermissionNames(ctx,
+		role.TenantIDEQ(tenantID),
+		role.HasTenantUsersWith(
+			tenantuser.UserIDEQ(userID),
+			tenantuser.TenantIDEQ(tenantID),
+			tenantuser.StatusEQ(tenantuser.Status(types.TenantUserStatusActive)),
+			tenantuser.DeletedAtIsNil(),
+		),
+	)
+}
+
+// findPermissionNames returns the distinct names of active permissions attached
+// to any non-deleted role matching the given predicates.
+func (r *authorizationRepoEnt) findPermissionNames(ctx *appctx.Context, rolePredicates ...predicate.Role) ([]string, error) {
+	conn := r.client.GetConn(ctx)
+
+	return conn.Permission.
+		Query().
+		Where(
+			permission.StatusEQ(permission.Status(types.StatusActive)),
+			permission.DeletedAtIsNil(),
+			permission.HasRolesWith(append(rolePredicates, role.DeletedAtIsNil())...),
+		).
+		Unique(true).
+		Order(ent.Asc(permission.FieldName)).
+		Select(permission.FieldName).
+		Strings(ctx)
+}
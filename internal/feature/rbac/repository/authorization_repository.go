@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+)
+
+// AuthorizationRepository resolves the permissions granted to a user through their roles.
+type AuthorizationRepository interface {
+	// FindPlatformPermissions returns the permission names granted to a platform user.
+	FindPlatformPermissions(ctx *appctx.Context, userID uuid.UUID) ([]string, error)
+
+	// FindTenantPermissions returns the permission names granted to a member of the given tenant.
+	FindTenantPermissions(ctx *appctx.Context, userID, tenantID uuid.UUID) ([]string, error)
+}
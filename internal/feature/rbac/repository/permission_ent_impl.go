@@ -4,12 +4,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/ent/permission"
+	"github.com/umardev500/laundry/ent/plan"
+	"github.com/umardev500/laundry/ent/subscription"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/rbac/domain"
 	"github.com/umardev500/laundry/internal/feature/rbac/mapper"
 	"github.com/umardev500/laundry/internal/feature/rbac/query"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
 )
 
 type permissionRepoEnt struct {
@@ -29,6 +32,42 @@ func (r *permissionRepoEnt) FindByID(ctx *appctx.Context, id uuid.UUID) (*domain
 	return mapper.FromEntPermission(entPerm), nil
 }
 
+// FindByIDs returns the non-deleted permissions matching the given IDs.
+func (r *permissionRepoEnt) FindByIDs(ctx *appctx.Context, ids []uuid.UUID) ([]*domain.Permission, error) {
+	conn := r.client.GetConn(ctx)
+	entPerms, err := conn.Permission.
+		Query().
+		Where(
+			permission.IDIn(ids...),
+			permission.DeletedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.FromEntPermissionList(entPerms), nil
+}
+
+// FindIDsInTenantPlan returns which of the given permission IDs are included
+// in the plan of the tenant's active subscription.
+func (r *permissionRepoEnt) FindIDsInTenantPlan(ctx *appctx.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error) {
+	conn := r.client.GetConn(ctx)
+	return conn.Permission.
+		Query().
+		Where(
+			permission.IDIn(ids...),
+			permission.HasPlansWith(
+				plan.DeletedAtIsNil(),
+				plan.HasSubscriptionsWith(
+					subscription.TenantIDEQ(tenantID),
+					subscription.StatusEQ(subscription.Status(types.SubscriptionStatusActive)),
+					subscription.DeletedAtIsNil(),
+				),
+			),
+		).
+		IDs(ctx)
+}
+
 func (r *permissionRepoEnt) Update(ctx *appctx.Context, p *domain.Permission) (*domain.Permission, error) {
 	conn := r.client.GetConn(ctx)
 	entPerm, err := conn.Permission.
@@ -10,6 +10,8 @@ import (
 
 type PermissionRepository interface {
 	FindByID(ctx *appctx.Context, id uuid.UUID) (*domain.Permission, error)
+	FindByIDs(ctx *appctx.Context, ids []uuid.UUID) ([]*domain.Permission, error)
+	FindIDsInTenantPlan(ctx *appctx.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error)
 	Update(ctx *appctx.Context, p *domain.Permission) (*domain.Permission, error)
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 	List(ctx *appctx.Context, q *query.ListPermissionQuery) (*pagination.PageData[domain.Permission], error)
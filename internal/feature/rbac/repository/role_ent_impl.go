@@ -3,6 +3,7 @@ package repository
 import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/permission"
 	"github.com/umardev500/laundry/ent/role"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/rbac/domain"
@@ -30,6 +31,7 @@ func (e *entImpl) Create(ctx *appctx.Context, r *domain.Role) (*domain.Role, err
 		Create().
 		SetName(r.Name).
 		SetDescription(r.Description).
+		SetMfaRequired(r.MFARequired).
 		SetNillableTenantID(r.TenantID).
 		Save(ctx)
 	if err != nil {
@@ -76,6 +78,7 @@ func (e *entImpl) Update(ctx *appctx.Context, r *domain.Role) (*domain.Role, err
 		UpdateOneID(r.ID).
 		SetName(r.Name).
 		SetDescription(r.Description).
+		SetMfaRequired(r.MFARequired).
 		SetNillableDeletedAt(r.DeletedAt).
 		Save(ctx)
 	if err != nil {
@@ -91,6 +94,71 @@ func (e *entImpl) Delete(ctx *appctx.Context, id uuid.UUID) error {
 	return conn.Role.DeleteOneID(id).Exec(ctx)
 }
 
+// FindPermissions returns the permissions granted to a role.
+func (e *entImpl) FindPermissions(ctx *appctx.Context, roleID uuid.UUID) ([]*domain.Permission, error) {
+	conn := e.client.GetConn(ctx)
+
+	entPerms, err := conn.Role.
+		Query().
+		Where(role.IDEQ(roleID)).
+		QueryPermissions().
+		Where(permission.DeletedAtIsNil()).
+		Order(ent.Asc(permission.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntPermissionList(entPerms), nil
+}
+
+// AddPermissions grants the given permissions to a role, skipping ones it already holds.
+func (e *entImpl) AddPermissions(ctx *appctx.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error {
+	conn := e.client.GetConn(ctx)
+
+	existing, err := conn.Role.
+		Query().
+		Where(role.IDEQ(roleID)).
+		QueryPermissions().
+		Where(permission.IDIn(permissionIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	held := make(map[uuid.UUID]struct{}, len(existing))
+	for _, id := range existing {
+		held[id] = struct{}{}
+	}
+
+	var toAdd []uuid.UUID
+	for _, id := range permissionIDs {
+		if _, ok := held[id]; !ok {
+			toAdd = append(toAdd, id)
+			held[id] = struct{}{}
+		}
+	}
+
+	if len(toAdd) == 0 {
+		return nil
+	}
+
+	return conn.Role.
+		UpdateOneID(roleID).
+		AddPermissionIDs(toAdd...).
+		Exec(ctx)
+}
+
+// RemovePermissions revokes the given permissions from a role.
+func (e *entImpl) RemovePermissions(ctx *appctx.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error {
+	conn := e.client.GetConn(ctx)
+
+	return conn.Role.
+		UpdateOneID(roleID).
+		RemovePermissionIDs(permissionIDs...).
+		Exec(ctx)
+}
+
 // List returns paginated and filtered roles.
 func (e *entImpl) List(ctx *appctx.Context, q *query.ListRoleQuery) (*pagination.PageData[domain.Role], error) {
 	q.Normalize()
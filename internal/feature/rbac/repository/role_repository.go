@@ -16,4 +16,9 @@ type RoleRepository interface {
 	Update(ctx *appctx.Context, role *domain.Role) (*domain.Role, error)
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 	List(ctx *appctx.Context, q *query.ListRoleQuery) (*pagination.PageData[domain.Role], error)
+
+	// Permission assignment
+	FindPermissions(ctx *appctx.Context, roleID uuid.UUID) ([]*domain.Permission, error)
+	AddPermissions(ctx *appctx.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error
+	RemovePermissions(ctx *appctx.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error
 }
@@ -2,8 +2,13 @@ package rbac
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
+	"github.com/umardev500/laundry/internal/feature/rbac/contract"
 	"github.com/umardev500/laundry/internal/feature/rbac/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
 )
 
 // Routes holds the handler for Role endpoints.
@@ -11,6 +16,9 @@ type Routes struct {
 	handler           *handler.Handler
 	featureHandler    *handler.FeatureHandler
 	permissionHandler *handler.PermissionHandler
+	keys              *jwtkeys.KeySet
+	sessions          authContract.SessionService
+	authz             contract.AuthorizationService
 }
 
 // Ensure Routes implements the RouteRegistrar interface
@@ -20,40 +28,59 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	// Base route
 	base := router.Group("rbac")
+	base.Use(middleware.CheckAuth(r.keys, r.sessions))
 
 	// --- Role Routes ---
 	role := base.Group("roles")
 
-	role.Post("/", r.handler.Create)           // Create a new role
-	role.Get("/", r.handler.List)              // List roles (with pagination, filters)
-	role.Get("/:id", r.handler.Get)            // Get role by ID
-	role.Put("/:id", r.handler.Update)         // Update role
-	role.Delete("/:id", r.handler.Delete)      // Soft delete a role
-	role.Delete("/:id/purge", r.handler.Purge) // Hard delete a role (permanent)
+	role.Post("/", middleware.RequirePermission(r.authz, "create_role"), r.handler.Create)           // Create a new role
+	role.Get("/", middleware.RequirePermission(r.authz, "view_role"), r.handler.List)                // List roles (with pagination, filters)
+	role.Get("/:id", middleware.RequirePermission(r.authz, "view_role"), r.handler.Get)              // Get role by ID
+	role.Put("/:id", middleware.RequirePermission(r.authz, "update_role"), r.handler.Update)         // Update role
+	role.Delete("/:id", middleware.RequirePermission(r.authz, "delete_role"), r.handler.Delete)      // Soft delete a role
+	role.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_role"), r.handler.Purge) // Hard delete a role (permanent)
+
+	// Second factor policy for members holding the role
+	role.Patch("/:id/mfa", middleware.RequirePermission(r.authz, "update_role"), r.handler.UpdateMFA)
+
+	// Role ↔ permission assignment
+	role.Get("/:id/permissions", middleware.RequirePermission(r.authz, "view_role"), r.handler.ListPermissions)
+	role.Post("/:id/permissions", middleware.RequirePermission(r.authz, "update_role"), r.handler.GrantPermissions)
+	role.Delete("/:id/permissions", middleware.RequirePermission(r.authz, "update_role"), r.handler.RevokePermissions)
 
 	// --- Feature Routes ---
 	feature := base.Group("features")
-	feature.Get("/", r.featureHandler.List)
-	feature.Get("/:id", r.featureHandler.Get)
-	feature.Put("/:id", r.featureHandler.Update)
-	feature.Patch("/:id/:status", r.featureHandler.UpdateStatus)
+	feature.Get("/", middleware.RequirePermission(r.authz, "view_feature"), r.featureHandler.List)
+	feature.Get("/:id", middleware.RequirePermission(r.authz, "view_feature"), r.featureHandler.Get)
+	feature.Put("/:id", middleware.RequirePermission(r.authz, "update_feature"), r.featureHandler.Update)
+	feature.Patch("/:id/:status", middleware.RequirePermission(r.authz, "update_feature"), r.featureHandler.UpdateStatus)
 
 	// --- Permission Routes ---
 	perm := base.Group("permissions")
-	perm.Get("/", r.permissionHandler.List)
-	perm.Get("/:id", r.permissionHandler.Get)
-	perm.Put("/:id", r.permissionHandler.Update)
-	perm.Patch("/:id/:status", r.permissionHandler.UpdateStatus) // e.g. /permissions/:id/active or /permissions/:id/suspended
-	perm.Delete("/:id", r.permissionHandler.Delete)
-	perm.Delete("/:id/purge", r.permissionHandler.Purge)
+	perm.Get("/", middleware.RequirePermission(r.authz, "view_permission"), r.permissionHandler.List)
+	perm.Get("/:id", middleware.RequirePermission(r.authz, "view_permission"), r.permissionHandler.Get)
+	perm.Put("/:id", middleware.RequirePermission(r.authz, "update_permission"), r.permissionHandler.Update)
+	perm.Patch("/:id/:status", middleware.RequirePermission(r.authz, "update_permission"), r.permissionHandler.UpdateStatus) // e.g. /permissions/:id/active or /permissions/:id/suspended
+	perm.Delete("/:id", middleware.RequirePermission(r.authz, "delete_permission"), r.permissionHandler.Delete)
+	perm.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_permission"), r.permissionHandler.Purge)
 
 }
 
 // NewRoutes creates a new Role routes instance.
-func NewRoutes(handler *handler.Handler, featureHandler *handler.FeatureHandler, permissionHandler *handler.PermissionHandler) *Routes {
+func NewRoutes(
+	handler *handler.Handler,
+	featureHandler *handler.FeatureHandler,
+	permissionHandler *handler.PermissionHandler,
+	keys *jwtkeys.KeySet,
+	sessions authContract.SessionService,
+	authz contract.AuthorizationService,
+) *Routes {
 	return &Routes{
 		handler:           handler,
 		featureHandler:    featureHandler,
 		permissionHandler: permissionHandler,
+		keys:              keys,
+		sessions:          sessions,
+		authz:             authz,
 	}
 }
@@ -61,6 +61,51 @@ func (s *FeatureSeeder) Seed(ctx context.Context) error {
 			Name:        "laundry_orders",
 			Description: "Handle laundry order lifecycle",
 		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-666666666666"),
+			Name:        "machines",
+			Description: "Manage laundry machines and machine types",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-777777777777"),
+			Name:        "services",
+			Description: "Manage laundry services, categories and units",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-888888888888"),
+			Name:        "payments",
+			Description: "Manage payment methods and payments",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-999999999999"),
+			Name:        "plans",
+			Description: "Manage subscription plans",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-aaaaaaaaaaaa"),
+			Name:        "subscriptions",
+			Description: "Manage tenant subscriptions",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-bbbbbbbbbbbb"),
+			Name:        "cash_shifts",
+			Description: "Run cash drawer shifts and end-of-day reports",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-cccccccccccc"),
+			Name:        "wallets",
+			Description: "Manage customer prepaid wallets",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-dddddddddddd"),
+			Name:        "promotions",
+			Description: "Manage discounts and promo codes",
+		},
+		{
+			ID:          uuid.MustParse("22222222-1111-1111-1111-eeeeeeeeeeee"),
+			Name:        "modifiers",
+			Description: "Manage service add-ons and modifiers",
+		},
 	}
 
 	for _, f := range features {
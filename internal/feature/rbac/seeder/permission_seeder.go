@@ -37,6 +37,15 @@ func (s *PermissionSeeder) Seed(ctx context.Context) error {
 		"permissions":    uuid.MustParse("22222222-1111-1111-1111-333333333333"),
 		"tenants":        uuid.MustParse("22222222-1111-1111-1111-444444444444"),
 		"laundry_orders": uuid.MustParse("22222222-1111-1111-1111-555555555555"),
+		"machines":       uuid.MustParse("22222222-1111-1111-1111-666666666666"),
+		"services":       uuid.MustParse("22222222-1111-1111-1111-777777777777"),
+		"payments":       uuid.MustParse("22222222-1111-1111-1111-888888888888"),
+		"plans":          uuid.MustParse("22222222-1111-1111-1111-999999999999"),
+		"subscriptions":  uuid.MustParse("22222222-1111-1111-1111-aaaaaaaaaaaa"),
+		"cash_shifts":    uuid.MustParse("22222222-1111-1111-1111-bbbbbbbbbbbb"),
+		"wallets":        uuid.MustParse("22222222-1111-1111-1111-cccccccccccc"),
+		"promotions":     uuid.MustParse("22222222-1111-1111-1111-dddddddddddd"),
+		"modifiers":      uuid.MustParse("22222222-1111-1111-1111-eeeeeeeeeeee"),
 	}
 
 	permissions := []struct {
@@ -50,22 +59,93 @@ func (s *PermissionSeeder) Seed(ctx context.Context) error {
 		{uuid.MustParse("aaaaaaaa-1111-1111-1111-aaaaaaaaaaaa"), "create_user", "Create User", "Ability to create users", "users"},
 		{uuid.MustParse("aaaaaaaa-2222-2222-2222-aaaaaaaaaaaa"), "update_user", "Update User", "Ability to update users", "users"},
 		{uuid.MustParse("aaaaaaaa-3333-3333-3333-aaaaaaaaaaaa"), "delete_user", "Delete User", "Ability to delete users", "users"},
+		{uuid.MustParse("aaaaaaaa-4444-4444-4444-aaaaaaaaaaaa"), "view_user", "View User", "Ability to view users", "users"},
+		{uuid.MustParse("aaaaaaaa-5555-5555-5555-aaaaaaaaaaaa"), "view_platform_user", "View Platform User", "Ability to view platform users", "users"},
+		{uuid.MustParse("aaaaaaaa-6666-6666-6666-aaaaaaaaaaaa"), "create_platform_user", "Create Platform User", "Ability to create platform users", "users"},
+		{uuid.MustParse("aaaaaaaa-7777-7777-7777-aaaaaaaaaaaa"), "update_platform_user", "Update Platform User", "Ability to update platform users", "users"},
+		{uuid.MustParse("aaaaaaaa-8888-8888-8888-aaaaaaaaaaaa"), "delete_platform_user", "Delete Platform User", "Ability to delete platform users", "users"},
 
 		// Roles feature
 		{uuid.MustParse("bbbbbbbb-1111-1111-1111-bbbbbbbbbbbb"), "create_role", "Create Role", "Ability to create roles", "roles"},
 		{uuid.MustParse("bbbbbbbb-2222-2222-2222-bbbbbbbbbbbb"), "update_role", "Update Role", "Ability to update roles", "roles"},
+		{uuid.MustParse("bbbbbbbb-3333-3333-3333-bbbbbbbbbbbb"), "view_role", "View Role", "Ability to view roles", "roles"},
+		{uuid.MustParse("bbbbbbbb-4444-4444-4444-bbbbbbbbbbbb"), "delete_role", "Delete Role", "Ability to delete roles", "roles"},
 
 		// Permissions feature
 		{uuid.MustParse("cccccccc-1111-1111-1111-cccccccccccc"), "create_permission", "Create Permission", "Ability to create permissions", "permissions"},
 		{uuid.MustParse("cccccccc-2222-2222-2222-cccccccccccc"), "update_permission", "Update Permission", "Ability to update permissions", "permissions"},
+		{uuid.MustParse("cccccccc-3333-3333-3333-cccccccccccc"), "view_permission", "View Permission", "Ability to view permissions", "permissions"},
+		{uuid.MustParse("cccccccc-4444-4444-4444-cccccccccccc"), "delete_permission", "Delete Permission", "Ability to delete permissions", "permissions"},
+		{uuid.MustParse("cccccccc-5555-5555-5555-cccccccccccc"), "view_feature", "View Feature", "Ability to view features", "permissions"},
+		{uuid.MustParse("cccccccc-6666-6666-6666-cccccccccccc"), "update_feature", "Update Feature", "Ability to update features", "permissions"},
 
 		// Tenants feature
 		{uuid.MustParse("dddddddd-1111-1111-1111-dddddddddddd"), "create_tenant", "Create Tenant", "Ability to create tenants", "tenants"},
 		{uuid.MustParse("dddddddd-2222-2222-2222-dddddddddddd"), "update_tenant", "Update Tenant", "Ability to update tenants", "tenants"},
+		{uuid.MustParse("dddddddd-3333-3333-3333-dddddddddddd"), "view_tenant", "View Tenant", "Ability to view tenants", "tenants"},
+		{uuid.MustParse("dddddddd-4444-4444-4444-dddddddddddd"), "delete_tenant", "Delete Tenant", "Ability to delete tenants", "tenants"},
+		{uuid.MustParse("dddddddd-5555-5555-5555-dddddddddddd"), "view_tenant_user", "View Tenant User", "Ability to view tenant members", "tenants"},
+		{uuid.MustParse("dddddddd-6666-6666-6666-dddddddddddd"), "create_tenant_user", "Create Tenant User", "Ability to add tenant members", "tenants"},
+		{uuid.MustParse("dddddddd-7777-7777-7777-dddddddddddd"), "update_tenant_user", "Update Tenant User", "Ability to update tenant members", "tenants"},
+		{uuid.MustParse("dddddddd-8888-8888-8888-dddddddddddd"), "delete_tenant_user", "Delete Tenant User", "Ability to remove tenant members", "tenants"},
 
 		// Laundry Orders feature
 		{uuid.MustParse("eeeeeeee-1111-1111-1111-eeeeeeeeeeee"), "create_order", "Create Order", "Ability to create laundry orders", "laundry_orders"},
 		{uuid.MustParse("eeeeeeee-2222-2222-2222-eeeeeeeeeeee"), "update_order", "Update Order", "Ability to update laundry orders", "laundry_orders"},
+		{uuid.MustParse("eeeeeeee-4444-4444-4444-eeeeeeeeeeee"), "view_order", "View Order", "Ability to view laundry orders", "laundry_orders"},
+		{uuid.MustParse("eeeeeeee-3333-3333-3333-eeeeeeeeeeee"), "view_tax_summary", "View Tax Summary", "Ability to view the monthly tax summary of orders", "laundry_orders"},
+
+		// Machines feature
+		{uuid.MustParse("ffffffff-1111-1111-1111-ffffffffffff"), "view_machine", "View Machine", "Ability to view machines", "machines"},
+		{uuid.MustParse("ffffffff-2222-2222-2222-ffffffffffff"), "create_machine", "Create Machine", "Ability to create machines", "machines"},
+		{uuid.MustParse("ffffffff-3333-3333-3333-ffffffffffff"), "update_machine", "Update Machine", "Ability to update machines", "machines"},
+		{uuid.MustParse("ffffffff-4444-4444-4444-ffffffffffff"), "delete_machine", "Delete Machine", "Ability to delete machines", "machines"},
+
+		// Services feature
+		{uuid.MustParse("a1a1a1a1-1111-1111-1111-a1a1a1a1a1a1"), "view_service", "View Service", "Ability to view services", "services"},
+		{uuid.MustParse("a1a1a1a1-2222-2222-2222-a1a1a1a1a1a1"), "create_service", "Create Service", "Ability to create services", "services"},
+		{uuid.MustParse("a1a1a1a1-3333-3333-3333-a1a1a1a1a1a1"), "update_service", "Update Service", "Ability to update services", "services"},
+		{uuid.MustParse("a1a1a1a1-4444-4444-4444-a1a1a1a1a1a1"), "delete_service", "Delete Service", "Ability to delete services", "services"},
+
+		// Payments feature
+		{uuid.MustParse("a2a2a2a2-1111-1111-1111-a2a2a2a2a2a2"), "create_payment_method", "Create Payment Method", "Ability to create payment methods", "payments"},
+		{uuid.MustParse("a2a2a2a2-2222-2222-2222-a2a2a2a2a2a2"), "update_payment_method", "Update Payment Method", "Ability to update payment methods", "payments"},
+		{uuid.MustParse("a2a2a2a2-3333-3333-3333-a2a2a2a2a2a2"), "delete_payment_method", "Delete Payment Method", "Ability to delete payment methods", "payments"},
+		{uuid.MustParse("a2a2a2a2-7777-7777-7777-a2a2a2a2a2a2"), "view_payment_method", "View Payment Method", "Ability to view payment methods", "payments"},
+		{uuid.MustParse("a2a2a2a2-4444-4444-4444-a2a2a2a2a2a2"), "view_refund", "View Refund", "Ability to view refunds", "payments"},
+		{uuid.MustParse("a2a2a2a2-5555-5555-5555-a2a2a2a2a2a2"), "request_refund", "Request Refund", "Ability to request refunds of payments", "payments"},
+		{uuid.MustParse("a2a2a2a2-6666-6666-6666-a2a2a2a2a2a2"), "approve_refund", "Approve Refund", "Ability to approve, reject and pay out refunds", "payments"},
+
+		// Plans feature
+		{uuid.MustParse("a3a3a3a3-1111-1111-1111-a3a3a3a3a3a3"), "create_plan", "Create Plan", "Ability to create plans", "plans"},
+		{uuid.MustParse("a3a3a3a3-2222-2222-2222-a3a3a3a3a3a3"), "update_plan", "Update Plan", "Ability to update plans", "plans"},
+		{uuid.MustParse("a3a3a3a3-3333-3333-3333-a3a3a3a3a3a3"), "delete_plan", "Delete Plan", "Ability to delete plans", "plans"},
+		{uuid.MustParse("a3a3a3a3-4444-4444-4444-a3a3a3a3a3a3"), "view_plan", "View Plan", "Ability to view plans", "plans"},
+
+		// Subscriptions feature
+		{uuid.MustParse("a4a4a4a4-1111-1111-1111-a4a4a4a4a4a4"), "view_subscription", "View Subscription", "Ability to view subscriptions", "subscriptions"},
+		{uuid.MustParse("a4a4a4a4-2222-2222-2222-a4a4a4a4a4a4"), "create_subscription", "Create Subscription", "Ability to create subscriptions", "subscriptions"},
+		{uuid.MustParse("a4a4a4a4-3333-3333-3333-a4a4a4a4a4a4"), "update_subscription", "Update Subscription", "Ability to update subscriptions", "subscriptions"},
+		{uuid.MustParse("a4a4a4a4-4444-4444-4444-a4a4a4a4a4a4"), "delete_subscription", "Delete Subscription", "Ability to delete subscriptions", "subscriptions"},
+
+		// Cash shifts feature
+		{uuid.MustParse("a5a5a5a5-1111-1111-1111-a5a5a5a5a5a5"), "operate_cash_shift", "Operate Cash Shift", "Ability to open and close one's own cash drawer shift", "cash_shifts"},
+		{uuid.MustParse("a5a5a5a5-2222-2222-2222-a5a5a5a5a5a5"), "view_cash_shift", "View Cash Shift", "Ability to view cash shifts and end-of-day reports", "cash_shifts"},
+
+		// Wallets feature
+		{uuid.MustParse("a6a6a6a6-1111-1111-1111-a6a6a6a6a6a6"), "view_wallet", "View Wallet", "Ability to view customer wallets and their ledger", "wallets"},
+		{uuid.MustParse("a6a6a6a6-2222-2222-2222-a6a6a6a6a6a6"), "top_up_wallet", "Top Up Wallet", "Ability to top up customer wallets", "wallets"},
+		{uuid.MustParse("a6a6a6a6-3333-3333-3333-a6a6a6a6a6a6"), "adjust_wallet", "Adjust Wallet", "Ability to correct customer wallet balances", "wallets"},
+
+		// Promotions feature
+		{uuid.MustParse("a7a7a7a7-1111-1111-1111-a7a7a7a7a7a7"), "view_promotion", "View Promotion", "Ability to view promotions and promo codes", "promotions"},
+		{uuid.MustParse("a7a7a7a7-2222-2222-2222-a7a7a7a7a7a7"), "create_promotion", "Create Promotion", "Ability to create promotions and promo codes", "promotions"},
+		{uuid.MustParse("a7a7a7a7-3333-3333-3333-a7a7a7a7a7a7"), "update_promotion", "Update Promotion", "Ability to update promotions", "promotions"},
+		{uuid.MustParse("a7a7a7a7-4444-4444-4444-a7a7a7a7a7a7"), "delete_promotion", "Delete Promotion", "Ability to delete promotions", "promotions"},
+		{uuid.MustParse("a8a8a8a8-1111-1111-1111-a8a8a8a8a8a8"), "view_modifier", "View Modifier", "Ability to view service add-ons and modifiers", "modifiers"},
+		{uuid.MustParse("a8a8a8a8-2222-2222-2222-a8a8a8a8a8a8"), "create_modifier", "Create Modifier", "Ability to create service add-ons and modifiers", "modifiers"},
+		{uuid.MustParse("a8a8a8a8-3333-3333-3333-a8a8a8a8a8a8"), "update_modifier", "Update Modifier", "Ability to update service modifiers", "modifiers"},
+		{uuid.MustParse("a8a8a8a8-4444-4444-4444-a8a8a8a8a8a8"), "delete_modifier", "Delete Modifier", "Ability to delete service modifiers", "modifiers"},
 	}
 
 	for _, p := range permissions {
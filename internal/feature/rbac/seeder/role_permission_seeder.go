@@ -0,0 +1,96 @@
+package seeder
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/umardev500/laundry/ent/permission"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/internal/infra/database/seeder"
+)
+
+// RolePermissionSeeder grants the seeded permissions to the seeded roles.
+type RolePermissionSeeder struct {
+	client *entdb.Client
+}
+
+var _ seeder.Seeder = (*RolePermissionSeeder)(nil)
+
+func NewRolePermissionSeeder(client *entdb.Client) *RolePermissionSeeder {
+	return &RolePermissionSeeder{client: client}
+}
+
+func (s *RolePermissionSeeder) Seed(ctx context.Context) error {
+	log.Info().Msg("🌿 Seeding role permissions...")
+
+	conn := s.client.GetConn(ctx)
+
+	tenantAdminPermissions := []string{
+		"view_order", "create_order", "update_order", "view_tax_summary",
+		"view_refund", "request_refund", "approve_refund",
+		"operate_cash_shift", "view_cash_shift",
+		"view_wallet", "top_up_wallet", "adjust_wallet",
+		"view_promotion", "create_promotion", "update_promotion", "delete_promotion",
+		"view_modifier", "create_modifier", "update_modifier", "delete_modifier",
+		"view_machine", "create_machine", "update_machine", "delete_machine",
+		"view_service", "create_service", "update_service", "delete_service",
+		"view_tenant_user", "create_tenant_user", "update_tenant_user", "delete_tenant_user",
+		"view_role", "create_role", "update_role", "delete_role", "view_permission",
+		"view_subscription", "view_plan", "view_payment_method",
+	}
+
+	tenantUserPermissions := []string{
+		"view_order", "create_order", "update_order",
+		"view_refund", "request_refund",
+		"operate_cash_shift",
+		"view_wallet", "top_up_wallet",
+		"view_promotion",
+		"view_modifier",
+		"view_machine",
+		"view_service",
+		"view_payment_method",
+	}
+
+	grants := []struct {
+		RoleID      uuid.UUID
+		Permissions []string // nil grants every permission
+	}{
+		// Platform roles
+		{uuid.MustParse("22222222-2222-2222-2222-222222222222"), nil},
+		{uuid.MustParse("33333333-3333-3333-3333-333333333333"), []string{
+			"view_user", "view_platform_user", "view_role", "view_permission", "view_feature",
+			"view_tenant", "view_tenant_user", "view_machine", "view_service", "view_subscription",
+			"view_order", "view_plan", "view_payment_method",
+		}},
+
+		// Tenant roles
+		{uuid.MustParse("44444444-4444-4444-4444-444444444444"), tenantAdminPermissions},
+		{uuid.MustParse("55555555-5555-5555-5555-555555555555"), tenantUserPermissions},
+		{uuid.MustParse("66666666-6666-6666-6666-666666666666"), tenantAdminPermissions},
+	}
+
+	for _, g := range grants {
+		query := conn.Permission.Query()
+		if g.Permissions != nil {
+			query = query.Where(permission.NameIn(g.Permissions...))
+		}
+
+		permissionIDs, err := query.IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = conn.Role.
+			UpdateOneID(g.RoleID).
+			ClearPermissions().
+			AddPermissionIDs(permissionIDs...).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info().Msg("✅ Role permissions seeded successfully.")
+	return nil
+}
@@ -12,12 +12,14 @@ func NewRbacSeederSet(
 	rolePlatform *PlatformRoleSeeder,
 	roleTenant *TenantRoleSeeder,
 	permission *PermissionSeeder,
+	rolePermission *RolePermissionSeeder,
 ) []RBACSeeder {
 	return []RBACSeeder{
 		feature,
 		rolePlatform,
 		roleTenant,
 		permission,
+		rolePermission,
 	}
 }
 
@@ -26,5 +28,6 @@ var ProviderSet = wire.NewSet(
 	NewPlatformRoleSeeder,
 	NewTenantRoleSeeder,
 	NewPermissionSeeder,
+	NewRolePermissionSeeder,
 	NewRbacSeederSet,
 )
@@ -34,6 +34,7 @@ func (s *TenantRoleSeeder) Seed(ctx context.Context) error {
 	}{
 		{uuid.MustParse("44444444-4444-4444-4444-444444444444"), "TenantAdmin", uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")},
 		{uuid.MustParse("55555555-5555-5555-5555-555555555555"), "TenantUser", uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")},
+		{uuid.MustParse("66666666-6666-6666-6666-666666666666"), "TenantAdmin", uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")},
 	}
 
 	for _, r := range tenantRoles {
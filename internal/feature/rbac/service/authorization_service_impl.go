@@ -0,0 +1,52 @@
+package service
+
+import (
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/rbac/contract"
+	"github.com/umardev500/laundry/internal/feature/rbac/domain"
+	"github.com/umardev500/laundry/internal/feature/rbac/repository"
+)
+
+// customerPermissions are held by every signed-in customer. The services
+// limit what they reach to the customer's own records. Placing orders and
+// taking payments is left to tenant staff.
+var customerPermissions = []string{"view_order"}
+
+type authorizationService struct {
+	repo repository.AuthorizationRepository
+}
+
+// NewAuthorizationService creates a new AuthorizationService.
+func NewAuthorizationService(repo repository.AuthorizationRepository) contract.AuthorizationService {
+	return &authorizationService{repo: repo}
+}
+
+// ResolvePermissions implements contract.AuthorizationService.
+func (s *authorizationService) ResolvePermissions(ctx *appctx.Context) (domain.PermissionSet, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrMissingUserID
+	}
+
+	var names []string
+	var err error
+
+	switch ctx.Scope() {
+	case appctx.ScopeAdmin:
+		names, err = s.repo.FindPlatformPermissions(ctx, *userID)
+	case appctx.ScopeTenant:
+		tenantID := ctx.TenantID()
+		if tenantID == nil {
+			return nil, domain.ErrMissingTenantID
+		}
+		names, err = s.repo.FindTenantPermissions(ctx, *userID, *tenantID)
+	default:
+		// Customers are not assigned roles; they only manage their own orders.
+		names = customerPermissions
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPermissionSet(names), nil
+}
@@ -12,11 +12,15 @@ import (
 )
 
 type serviceImpl struct {
-	repo repository.RoleRepository
+	repo           repository.RoleRepository
+	permissionRepo repository.PermissionRepository
 }
 
-func NewService(repo repository.RoleRepository) contract.Service {
-	return &serviceImpl{repo: repo}
+func NewService(repo repository.RoleRepository, permissionRepo repository.PermissionRepository) contract.Service {
+	return &serviceImpl{
+		repo:           repo,
+		permissionRepo: permissionRepo,
+	}
 }
 
 // Create adds a new role after checking for duplicates.
@@ -67,6 +71,17 @@ func (s *serviceImpl) Update(ctx *appctx.Context, r *domain.Role) (*domain.Role,
 	return s.repo.Update(ctx, role)
 }
 
+// SetMFARequired changes whether members with the role must use a second factor.
+func (s *serviceImpl) SetMFARequired(ctx *appctx.Context, id uuid.UUID, required bool) (*domain.Role, error) {
+	role, err := s.findExistingRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	role.SetMFARequired(required)
+	return s.repo.Update(ctx, role)
+}
+
 // Delete performs a soft-delete on a role.
 func (s *serviceImpl) Delete(ctx *appctx.Context, id uuid.UUID) error {
 	role, err := s.findExistingRole(ctx, id)
@@ -89,6 +104,65 @@ func (s *serviceImpl) Purge(ctx *appctx.Context, id uuid.UUID) error {
 	return s.repo.Delete(ctx, role.ID)
 }
 
+// ListPermissions returns the permissions granted to a role.
+func (s *serviceImpl) ListPermissions(ctx *appctx.Context, id uuid.UUID) ([]*domain.Permission, error) {
+	role, err := s.findExistingRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindPermissions(ctx, role.ID)
+}
+
+// GrantPermissions attaches permissions to a role. Tenant roles may only be
+// granted permissions included in the tenant's active subscription plan.
+func (s *serviceImpl) GrantPermissions(ctx *appctx.Context, id uuid.UUID, permissionIDs []uuid.UUID) ([]*domain.Permission, error) {
+	role, err := s.findExistingRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionIDs = uniqueIDs(permissionIDs)
+
+	perms, err := s.permissionRepo.FindByIDs(ctx, permissionIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(perms) != len(permissionIDs) {
+		return nil, domain.ErrPermissionNotFound
+	}
+
+	if role.TenantID != nil {
+		allowed, err := s.permissionRepo.FindIDsInTenantPlan(ctx, *role.TenantID, permissionIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(allowed) != len(permissionIDs) {
+			return nil, domain.ErrPermissionNotInPlan
+		}
+	}
+
+	if err := s.repo.AddPermissions(ctx, role.ID, permissionIDs); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindPermissions(ctx, role.ID)
+}
+
+// RevokePermissions detaches permissions from a role.
+func (s *serviceImpl) RevokePermissions(ctx *appctx.Context, id uuid.UUID, permissionIDs []uuid.UUID) ([]*domain.Permission, error) {
+	role, err := s.findExistingRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RemovePermissions(ctx, role.ID, uniqueIDs(permissionIDs)); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindPermissions(ctx, role.ID)
+}
+
 // findExistingRole ensures the role exists and is not soft-deleted.
 func (s *serviceImpl) findExistingRole(ctx *appctx.Context, id uuid.UUID) (*domain.Role, error) {
 	role, err := s.repo.FindByID(ctx, id)
@@ -126,3 +200,17 @@ func (s *serviceImpl) findRoleAllowDeleted(ctx *appctx.Context, id uuid.UUID) (*
 
 	return role, nil
 }
+
+// uniqueIDs removes duplicate IDs while preserving order.
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
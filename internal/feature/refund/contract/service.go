@@ -0,0 +1,35 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/internal/feature/refund/query"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// Service defines the business logic for refunds.
+type Service interface {
+	// Request asks for part or all of a paid payment back. The refund waits
+	// for approval.
+	Request(ctx *appctx.Context, r *domain.Refund) (*domain.Refund, error)
+
+	// Approve accepts a pending refund, optionally for a smaller amount.
+	// Cash and wallet refunds are paid out straight away and gateway refunds
+	// once the approval is committed; transfers wait for Complete.
+	Approve(ctx *appctx.Context, id uuid.UUID, amount *money.Money, note *string) (*domain.Refund, error)
+
+	// Reject turns a pending refund down.
+	Reject(ctx *appctx.Context, id uuid.UUID, note string) (*domain.Refund, error)
+
+	// Complete records that an approved transfer refund was sent. For a
+	// gateway refund whose payout failed after approval it retries the payout.
+	Complete(ctx *appctx.Context, id uuid.UUID) (*domain.Refund, error)
+
+	// GetByID retrieves a refund by its ID
+	GetByID(ctx *appctx.Context, id uuid.UUID, q *query.FindRefundByIdQuery) (*domain.Refund, error)
+
+	// List retrieves paginated refunds with filters
+	List(ctx *appctx.Context, q *query.ListRefundQuery) (*pagination.PageData[domain.Refund], error)
+}
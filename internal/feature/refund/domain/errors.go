@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRefundNotFound                 = errors.New("refund not found")
+	ErrUnauthorizedRefundAccess       = errors.New("unauthorized access to refund")
+	ErrRefundReasonRequired           = errors.New("refund reason is required")
+	ErrInvalidRefundAmount            = errors.New("refund amount must be greater than zero")
+	ErrInvalidRefundMethod            = errors.New("invalid refund method")
+	ErrPaymentNotRefundable           = errors.New("only paid payments can be refunded")
+	ErrNothingToRefund                = errors.New("payment has been refunded in full")
+	ErrRefundExceedsRefundable        = errors.New("refund exceeds the refundable amount of the payment")
+	ErrApprovedAmountExceedsRequested = errors.New("approved amount cannot exceed the requested amount")
+	ErrOriginalMethodRequiresGateway  = errors.New("only gateway payments can be refunded to the original method")
+	ErrWalletRefundRequiresCustomer   = errors.New("only payments of registered customers can be refunded to a wallet")
+	ErrRefundRequiresUser             = errors.New("refunds must be requested and reviewed by a signed-in user")
+)
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+
+	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+)
+
+// Refund returns part or all of a paid payment to the customer. It is
+// requested by staff and has to be approved before money goes back.
+type Refund struct {
+	ID              uuid.UUID
+	TenantID        *uuid.UUID
+	PaymentID       uuid.UUID
+	Payment         *paymentDomain.Payment
+	RequestedAmount money.Money
+	ApprovedAmount  *money.Money
+	Reason          string
+	Method          types.RefundMethod
+	Status          types.RefundStatus
+	ReviewNote      *string
+	GatewayRefundID *string
+	CashShiftID     *uuid.UUID // drawer shift that paid out a cash refund
+	RequestedBy     uuid.UUID
+	ApprovedBy      *uuid.UUID
+	RejectedBy      *uuid.UUID
+	ApprovedAt      *time.Time
+	RejectedAt      *time.Time
+	RefundedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// -------------------------
+// Validation
+// -------------------------
+
+func (r *Refund) Validate() error {
+	if r.PaymentID == uuid.Nil {
+		return types.ErrInvalidUUID
+	}
+
+	if r.RequestedAmount <= 0 {
+		return ErrInvalidRefundAmount
+	}
+
+	if r.Reason == "" {
+		return ErrRefundReasonRequired
+	}
+
+	switch r.Method {
+	case types.RefundMethodOriginal, types.RefundMethodCash, types.RefundMethodTransfer, types.RefundMethodWallet:
+	default:
+		return ErrInvalidRefundMethod
+	}
+
+	return nil
+}
+
+// -------------------------
+// Actions / Mutations
+// -------------------------
+
+// Approve accepts the refund, optionally for less than was requested.
+func (r *Refund) Approve(by uuid.UUID, amount *money.Money, note *string) error {
+	approved := r.RequestedAmount
+	if amount != nil {
+		approved = *amount
+	}
+
+	if approved <= 0 {
+		return ErrInvalidRefundAmount
+	}
+	if approved > r.RequestedAmount {
+		return ErrApprovedAmountExceedsRequested
+	}
+
+	if err := r.setStatus(types.RefundStatusApproved); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	r.ApprovedAmount = &approved
+	r.ApprovedBy = &by
+	r.ApprovedAt = &now
+	r.ReviewNote = note
+	return nil
+}
+
+// Reject turns the refund down.
+func (r *Refund) Reject(by uuid.UUID, note *string) error {
+	if err := r.setStatus(types.RefundStatusRejected); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	r.RejectedBy = &by
+	r.RejectedAt = &now
+	r.ReviewNote = note
+	return nil
+}
+
+// MarkRefunded records that the approved amount went back to the customer.
+func (r *Refund) MarkRefunded(gatewayRefundID *string) error {
+	if err := r.setStatus(types.RefundStatusRefunded); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	r.GatewayRefundID = gatewayRefundID
+	r.RefundedAt = &now
+	return nil
+}
+
+func (r *Refund) setStatus(next types.RefundStatus) error {
+	if !r.Status.CanTransitionTo(next) {
+		return errorsx.NewErrInvalidStatusTransition(
+			string(r.Status),
+			string(next.Normalize()),
+			r.Status.AllowedNextStatuses(),
+		)
+	}
+
+	r.Status = next.Normalize()
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// Amount is the part of the payment the refund accounts for: the approved
+// amount once reviewed, the requested amount before.
+func (r *Refund) Amount() money.Money {
+	if r.ApprovedAmount != nil {
+		return *r.ApprovedAmount
+	}
+	return r.RequestedAmount
+}
+
+// BelongsToTenant checks whether the refund belongs to the tenant in context.
+func (r *Refund) BelongsToTenant(ctx *appctx.Context) bool {
+	if ctx.Scope() == appctx.ScopeTenant {
+		return ctx.TenantID() != nil && r.TenantID != nil && *r.TenantID == *ctx.TenantID()
+	}
+	return true
+}
+
+// Held returns how much of a payment the refunds take up, counting open and
+// completed refunds. The refund with the given ID is left out.
+func Held(refunds []*Refund, except uuid.UUID) money.Money {
+	var total money.Money
+	for _, r := range refunds {
+		if r.ID == except {
+			continue
+		}
+		if r.Status.IsOpen() || r.Status == types.RefundStatusRefunded {
+			total += r.Amount()
+		}
+	}
+	return total
+}
+
+// Refunded returns how much of a payment has gone back to the customer.
+func Refunded(refunds []*Refund) money.Money {
+	var total money.Money
+	for _, r := range refunds {
+		if r.Status == types.RefundStatusRefunded {
+			total += r.Amount()
+		}
+	}
+	return total
+}
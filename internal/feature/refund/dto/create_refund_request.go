@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+	"github.com/umardev500/laundry/pkg/utils/deref"
+)
+
+// CreateRefundRequest asks for part or all of a paid payment back. Without an
+// amount the refund covers what is left to refund of the payment. Without a
+// method gateway payments are refunded to the original method, wallet
+// payments to the wallet and others in cash.
+type CreateRefundRequest struct {
+	PaymentID uuid.UUID          `json:"payment_id" validate:"required"`
+	Amount    *money.Money       `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	Reason    string             `json:"reason" validate:"required,max=255"`
+	Method    types.RefundMethod `json:"method,omitempty" validate:"omitempty,oneof=original cash transfer wallet"`
+}
+
+func (r *CreateRefundRequest) ToDomain() *domain.Refund {
+	return &domain.Refund{
+		PaymentID:       r.PaymentID,
+		RequestedAmount: deref.Money(r.Amount),
+		Reason:          r.Reason,
+		Method:          r.Method,
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+
+	paymentDto "github.com/umardev500/laundry/internal/feature/payment/dto"
+)
+
+// RefundResponse represents a Refund for API responses.
+type RefundResponse struct {
+	ID              uuid.UUID                   `json:"id"`
+	TenantID        *uuid.UUID                  `json:"tenant_id,omitempty"`
+	PaymentID       uuid.UUID                   `json:"payment_id"`
+	Payment         *paymentDto.PaymentResponse `json:"payment,omitempty"`
+	RequestedAmount money.Money                 `json:"requested_amount"`
+	ApprovedAmount  *money.Money                `json:"approved_amount,omitempty"`
+	Reason          string                      `json:"reason"`
+	Method          types.RefundMethod          `json:"method"`
+	Status          types.RefundStatus          `json:"status"`
+	ReviewNote      *string                     `json:"review_note,omitempty"`
+	GatewayRefundID *string                     `json:"gateway_refund_id,omitempty"`
+	CashShiftID     *uuid.UUID                  `json:"cash_shift_id,omitempty"`
+	RequestedBy     uuid.UUID                   `json:"requested_by"`
+	ApprovedBy      *uuid.UUID                  `json:"approved_by,omitempty"`
+	RejectedBy      *uuid.UUID                  `json:"rejected_by,omitempty"`
+	ApprovedAt      *time.Time                  `json:"approved_at,omitempty"`
+	RejectedAt      *time.Time                  `json:"rejected_at,omitempty"`
+	RefundedAt      *time.Time                  `json:"refunded_at,omitempty"`
+	CreatedAt       time.Time                   `json:"created_at"`
+	UpdatedAt       time.Time                   `json:"updated_at"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/money"
+
+// ApproveRefundRequest approves a refund, optionally for less than was requested.
+type ApproveRefundRequest struct {
+	Amount *money.Money `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	Note   *string      `json:"note,omitempty" validate:"omitempty,max=255"`
+}
+
+// RejectRefundRequest turns a refund down with the reason given to the requester.
+type RejectRefundRequest struct {
+	Note string `json:"note" validate:"required,max=255"`
+}
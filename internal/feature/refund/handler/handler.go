@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/refund/contract"
+	"github.com/umardev500/laundry/internal/feature/refund/dto"
+	"github.com/umardev500/laundry/internal/feature/refund/mapper"
+	"github.com/umardev500/laundry/internal/feature/refund/query"
+	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/validator"
+)
+
+type Handler struct {
+	service   contract.Service
+	validator *validator.Validator
+}
+
+func NewHandler(s contract.Service, v *validator.Validator) *Handler {
+	return &Handler{
+		service:   s,
+		validator: v,
+	}
+}
+
+// Request POST /api/refunds
+func (h *Handler) Request(c *fiber.Ctx) error {
+	var req dto.CreateRefundRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	r, err := h.service.Request(ctx, req.ToDomain())
+	if err != nil {
+		return handleRefundError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToResponse(r))
+}
+
+// List GET /api/refunds
+func (h *Handler) List(c *fiber.Ctx) error {
+	var q query.ListRefundQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	q.Normalize()
+	ctx := appctx.New(c.UserContext())
+
+	page, err := h.service.List(ctx, &q)
+	if err != nil {
+		return handleRefundError(c, err)
+	}
+
+	return httpx.JSONPaginated(
+		c,
+		fiber.StatusOK,
+		mapper.ToResponsePage(page).Data,
+		httpx.NewPagination(q.Page, q.Limit, page.Total),
+	)
+}
+
+// FindById GET /api/refunds/:id
+func (h *Handler) FindById(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid refund ID")
+	}
+
+	var q query.FindRefundByIdQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	r, err := h.service.GetByID(ctx, id, &q)
+	if err != nil {
+		return handleRefundError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(r))
+}
+
+// Approve POST /api/refunds/:id/approve
+func (h *Handler) Approve(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid refund ID")
+	}
+
+	var req dto.ApproveRefundRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	r, err := h.service.Approve(ctx, id, req.Amount, req.Note)
+	if err != nil {
+		return handleRefundError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(r))
+}
+
+// Reject POST /api/refunds/:id/reject
+func (h *Handler) Reject(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid refund ID")
+	}
+
+	var req dto.RejectRefundRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	r, err := h.service.Reject(ctx, id, req.Note)
+	if err != nil {
+		return handleRefundError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(r))
+}
+
+// Complete POST /api/refunds/:id/complete
+func (h *Handler) Complete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid refund ID")
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	r, err := h.service.Complete(ctx, id)
+	if err != nil {
+		return handleRefundError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(r))
+}
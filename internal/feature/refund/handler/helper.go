@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/types"
+
+	cashShiftDomain "github.com/umardev500/laundry/internal/feature/cashshift/domain"
+	orderDomain "github.com/umardev500/laundry/internal/feature/order/domain"
+	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+)
+
+// handleRefundError centralizes HTTP error mapping for refund module
+func handleRefundError(c *fiber.Ctx, err error) error {
+	switch {
+	case errorsx.IsInvalidTransitionErr[types.RefundStatus](err),
+		errorsx.IsInvalidTransitionErr[types.PaymentStatus](err),
+		errorsx.IsInvalidTransitionErr[types.OrderStatus](err):
+		return httpx.JSONErrorWithData(
+			c,
+			fiber.StatusBadRequest,
+			"invalid status transition",
+			err,
+			err,
+		)
+
+	case errors.Is(err, domain.ErrRefundNotFound),
+		errors.Is(err, paymentDomain.ErrPaymentNotFound),
+		errors.Is(err, orderDomain.ErrOrderNotFound):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrRefundRequiresUser):
+		return httpx.Unauthorized(c, err.Error())
+
+	case errors.Is(err, domain.ErrUnauthorizedRefundAccess),
+		errors.Is(err, paymentDomain.ErrPaymentDeleted),
+		errors.Is(err, paymentDomain.ErrUnauthorizedPaymentAccess),
+		errors.Is(err, cashShiftDomain.ErrCashShiftRequiresStaff):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, domain.ErrPaymentNotRefundable),
+		errors.Is(err, domain.ErrNothingToRefund),
+		errors.Is(err, domain.ErrRefundExceedsRefundable),
+		errors.Is(err, cashShiftDomain.ErrNoOpenCashShift):
+		return httpx.Conflict(c, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidRefundAmount),
+		errors.Is(err, domain.ErrRefundReasonRequired),
+		errors.Is(err, domain.ErrInvalidRefundMethod),
+		errors.Is(err, domain.ErrApprovedAmountExceedsRequested),
+		errors.Is(err, domain.ErrOriginalMethodRequiresGateway),
+		errors.Is(err, domain.ErrWalletRefundRequiresCustomer):
+		return httpx.BadRequest(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
@@ -0,0 +1,97 @@
+package mapper
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/internal/feature/refund/dto"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+
+	paymentMapper "github.com/umardev500/laundry/internal/feature/payment/mapper"
+)
+
+// FromEnt converts an Ent Refund to a domain Refund
+func FromEnt(e *ent.Refund) *domain.Refund {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.Refund{
+		ID:              e.ID,
+		TenantID:        e.TenantID,
+		PaymentID:       e.PaymentID,
+		Payment:         paymentMapper.FromEnt(e.Edges.Payment),
+		RequestedAmount: e.RequestedAmount,
+		ApprovedAmount:  e.ApprovedAmount,
+		Reason:          e.Reason,
+		Method:          types.RefundMethod(e.Method),
+		Status:          types.RefundStatus(e.Status),
+		ReviewNote:      e.ReviewNote,
+		GatewayRefundID: e.GatewayRefundID,
+		CashShiftID:     e.CashShiftID,
+		RequestedBy:     e.RequestedBy,
+		ApprovedBy:      e.ApprovedBy,
+		RejectedBy:      e.RejectedBy,
+		ApprovedAt:      e.ApprovedAt,
+		RejectedAt:      e.RejectedAt,
+		RefundedAt:      e.RefundedAt,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+// FromEntList converts a slice of Ent Refunds to domain Refunds
+func FromEntList(ents []*ent.Refund) []*domain.Refund {
+	refunds := make([]*domain.Refund, len(ents))
+	for i, e := range ents {
+		refunds[i] = FromEnt(e)
+	}
+	return refunds
+}
+
+// ToResponse converts a domain Refund to a RefundResponse DTO
+func ToResponse(d *domain.Refund) *dto.RefundResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.RefundResponse{
+		ID:              d.ID,
+		TenantID:        d.TenantID,
+		PaymentID:       d.PaymentID,
+		Payment:         paymentMapper.ToResponse(d.Payment, nil),
+		RequestedAmount: d.RequestedAmount,
+		ApprovedAmount:  d.ApprovedAmount,
+		Reason:          d.Reason,
+		Method:          d.Method,
+		Status:          d.Status,
+		ReviewNote:      d.ReviewNote,
+		GatewayRefundID: d.GatewayRefundID,
+		CashShiftID:     d.CashShiftID,
+		RequestedBy:     d.RequestedBy,
+		ApprovedBy:      d.ApprovedBy,
+		RejectedBy:      d.RejectedBy,
+		ApprovedAt:      d.ApprovedAt,
+		RejectedAt:      d.RejectedAt,
+		RefundedAt:      d.RefundedAt,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+	}
+}
+
+// ToResponseList converts a slice of domain Refunds to DTOs
+func ToResponseList(refunds []*domain.Refund) []*dto.RefundResponse {
+	res := make([]*dto.RefundResponse, len(refunds))
+	for i, r := range refunds {
+		res[i] = ToResponse(r)
+	}
+	return res
+}
+
+// ToResponsePage converts paginated domain Refunds to paginated DTOs
+func ToResponsePage(data *pagination.PageData[domain.Refund]) *pagination.PageData[dto.RefundResponse] {
+	return &pagination.PageData[dto.RefundResponse]{
+		Data:  ToResponseList(data.Data),
+		Total: data.Total,
+	}
+}
@@ -0,0 +1,16 @@
+package refund
+
+import (
+	"github.com/google/wire"
+	"github.com/umardev500/laundry/internal/feature/refund/handler"
+	"github.com/umardev500/laundry/internal/feature/refund/repository"
+	"github.com/umardev500/laundry/internal/feature/refund/service"
+)
+
+// ProviderSet wires Refund module dependencies
+var ProviderSet = wire.NewSet(
+	handler.NewHandler,
+	repository.NewEntRefundRepository,
+	service.NewRefundService,
+	NewRoutes,
+)
@@ -0,0 +1,5 @@
+package query
+
+type FindRefundByIdQuery struct {
+	IncludePayment bool `query:"include_payment"`
+}
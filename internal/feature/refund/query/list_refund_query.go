@@ -0,0 +1,33 @@
+package query
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// OrderBy for refunds
+type RefundOrder string
+
+const (
+	RefundOrderCreatedAtAsc  RefundOrder = "created_at_asc"
+	RefundOrderCreatedAtDesc RefundOrder = "created_at_desc"
+)
+
+// ListRefundQuery defines filters for listing refunds
+type ListRefundQuery struct {
+	pagination.Query
+	PaymentID      *uuid.UUID         `query:"payment_id"`      // Filter by payment (optional)
+	Status         types.RefundStatus `query:"status"`          // Filter by refund status (optional)
+	Order          RefundOrder        `query:"order"`           // Order by created_at
+	IncludePayment bool               `query:"include_payment"` // Load the refunded payment
+}
+
+// Normalize sets default pagination and ordering values
+func (q *ListRefundQuery) Normalize() {
+	q.Query.Normalize(1, 10) // Default page 1, 10 items per page
+	if q.Order == "" {
+		q.Order = RefundOrderCreatedAtDesc
+	}
+	q.Status = q.Status.Normalize()
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/payment"
+	"github.com/umardev500/laundry/ent/refund"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/internal/feature/refund/mapper"
+	"github.com/umardev500/laundry/internal/feature/refund/query"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// EntRefundRepository implements domain.Refund repository using Ent
+type EntRefundRepository struct {
+	client *entdb.Client
+}
+
+// NewEntRefundRepository creates a new repository instance
+func NewEntRefundRepository(client *entdb.Client) Repository {
+	return &EntRefundRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new refund
+func (r *EntRefundRepository) Create(ctx *appctx.Context, d *domain.Refund) (*domain.Refund, error) {
+	conn := r.client.GetConn(ctx)
+	entRefund, err := conn.Refund.
+		Create().
+		SetNillableTenantID(d.TenantID).
+		SetPaymentID(d.PaymentID).
+		SetRequestedAmount(d.RequestedAmount).
+		SetReason(d.Reason).
+		SetMethod(refund.Method(d.Method)).
+		SetStatus(refund.Status(d.Status)).
+		SetRequestedBy(d.RequestedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entRefund), nil
+}
+
+// Update modifies an existing refund
+func (r *EntRefundRepository) Update(ctx *appctx.Context, d *domain.Refund) (*domain.Refund, error) {
+	conn := r.client.GetConn(ctx)
+	entRefund, err := conn.Refund.
+		UpdateOneID(d.ID).
+		SetNillableApprovedAmount(d.ApprovedAmount).
+		SetMethod(refund.Method(d.Method)).
+		SetStatus(refund.Status(d.Status)).
+		SetNillableReviewNote(d.ReviewNote).
+		SetNillableGatewayRefundID(d.GatewayRefundID).
+		SetNillableCashShiftID(d.CashShiftID).
+		SetNillableApprovedBy(d.ApprovedBy).
+		SetNillableRejectedBy(d.RejectedBy).
+		SetNillableApprovedAt(d.ApprovedAt).
+		SetNillableRejectedAt(d.RejectedAt).
+		SetNillableRefundedAt(d.RefundedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entRefund), nil
+}
+
+// FindById returns a refund by its ID
+func (r *EntRefundRepository) FindById(ctx *appctx.Context, id uuid.UUID, q *query.FindRefundByIdQuery) (*domain.Refund, error) {
+	if q == nil {
+		q = &query.FindRefundByIdQuery{}
+	}
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.Refund.
+		Query().
+		Where(refund.IDEQ(id))
+
+	qb = r.applyScope(ctx, qb)
+
+	if q.IncludePayment {
+		qb = qb.WithPayment()
+	}
+
+	entRefund, err := qb.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entRefund), nil
+}
+
+// ListByPayment returns every refund of a payment, oldest first
+func (r *EntRefundRepository) ListByPayment(ctx *appctx.Context, paymentID uuid.UUID) ([]*domain.Refund, error) {
+	conn := r.client.GetConn(ctx)
+	ents, err := conn.Refund.
+		Query().
+		Where(refund.PaymentIDEQ(paymentID)).
+		Order(ent.Asc(refund.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEntList(ents), nil
+}
+
+// List retrieves paginated refunds with filtering, ordering, and tenant scoping.
+func (r *EntRefundRepository) List(ctx *appctx.Context, q *query.ListRefundQuery) (*pagination.PageData[domain.Refund], error) {
+	q.Normalize()
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.Refund.Query()
+	qb = r.applyScope(ctx, qb)
+
+	// Filter by payment
+	if q.PaymentID != nil {
+		qb = qb.Where(refund.PaymentIDEQ(*q.PaymentID))
+	}
+
+	// Filter by status
+	if q.Status != "" {
+		qb = qb.Where(refund.StatusEQ(refund.Status(q.Status)))
+	}
+
+	if q.IncludePayment {
+		qb = qb.WithPayment()
+	}
+
+	// Ordering
+	switch q.Order {
+	case query.RefundOrderCreatedAtAsc:
+		qb = qb.Order(ent.Asc(refund.FieldCreatedAt))
+	default:
+		qb = qb.Order(ent.Desc(refund.FieldCreatedAt))
+	}
+
+	// Total count for pagination
+	total, err := qb.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch paginated data
+	ents, err := qb.
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagination.PageData[domain.Refund]{
+		Data:  mapper.FromEntList(ents),
+		Total: total,
+	}, nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// applyScope ensures tenant-level filtering.
+func (r *EntRefundRepository) applyScope(ctx *appctx.Context, qb *ent.RefundQuery) *ent.RefundQuery {
+	switch ctx.Scope() {
+	case appctx.ScopeTenant:
+		qb = qb.Where(refund.TenantIDEQ(*ctx.TenantID()))
+	case appctx.ScopeUser:
+		qb = qb.Where(refund.HasPaymentWith(payment.UserIDEQ(*ctx.UserID())))
+	case appctx.ScopeAdmin:
+		// no filtering for admin
+	}
+
+	return qb
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/internal/feature/refund/query"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+type Repository interface {
+	// Create inserts a new refund into the database.
+	Create(ctx *appctx.Context, r *domain.Refund) (*domain.Refund, error)
+
+	// Update saves the review and completion of a refund.
+	Update(ctx *appctx.Context, r *domain.Refund) (*domain.Refund, error)
+
+	// FindById returns a refund by its ID.
+	FindById(ctx *appctx.Context, id uuid.UUID, q *query.FindRefundByIdQuery) (*domain.Refund, error)
+
+	// ListByPayment returns every refund of a payment.
+	ListByPayment(ctx *appctx.Context, paymentID uuid.UUID) ([]*domain.Refund, error)
+
+	List(ctx *appctx.Context, q *query.ListRefundQuery) (*pagination.PageData[domain.Refund], error)
+}
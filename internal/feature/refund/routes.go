@@ -0,0 +1,44 @@
+package refund
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
+	"github.com/umardev500/laundry/internal/app/router"
+	"github.com/umardev500/laundry/internal/feature/refund/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+)
+
+type Routes struct {
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
+}
+
+var _ router.RouteRegistrar = (*Routes)(nil)
+
+func (r *Routes) RegisterRoutes(router fiber.Router) {
+	group := router.Group("refunds")
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+
+	group.Get("/", middleware.RequirePermission(r.authz, "view_refund"), r.handler.List)
+	group.Post("/", middleware.RequirePermission(r.authz, "request_refund"), r.handler.Request)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_refund"), r.handler.FindById)
+
+	// Reviewing and paying out refunds is limited to approvers
+	group.Post("/:id/approve", middleware.RequirePermission(r.authz, "approve_refund"), r.handler.Approve)
+	group.Post("/:id/reject", middleware.RequirePermission(r.authz, "approve_refund"), r.handler.Reject)
+	group.Post("/:id/complete", middleware.RequirePermission(r.authz, "approve_refund"), r.handler.Complete)
+}
+
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
+}
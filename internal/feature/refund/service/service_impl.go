@@ -0,0 +1,439 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/refund/contract"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/internal/feature/refund/query"
+	"github.com/umardev500/laundry/internal/feature/refund/repository"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/internal/infra/paymentgateway"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+
+	cashShiftContract "github.com/umardev500/laundry/internal/feature/cashshift/contract"
+	orderContract "github.com/umardev500/laundry/internal/feature/order/contract"
+	orderDomain "github.com/umardev500/laundry/internal/feature/order/domain"
+	orderQuery "github.com/umardev500/laundry/internal/feature/order/query"
+	paymentContract "github.com/umardev500/laundry/internal/feature/payment/contract"
+	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+	paymentMethodContract "github.com/umardev500/laundry/internal/feature/paymentmethod/contract"
+	walletContract "github.com/umardev500/laundry/internal/feature/wallet/contract"
+)
+
+type refundService struct {
+	client               *entdb.Client
+	repo                 repository.Repository
+	paymentService       paymentContract.Service
+	paymentMethodService paymentMethodContract.Service
+	orderService         orderContract.OrderService
+	gateway              paymentgateway.PaymentGateway
+	cashShift            cashShiftContract.Service
+	wallet               walletContract.Service
+}
+
+// NewRefundService creates a new refund service
+func NewRefundService(
+	client *entdb.Client,
+	repo repository.Repository,
+	paymentService paymentContract.Service,
+	paymentMethodService paymentMethodContract.Service,
+	orderService orderContract.OrderService,
+	gateway paymentgateway.PaymentGateway,
+	cashShift cashShiftContract.Service,
+	wallet walletContract.Service,
+) contract.Service {
+	return &refundService{
+		client:               client,
+		repo:                 repo,
+		paymentService:       paymentService,
+		paymentMethodService: paymentMethodService,
+		orderService:         orderService,
+		gateway:              gateway,
+		cashShift:            cashShift,
+		wallet:               wallet,
+	}
+}
+
+// Request implements contract.Service.
+func (s *refundService) Request(ctx *appctx.Context, r *domain.Refund) (*domain.Refund, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrRefundRequiresUser
+	}
+
+	var created *domain.Refund
+	err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		pay, refunds, err := s.refundablePayment(newCtx, r.PaymentID)
+		if err != nil {
+			return err
+		}
+
+		// Gateway and wallet payments go back the way they came unless told otherwise
+		if r.Method == "" {
+			r.Method, err = s.defaultMethod(newCtx, pay)
+			if err != nil {
+				return err
+			}
+		}
+		if r.Method == types.RefundMethodOriginal && pay.GatewayChargeID == nil {
+			return domain.ErrOriginalMethodRequiresGateway
+		}
+		if r.Method == types.RefundMethodWallet && (pay.UserID == nil || pay.TenantID == nil) {
+			return domain.ErrWalletRefundRequiresCustomer
+		}
+
+		refundable := pay.Amount - domain.Held(refunds, uuid.Nil)
+		if refundable <= 0 {
+			return domain.ErrNothingToRefund
+		}
+
+		if r.RequestedAmount == 0 {
+			r.RequestedAmount = refundable
+		}
+		if r.RequestedAmount > refundable {
+			return domain.ErrRefundExceedsRefundable
+		}
+
+		r.TenantID = pay.TenantID
+		r.RequestedBy = *userID
+		r.Status = types.RefundStatusPending
+
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		created, err = s.repo.Create(newCtx, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Approve implements contract.Service.
+func (s *refundService) Approve(ctx *appctx.Context, id uuid.UUID, amount *money.Money, note *string) (*domain.Refund, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrRefundRequiresUser
+	}
+
+	var updated *domain.Refund
+	err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		r, err := s.findExisting(newCtx, id, nil)
+		if err != nil {
+			return err
+		}
+
+		pay, refunds, err := s.refundablePayment(newCtx, r.PaymentID)
+		if err != nil {
+			return err
+		}
+
+		// Read the refund again under the payment lock; a concurrent approval
+		// of the same refund may have finished while we waited for it
+		r, err = s.findExisting(newCtx, id, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := r.Approve(*userID, amount, note); err != nil {
+			return err
+		}
+
+		// Other refunds may have been approved since this one was requested
+		if r.Amount() > pay.Amount-domain.Held(refunds, r.ID) {
+			return domain.ErrRefundExceedsRefundable
+		}
+
+		// Cash comes out of the approver's drawer, which must be open
+		if r.Method == types.RefundMethodCash {
+			shiftID, err := s.cashShift.OpenShiftID(newCtx)
+			if err != nil {
+				return err
+			}
+			r.CashShiftID = &shiftID
+		}
+
+		// Transfers are sent by staff and completed afterwards; gateway
+		// refunds are paid out once the approval is committed
+		if r.Method == types.RefundMethodCash || r.Method == types.RefundMethodWallet {
+			if err := r.MarkRefunded(nil); err != nil {
+				return err
+			}
+		}
+
+		updated, err = s.repo.Update(newCtx, r)
+		if err != nil {
+			return err
+		}
+
+		if updated.Status != types.RefundStatusRefunded {
+			return nil
+		}
+
+		if err := s.settle(newCtx, pay); err != nil {
+			return err
+		}
+
+		if r.Method == types.RefundMethodWallet {
+			_, err := s.wallet.CreditRefund(newCtx, *pay.TenantID, *pay.UserID, r.Amount(), r.ID)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.Method == types.RefundMethodOriginal {
+		return s.refundAtGateway(ctx, updated)
+	}
+
+	return updated, nil
+}
+
+// Reject implements contract.Service.
+func (s *refundService) Reject(ctx *appctx.Context, id uuid.UUID, note string) (*domain.Refund, error) {
+	userID := ctx.UserID()
+	if userID == nil {
+		return nil, domain.ErrRefundRequiresUser
+	}
+
+	r, err := s.findExisting(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Reject(*userID, &note); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, r)
+}
+
+// Complete implements contract.Service.
+func (s *refundService) Complete(ctx *appctx.Context, id uuid.UUID) (*domain.Refund, error) {
+	r, err := s.findExisting(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Method == types.RefundMethodOriginal && r.Status == types.RefundStatusApproved {
+		return s.refundAtGateway(ctx, r)
+	}
+
+	var updated *domain.Refund
+	err = s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		r, err := s.findExisting(newCtx, id, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := r.MarkRefunded(nil); err != nil {
+			return err
+		}
+
+		updated, err = s.repo.Update(newCtx, r)
+		if err != nil {
+			return err
+		}
+
+		pay, err := s.paymentService.GetByID(newCtx, r.PaymentID, nil)
+		if err != nil {
+			return err
+		}
+
+		return s.settle(newCtx, pay)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// GetByID implements contract.Service.
+func (s *refundService) GetByID(ctx *appctx.Context, id uuid.UUID, q *query.FindRefundByIdQuery) (*domain.Refund, error) {
+	return s.findExisting(ctx, id, q)
+}
+
+// List implements contract.Service.
+func (s *refundService) List(ctx *appctx.Context, q *query.ListRefundQuery) (*pagination.PageData[domain.Refund], error) {
+	if q == nil {
+		q = &query.ListRefundQuery{}
+	}
+	q.Normalize()
+
+	return s.repo.List(ctx, q)
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// defaultMethod returns how a payment is refunded when the request does not say.
+func (s *refundService) defaultMethod(ctx *appctx.Context, pay *paymentDomain.Payment) (types.RefundMethod, error) {
+	if pay.IsOnline() {
+		return types.RefundMethodOriginal, nil
+	}
+
+	m, err := s.paymentMethodService.GetByID(ctx, pay.PaymentMethodID)
+	if err != nil {
+		return "", err
+	}
+
+	if m.Type == types.PaymentMethodWallet && pay.UserID != nil {
+		return types.RefundMethodWallet, nil
+	}
+	return types.RefundMethodCash, nil
+}
+
+// refundAtGateway pays an approved refund back through the payment gateway and
+// records it as refunded. It runs outside the approval's transaction so a
+// rollback cannot undo the record of money already sent; the refund ID is the
+// idempotency key, so retrying after a failure never pays out twice.
+func (s *refundService) refundAtGateway(ctx *appctx.Context, r *domain.Refund) (*domain.Refund, error) {
+	pay, err := s.paymentService.GetByID(ctx, r.PaymentID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if pay.GatewayChargeID == nil {
+		return nil, domain.ErrOriginalMethodRequiresGateway
+	}
+
+	res, err := s.gateway.Refund(ctx, paymentgateway.RefundRequest{
+		ChargeID:       *pay.GatewayChargeID,
+		Amount:         r.Amount(),
+		Reason:         r.Reason,
+		IdempotencyKey: r.ID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *domain.Refund
+	err = s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		r, err := s.findExisting(newCtx, r.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := r.MarkRefunded(&res.ID); err != nil {
+			return err
+		}
+
+		updated, err = s.repo.Update(newCtx, r)
+		if err != nil {
+			return err
+		}
+
+		return s.settle(newCtx, pay)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// refundablePayment loads a paid payment together with its refunds. The
+// payment stays locked until the transaction ends, so concurrent requests and
+// approvals see each other's refunds and cannot refund more than was paid.
+func (s *refundService) refundablePayment(ctx *appctx.Context, paymentID uuid.UUID) (*paymentDomain.Payment, []*domain.Refund, error) {
+	pay, err := s.paymentService.GetByIDForUpdate(ctx, paymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pay.Status != types.PaymentStatusPaid {
+		return nil, nil, domain.ErrPaymentNotRefundable
+	}
+
+	refunds, err := s.repo.ListByPayment(ctx, pay.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pay, refunds, nil
+}
+
+// settle moves the payment to REFUNDED once its refunds add up to the paid
+// amount, and the order with it when none of its payments are left paid. An
+// order still being worked on cannot be refunded and fails the refund.
+func (s *refundService) settle(ctx *appctx.Context, pay *paymentDomain.Payment) error {
+	refunds, err := s.repo.ListByPayment(ctx, pay.ID)
+	if err != nil {
+		return err
+	}
+
+	if domain.Refunded(refunds) < pay.Amount {
+		return nil
+	}
+
+	for _, next := range pay.Status.PathTo(types.PaymentStatusRefunded) {
+		if _, err := s.paymentService.UpdateStatus(ctx, &paymentDomain.Payment{ID: pay.ID, Status: next}); err != nil {
+			return err
+		}
+	}
+
+	if pay.RefType != types.PaymentTypeOrder {
+		return nil
+	}
+
+	ord, err := s.orderService.FindByID(ctx, pay.RefID, &orderQuery.OrderQuery{IncludePayments: true})
+	if err != nil {
+		return err
+	}
+
+	if !ord.IsFullyRefunded() || ord.Status == types.OrderStatusRefunded {
+		return nil
+	}
+
+	for _, next := range []types.OrderStatus{types.OrderStatusRefundRequested, types.OrderStatusRefunded} {
+		if ord.Status == next {
+			continue
+		}
+
+		ord, err = s.orderService.UpdateStatus(ctx, &orderDomain.Order{ID: ord.ID, Status: next})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findExisting ensures the refund exists and belongs to the tenant in context
+func (s *refundService) findExisting(ctx *appctx.Context, id uuid.UUID, q *query.FindRefundByIdQuery) (*domain.Refund, error) {
+	r, err := s.repo.FindById(ctx, id, q)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrRefundNotFound
+		}
+		return nil, err
+	}
+
+	if !r.BelongsToTenant(ctx) {
+		return nil, domain.ErrUnauthorizedRefundAccess
+	}
+
+	return r, nil
+}
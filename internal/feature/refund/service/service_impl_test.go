@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/refund/domain"
+	"github.com/umardev500/laundry/internal/feature/refund/query"
+	"github.com/umardev500/laundry/internal/feature/refund/repository"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+
+	cashShiftContract "github.com/umardev500/laundry/internal/feature/cashshift/contract"
+	paymentContract "github.com/umardev500/laundry/internal/feature/payment/contract"
+	paymentDomain "github.com/umardev500/laundry/internal/feature/payment/domain"
+	paymentQuery "github.com/umardev500/laundry/internal/feature/payment/query"
+)
+
+// memoryRefunds is an in-memory refund repository.
+type memoryRefunds struct {
+	repository.Repository
+	refunds map[uuid.UUID]domain.Refund
+}
+
+func (m *memoryRefunds) Create(_ *appctx.Context, r *domain.Refund) (*domain.Refund, error) {
+	r.ID = uuid.New()
+	m.refunds[r.ID] = *r
+	return r, nil
+}
+
+func (m *memoryRefunds) Update(_ *appctx.Context, r *domain.Refund) (*domain.Refund, error) {
+	m.refunds[r.ID] = *r
+	return r, nil
+}
+
+func (m *memoryRefunds) FindById(_ *appctx.Context, id uuid.UUID, _ *query.FindRefundByIdQuery) (*domain.Refund, error) {
+	r, ok := m.refunds[id]
+	if !ok {
+		return nil, &ent.NotFoundError{}
+	}
+	return &r, nil
+}
+
+func (m *memoryRefunds) ListByPayment(_ *appctx.Context, paymentID uuid.UUID) ([]*domain.Refund, error) {
+	var refunds []*domain.Refund
+	for _, r := range m.refunds {
+		if r.PaymentID == paymentID {
+			refunds = append(refunds, &r)
+		}
+	}
+	return refunds, nil
+}
+
+// stubPayments serves a single payment and records its status changes.
+type stubPayments struct {
+	paymentContract.Service
+	payment  paymentDomain.Payment
+	statuses []types.PaymentStatus
+}
+
+func (s *stubPayments) GetByID(_ *appctx.Context, _ uuid.UUID, _ *paymentQuery.FindPaymentByIdQuery) (*paymentDomain.Payment, error) {
+	p := s.payment
+	return &p, nil
+}
+
+func (s *stubPayments) GetByIDForUpdate(_ *appctx.Context, _ uuid.UUID) (*paymentDomain.Payment, error) {
+	p := s.payment
+	return &p, nil
+}
+
+func (s *stubPayments) UpdateStatus(_ *appctx.Context, p *paymentDomain.Payment) (*paymentDomain.Payment, error) {
+	s.payment.Status = p.Status
+	s.statuses = append(s.statuses, p.Status)
+	p2 := s.payment
+	return &p2, nil
+}
+
+// stubCashShift always has the given shift open.
+type stubCashShift struct {
+	cashShiftContract.Service
+	shiftID uuid.UUID
+}
+
+func (s *stubCashShift) OpenShiftID(_ *appctx.Context) (uuid.UUID, error) {
+	return s.shiftID, nil
+}
+
+type refundFixture struct {
+	service  *refundService
+	refunds  *memoryRefunds
+	payments *stubPayments
+	shiftID  uuid.UUID
+}
+
+// newRefundFixture returns a refund service for a paid cash payment of amount
+// that already has the given refunds.
+func newRefundFixture(amount money.Money, existing ...domain.Refund) *refundFixture {
+	tenantID := uuid.New()
+	pay := paymentDomain.Payment{
+		ID:       uuid.New(),
+		TenantID: &tenantID,
+		RefType:  types.PaymentTypeSubscription,
+		Amount:   amount,
+		Status:   types.PaymentStatusPaid,
+	}
+
+	refunds := &memoryRefunds{refunds: map[uuid.UUID]domain.Refund{}}
+	for _, r := range existing {
+		r.ID = uuid.New()
+		r.PaymentID = pay.ID
+		r.TenantID = &tenantID
+		refunds.refunds[r.ID] = r
+	}
+
+	f := &refundFixture{
+		refunds:  refunds,
+		payments: &stubPayments{payment: pay},
+		shiftID:  uuid.New(),
+	}
+	f.service = &refundService{
+		client:         entdb.NewNopClient(),
+		repo:           refunds,
+		paymentService: f.payments,
+		cashShift:      &stubCashShift{shiftID: f.shiftID},
+	}
+	return f
+}
+
+func staffContext() *appctx.Context {
+	userID := uuid.New()
+	return appctx.New(context.Background()).WithUserID(&userID)
+}
+
+func cashRefund(amount money.Money, status types.RefundStatus) domain.Refund {
+	return domain.Refund{
+		RequestedAmount: amount,
+		Reason:          "damaged",
+		Method:          types.RefundMethodCash,
+		Status:          status,
+	}
+}
+
+func TestRequestRefundLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []domain.Refund
+		amount   money.Money
+		want     money.Money
+		wantErr  error
+	}{
+		{name: "part of the payment", amount: 30_000, want: 30_000},
+		{name: "whole payment by default", want: 100_000},
+		{name: "rest by default", existing: []domain.Refund{cashRefund(40_000, types.RefundStatusRefunded)}, want: 60_000},
+		{name: "more than paid", amount: 100_001, wantErr: domain.ErrRefundExceedsRefundable},
+		{
+			name:     "more than is left after a pending refund",
+			existing: []domain.Refund{cashRefund(70_000, types.RefundStatusPending)},
+			amount:   40_000,
+			wantErr:  domain.ErrRefundExceedsRefundable,
+		},
+		{
+			name:     "rejected refunds free the amount",
+			existing: []domain.Refund{cashRefund(70_000, types.RefundStatusRejected)},
+			amount:   100_000,
+			want:     100_000,
+		},
+		{
+			name:     "nothing left",
+			existing: []domain.Refund{cashRefund(100_000, types.RefundStatusApproved)},
+			wantErr:  domain.ErrNothingToRefund,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRefundFixture(100_000, tt.existing...)
+
+			r := cashRefund(tt.amount, "")
+			r.PaymentID = f.payments.payment.ID
+
+			got, err := f.service.Request(staffContext(), &r)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Request() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Request() unexpected error: %v", err)
+			}
+
+			if got.RequestedAmount != tt.want || got.Status != types.RefundStatusPending {
+				t.Errorf("Request() = %s %s, want %s %s", got.RequestedAmount, got.Status, tt.want, types.RefundStatusPending)
+			}
+		})
+	}
+}
+
+func TestApproveRefundLimits(t *testing.T) {
+	tests := []struct {
+		name         string
+		requested    money.Money
+		approved     *money.Money
+		others       []domain.Refund
+		wantErr      error
+		wantStatuses []types.PaymentStatus
+	}{
+		{name: "part of the payment", requested: 40_000},
+		{name: "less than requested", requested: 100_000, approved: amountOf(60_000)},
+		{
+			name:      "more than requested",
+			requested: 40_000,
+			approved:  amountOf(50_000),
+			wantErr:   domain.ErrApprovedAmountExceedsRequested,
+		},
+		{
+			name:      "more than is left after another approval",
+			requested: 60_000,
+			others:    []domain.Refund{cashRefund(50_000, types.RefundStatusRefunded)},
+			wantErr:   domain.ErrRefundExceedsRefundable,
+		},
+		{
+			name:         "rest of the payment",
+			requested:    50_000,
+			others:       []domain.Refund{cashRefund(50_000, types.RefundStatusRefunded)},
+			wantStatuses: []types.PaymentStatus{types.PaymentStatusRefundRequested, types.PaymentStatusRefunded},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending := cashRefund(tt.requested, types.RefundStatusPending)
+			f := newRefundFixture(100_000, append(tt.others, pending)...)
+
+			var id uuid.UUID
+			for _, r := range f.refunds.refunds {
+				if r.Status == types.RefundStatusPending {
+					id = r.ID
+				}
+			}
+
+			got, err := f.service.Approve(staffContext(), id, tt.approved, nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Approve() error = %v, want %v", err, tt.wantErr)
+				}
+				if stored := f.refunds.refunds[id]; stored.Status != types.RefundStatusPending {
+					t.Errorf("stored refund status = %s, want %s", stored.Status, types.RefundStatusPending)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Approve() unexpected error: %v", err)
+			}
+
+			want := tt.requested
+			if tt.approved != nil {
+				want = *tt.approved
+			}
+			if got.Amount() != want || got.Status != types.RefundStatusRefunded {
+				t.Errorf("Approve() = %s %s, want %s %s", got.Amount(), got.Status, want, types.RefundStatusRefunded)
+			}
+			if got.CashShiftID == nil || *got.CashShiftID != f.shiftID {
+				t.Errorf("Approve() cash shift = %v, want %s", got.CashShiftID, f.shiftID)
+			}
+			if !slices.Equal(f.payments.statuses, tt.wantStatuses) {
+				t.Errorf("payment statuses = %v, want %v", f.payments.statuses, tt.wantStatuses)
+			}
+		})
+	}
+}
+
+func amountOf(m money.Money) *money.Money {
+	return &m
+}
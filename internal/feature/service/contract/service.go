@@ -17,5 +17,7 @@ type Service interface {
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 	Purge(ctx *appctx.Context, id uuid.UUID) error
 
-	AreItemsAvailable(ctx *appctx.Context, ids []uuid.UUID) (*domain.AvailabilityResult, error)
+	// AreItemsAvailable looks the services up among the tenant's own; services
+	// of other tenants are reported unavailable.
+	AreItemsAvailable(ctx *appctx.Context, tenantID uuid.UUID, ids []uuid.UUID) (*domain.AvailabilityResult, error)
 }
@@ -5,6 +5,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type Service struct {
@@ -13,7 +16,9 @@ type Service struct {
 	ServiceUnitID     *uuid.UUID
 	ServiceCategoryID *uuid.UUID
 	Name              string
-	BasePrice         float64
+	BasePrice         money.Money
+	Pricing           *pricing.Rules     // nil charges the base price per unit
+	Turnarounds       turnaround.Options // nil offers turnaround.Default
 	Description       string
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -21,7 +26,7 @@ type Service struct {
 }
 
 // Update applies changes to mutable fields.
-func (s *Service) Update(name string, price float64, description string, unitID, categoryID *uuid.UUID) {
+func (s *Service) Update(name string, price money.Money, description string, unitID, categoryID *uuid.UUID) {
 	if name != "" {
 		s.Name = name
 	}
@@ -40,6 +45,38 @@ func (s *Service) Update(name string, price float64, description string, unitID,
 	}
 }
 
+// SetPricing validates and replaces the pricing rules. Empty rules remove them.
+func (s *Service) SetPricing(rules *pricing.Rules) error {
+	if rules.IsZero() {
+		s.Pricing = nil
+		return nil
+	}
+
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	s.Pricing = rules
+	return nil
+}
+
+// SetTurnarounds validates and replaces the turnaround options. No options
+// remove them, leaving turnaround.Default.
+func (s *Service) SetTurnarounds(opts turnaround.Options) error {
+	if len(opts) == 0 {
+		s.Turnarounds = nil
+		return nil
+	}
+
+	opts.Normalize()
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	s.Turnarounds = opts
+	return nil
+}
+
 // SoftDelete marks record as deleted.
 func (s *Service) SoftDelete() {
 	now := time.Now().UTC()
@@ -4,16 +4,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/service/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/utils"
 )
 
 type CreateServiceRequest struct {
-	TenantID          uuid.UUID `json:"tenant_id" validate:"required"`
-	ServiceUnitID     uuid.UUID `json:"service_unit_id,omitempty"`
-	ServiceCategoryID uuid.UUID `json:"service_category_id,omitempty"`
-	Name              string    `json:"name" validate:"required,min=2,max=200"`
-	Price             float64   `json:"price,omitempty"`
-	Description       string    `json:"description,omitempty" validate:"omitempty,max=1024"`
+	TenantID          uuid.UUID          `json:"tenant_id" validate:"required"`
+	ServiceUnitID     uuid.UUID          `json:"service_unit_id,omitempty"`
+	ServiceCategoryID uuid.UUID          `json:"service_category_id,omitempty"`
+	Name              string             `json:"name" validate:"required,min=2,max=200"`
+	Price             money.Money        `json:"price,omitempty"`
+	Pricing           *pricing.Rules     `json:"pricing,omitempty"`
+	Turnarounds       turnaround.Options `json:"turnarounds,omitempty"`
+	Description       string             `json:"description,omitempty" validate:"omitempty,max=1024"`
 }
 
 func (r *CreateServiceRequest) ToDomain(ctx *appctx.Context) *domain.Service {
@@ -27,6 +32,8 @@ func (r *CreateServiceRequest) ToDomain(ctx *appctx.Context) *domain.Service {
 		ServiceCategoryID: utils.NilIfUUIDZero(r.ServiceCategoryID),
 		Name:              r.Name,
 		BasePrice:         r.Price,
+		Pricing:           r.Pricing,
+		Turnarounds:       r.Turnarounds,
 		Description:       r.Description,
 	}
 }
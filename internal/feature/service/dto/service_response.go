@@ -4,16 +4,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type ServiceResponse struct {
-	ID                uuid.UUID  `json:"id"`
-	TenantID          uuid.UUID  `json:"tenant_id"`
-	ServiceUnitID     *uuid.UUID `json:"service_unit_id,omitempty"`
-	ServiceCategoryID *uuid.UUID `json:"service_category_id,omitempty"`
-	Name              string     `json:"name"`
-	Price             float64    `json:"price"`
-	Description       string     `json:"description,omitempty"`
-	CreatedAt         time.Time  `json:"created_at"`
-	UpdatedAt         time.Time  `json:"updated_at"`
+	ID                uuid.UUID          `json:"id"`
+	TenantID          uuid.UUID          `json:"tenant_id"`
+	ServiceUnitID     *uuid.UUID         `json:"service_unit_id,omitempty"`
+	ServiceCategoryID *uuid.UUID         `json:"service_category_id,omitempty"`
+	Name              string             `json:"name"`
+	Price             money.Money        `json:"price"`
+	Pricing           *pricing.Rules     `json:"pricing,omitempty"`
+	Turnarounds       turnaround.Options `json:"turnarounds,omitempty"`
+	Description       string             `json:"description,omitempty"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
 }
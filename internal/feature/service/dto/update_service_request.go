@@ -3,19 +3,24 @@ package dto
 import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/feature/service/domain"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/utils"
 )
 
 type UpdateServiceRequest struct {
-	Name              string    `json:"name,omitempty" validate:"omitempty,min=2,max=200"`
-	Price             *float64  `json:"price,omitempty"`
-	Description       string    `json:"description,omitempty" validate:"omitempty,max=1024"`
-	ServiceUnitID     uuid.UUID `json:"service_unit_id,omitempty"`
-	ServiceCategoryID uuid.UUID `json:"service_category_id,omitempty"`
+	Name              string             `json:"name,omitempty" validate:"omitempty,min=2,max=200"`
+	Price             *money.Money       `json:"price,omitempty"`
+	Pricing           *pricing.Rules     `json:"pricing,omitempty"`
+	Turnarounds       turnaround.Options `json:"turnarounds,omitempty"`
+	Description       string             `json:"description,omitempty" validate:"omitempty,max=1024"`
+	ServiceUnitID     uuid.UUID          `json:"service_unit_id,omitempty"`
+	ServiceCategoryID uuid.UUID          `json:"service_category_id,omitempty"`
 }
 
 func (r *UpdateServiceRequest) ToDomain(id uuid.UUID) *domain.Service {
-	price := float64(-1)
+	price := money.Money(-1)
 	if r.Price != nil {
 		price = *r.Price
 	}
@@ -23,6 +28,8 @@ func (r *UpdateServiceRequest) ToDomain(id uuid.UUID) *domain.Service {
 		ID:                id,
 		Name:              r.Name,
 		BasePrice:         price,
+		Pricing:           r.Pricing,
+		Turnarounds:       r.Turnarounds,
 		Description:       r.Description,
 		ServiceUnitID:     utils.NilIfUUIDZero(r.ServiceUnitID),
 		ServiceCategoryID: utils.NilIfUUIDZero(r.ServiceCategoryID),
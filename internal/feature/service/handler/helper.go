@@ -6,6 +6,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/feature/service/domain"
 	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 // handleServiceError centralizes HTTP error mapping for service module
@@ -21,6 +23,18 @@ func handleServiceError(c *fiber.Ctx, err error) error {
 	case errors.Is(err, domain.ErrServiceAlreadyExists):
 		return httpx.Conflict(c, err.Error())
 
+	case errors.Is(err, pricing.ErrInvalidMinQuantity),
+		errors.Is(err, pricing.ErrInvalidQuantityStep),
+		errors.Is(err, pricing.ErrInvalidMinCharge),
+		errors.Is(err, pricing.ErrInvalidTierPrice),
+		errors.Is(err, pricing.ErrInvalidTierOrder),
+		errors.Is(err, turnaround.ErrUnknownSpeed),
+		errors.Is(err, turnaround.ErrDuplicateSpeed),
+		errors.Is(err, turnaround.ErrInvalidDuration),
+		errors.Is(err, turnaround.ErrInvalidSurcharge),
+		errors.Is(err, turnaround.ErrRegularSpeedNeeded):
+		return httpx.BadRequest(c, err.Error())
+
 	default:
 		return httpx.InternalServerError(c, err.Error())
 	}
@@ -18,6 +18,8 @@ func FromEnt(e *ent.Service) *domain.Service {
 		ServiceCategoryID: e.ServiceCategoryID,
 		Name:              e.Name,
 		BasePrice:         e.BasePrice,
+		Pricing:           e.Pricing,
+		Turnarounds:       e.Turnarounds,
 		Description:       e.Description,
 		CreatedAt:         e.CreatedAt,
 		UpdatedAt:         e.UpdatedAt,
@@ -44,6 +46,8 @@ func ToResponse(d *domain.Service) *dto.ServiceResponse {
 		ServiceCategoryID: d.ServiceCategoryID,
 		Name:              d.Name,
 		Price:             d.BasePrice,
+		Pricing:           d.Pricing,
+		Turnarounds:       d.Turnarounds.OrDefault(),
 		Description:       d.Description,
 		CreatedAt:         d.CreatedAt,
 		UpdatedAt:         d.UpdatedAt,
@@ -25,11 +25,15 @@ func NewEntRepository(client *entdb.Client) Repository {
 	}
 }
 
-func (r *entImpl) AreItemsAvailable(ctx *appctx.Context, ids []uuid.UUID) (*domain.AvailabilityResult, error) {
+// AreItemsAvailable finds the requested services among the tenant's own.
+func (r *entImpl) AreItemsAvailable(ctx *appctx.Context, tenantID uuid.UUID, ids []uuid.UUID) (*domain.AvailabilityResult, error) {
 	conn := r.client.GetConn(ctx)
 	services, err := conn.Service.
 		Query().
-		Where(service.IDIn(ids...)).
+		Where(
+			service.IDIn(ids...),
+			service.TenantID(tenantID),
+		).
 		All(ctx)
 
 	if err != nil {
@@ -46,16 +50,23 @@ func (r *entImpl) AreItemsAvailable(ctx *appctx.Context, ids []uuid.UUID) (*doma
 func (r *entImpl) Create(ctx *appctx.Context, s *domain.Service) (*domain.Service, error) {
 	conn := r.client.GetConn(ctx)
 
-	entModel, err := conn.Service.
+	builder := conn.Service.
 		Create().
 		SetTenantID(s.TenantID).
 		SetNillableServiceUnitID(s.ServiceUnitID).
 		SetNillableServiceCategoryID(s.ServiceCategoryID).
 		SetName(s.Name).
 		SetBasePrice(s.BasePrice).
-		SetNillableDescription(&s.Description).
-		Save(ctx)
+		SetNillableDescription(&s.Description)
+
+	if s.Pricing != nil {
+		builder.SetPricing(s.Pricing)
+	}
+	if s.Turnarounds != nil {
+		builder.SetTurnarounds(s.Turnarounds)
+	}
 
+	entModel, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,15 +110,27 @@ func (r *entImpl) FindByName(ctx *appctx.Context, name string) (*domain.Service,
 func (r *entImpl) Update(ctx *appctx.Context, s *domain.Service) (*domain.Service, error) {
 	conn := r.client.GetConn(ctx)
 
-	entModel, err := conn.Service.
+	builder := conn.Service.
 		UpdateOneID(s.ID).
 		SetName(s.Name).
 		SetBasePrice(s.BasePrice).
 		SetNillableDescription(&s.Description).
 		SetNillableServiceUnitID(s.ServiceUnitID).
 		SetNillableServiceCategoryID(s.ServiceCategoryID).
-		SetNillableDeletedAt(s.DeletedAt).
-		Save(ctx)
+		SetNillableDeletedAt(s.DeletedAt)
+
+	if s.Pricing != nil {
+		builder.SetPricing(s.Pricing)
+	} else {
+		builder.ClearPricing()
+	}
+	if s.Turnarounds != nil {
+		builder.SetTurnarounds(s.Turnarounds)
+	} else {
+		builder.ClearTurnarounds()
+	}
+
+	entModel, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -9,6 +9,9 @@ import (
 
 	"github.com/umardev500/laundry/ent/service"
 	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pricing"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type ServiceSeeder struct {
@@ -30,7 +33,9 @@ func (s *ServiceSeeder) Seed(ctx context.Context) error {
 		ServiceUnitID     uuid.UUID
 		ServiceCategoryID uuid.UUID
 		Name              string
-		Price             float64
+		Price             money.Money
+		Pricing           *pricing.Rules
+		Turnarounds       turnaround.Options
 		Description       string
 	}{
 		// Tenant A
@@ -40,7 +45,7 @@ func (s *ServiceSeeder) Seed(ctx context.Context) error {
 			ServiceUnitID:     uuid.MustParse("11111111-1111-1111-1111-111111111111"), // Per Piece
 			ServiceCategoryID: uuid.MustParse("11111111-1111-1111-1111-111111111111"),
 			Name:              "Wash Small",
-			Price:             2.5,
+			Price:             money.MustParse("2.5"),
 			Description:       "Small single load wash",
 		},
 		{
@@ -49,8 +54,21 @@ func (s *ServiceSeeder) Seed(ctx context.Context) error {
 			ServiceUnitID:     uuid.MustParse("22222222-2222-2222-2222-222222222222"), // Per Kilogram
 			ServiceCategoryID: uuid.MustParse("22222222-2222-2222-2222-222222222222"),
 			Name:              "Wash Large",
-			Price:             5.0,
-			Description:       "Large double load wash",
+			Price:             money.FromMajor(5),
+			Pricing: &pricing.Rules{
+				MinQuantity:  3,
+				QuantityStep: 0.5,
+				Tiers: []pricing.Tier{
+					{UpTo: 5, Price: money.FromMajor(5)},
+					{Price: money.MustParse("4.5")},
+				},
+			},
+			Turnarounds: turnaround.Options{
+				{Speed: turnaround.SpeedRegular, Days: 3},
+				{Speed: turnaround.SpeedExpress, Days: 1, SurchargePercent: 50},
+				{Speed: turnaround.SpeedSameDay, Hours: 6, SurchargePercent: 100},
+			},
+			Description: "Large double load wash",
 		},
 
 		// Tenant B
@@ -60,7 +78,7 @@ func (s *ServiceSeeder) Seed(ctx context.Context) error {
 			ServiceUnitID:     uuid.MustParse("11111111-1111-1111-1111-111111111111"), // Per Piece
 			ServiceCategoryID: uuid.MustParse("11111111-1111-1111-1111-111111111111"),
 			Name:              "Wash Small B",
-			Price:             3.0,
+			Price:             money.FromMajor(3),
 			Description:       "Small single load wash for Tenant B",
 		},
 		{
@@ -69,7 +87,7 @@ func (s *ServiceSeeder) Seed(ctx context.Context) error {
 			ServiceUnitID:     uuid.MustParse("22222222-2222-2222-2222-222222222222"), // Per Kilogram
 			ServiceCategoryID: uuid.MustParse("22222222-2222-2222-2222-222222222222"),
 			Name:              "Wash Large B",
-			Price:             6.0,
+			Price:             money.FromMajor(6),
 			Description:       "Large double load wash for Tenant B",
 		},
 	}
@@ -84,6 +102,8 @@ func (s *ServiceSeeder) Seed(ctx context.Context) error {
 			SetName(svc.Name).
 			SetBasePrice(svc.Price).
 			SetNillableDescription(&svc.Description).
+			SetPricing(svc.Pricing).
+			SetTurnarounds(svc.Turnarounds).
 			OnConflict(
 				sql.ConflictColumns(service.FieldName),
 			).
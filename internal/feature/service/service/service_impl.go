@@ -21,13 +21,13 @@ func NewService(repo repository.Repository) contract.Service {
 	}
 }
 
-// AreItemsAvailable returns a map of service ids to availability.
-func (s *serviceImpl) AreItemsAvailable(ctx *appctx.Context, ids []uuid.UUID) (*domain.AvailabilityResult, error) {
+// AreItemsAvailable returns which of the tenant's services were found.
+func (s *serviceImpl) AreItemsAvailable(ctx *appctx.Context, tenantID uuid.UUID, ids []uuid.UUID) (*domain.AvailabilityResult, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
 
-	return s.repo.AreItemsAvailable(ctx, ids)
+	return s.repo.AreItemsAvailable(ctx, tenantID, ids)
 }
 
 // Create adds a new service.
@@ -39,6 +39,14 @@ func (s *serviceImpl) Create(ctx *appctx.Context, svc *domain.Service) (*domain.
 	if existing != nil {
 		return nil, domain.ErrServiceAlreadyExists
 	}
+
+	if err := svc.SetPricing(svc.Pricing); err != nil {
+		return nil, err
+	}
+	if err := svc.SetTurnarounds(svc.Turnarounds); err != nil {
+		return nil, err
+	}
+
 	return s.repo.Create(ctx, svc)
 }
 
@@ -66,6 +74,21 @@ func (s *serviceImpl) Update(ctx *appctx.Context, svc *domain.Service) (*domain.
 
 	// Update fields; price only if non-negative sentinel.
 	existing.Update(svc.Name, svc.BasePrice, svc.Description, svc.ServiceUnitID, svc.ServiceCategoryID)
+
+	// Pricing rules are only replaced when given
+	if svc.Pricing != nil {
+		if err := existing.SetPricing(svc.Pricing); err != nil {
+			return nil, err
+		}
+	}
+
+	// So are the turnaround options; an empty list removes them
+	if svc.Turnarounds != nil {
+		if err := existing.SetTurnarounds(svc.Turnarounds); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.repo.Update(ctx, existing)
 }
 
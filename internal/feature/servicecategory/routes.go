@@ -2,13 +2,21 @@ package servicecategory
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/feature/servicecategory/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 // Routes defines all HTTP routes for the ServiceCategory feature.
 type Routes struct {
-	handler *handler.Handler
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 // Ensure Routes implements router.RouteRegistrar.
@@ -18,17 +26,21 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	categories := router.Group("service-categories")
 
-	categories.Post("/", r.handler.Create)
-	categories.Get("/", r.handler.List)
-	categories.Get("/:id", r.handler.Get)
-	categories.Put("/:id", r.handler.Update)
-	categories.Delete("/:id", r.handler.Delete)
-	categories.Delete("/:id/purge", r.handler.Purge)
+	categories.Use(middleware.CheckAuth(r.keys, r.sessions))
+	categories.Post("/", middleware.RequirePermission(r.authz, "create_service"), r.handler.Create)
+	categories.Get("/", middleware.RequirePermission(r.authz, "view_service"), r.handler.List)
+	categories.Get("/:id", middleware.RequirePermission(r.authz, "view_service"), r.handler.Get)
+	categories.Put("/:id", middleware.RequirePermission(r.authz, "update_service"), r.handler.Update)
+	categories.Delete("/:id", middleware.RequirePermission(r.authz, "delete_service"), r.handler.Delete)
+	categories.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_service"), r.handler.Purge)
 }
 
 // NewRoutes creates a new Routes instance.
-func NewRoutes(h *handler.Handler) *Routes {
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: h,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
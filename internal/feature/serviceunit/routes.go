@@ -4,13 +4,18 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/serviceunit/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -18,18 +23,20 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("service-units")
 
-	group.Use(middleware.CheckAuth(r.config))
-	group.Post("/", r.handler.Create)
-	group.Get("/", r.handler.List)
-	group.Get("/:id", r.handler.Get)
-	group.Put("/:id", r.handler.Update)
-	group.Delete("/:id", r.handler.Delete)
-	group.Delete("/:id/purge", r.handler.Purge)
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+	group.Post("/", middleware.RequirePermission(r.authz, "create_service"), r.handler.Create)
+	group.Get("/", middleware.RequirePermission(r.authz, "view_service"), r.handler.List)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_service"), r.handler.Get)
+	group.Put("/:id", middleware.RequirePermission(r.authz, "update_service"), r.handler.Update)
+	group.Delete("/:id", middleware.RequirePermission(r.authz, "delete_service"), r.handler.Delete)
+	group.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_service"), r.handler.Purge)
 }
 
-func NewRoutes(h *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: h,
-		config:  config,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
@@ -4,13 +4,18 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/subscription/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -18,20 +23,22 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	group := router.Group("subscriptions")
 
-	group.Use(middleware.CheckAuth(r.config))
-	group.Post("/", r.handler.Create)
-	group.Get("/", r.handler.List)
-	group.Get("/:id", r.handler.Get)
-	group.Put("/:id", r.handler.Update)
-	group.Delete("/:id", r.handler.Delete)
-	group.Delete("/:id/purge", r.handler.Purge)
-	group.Patch("/:id/status/:status", r.handler.UpdateStatus)
-	group.Patch("/:id/restore", r.handler.Restore)
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+	group.Post("/", middleware.RequirePermission(r.authz, "create_subscription"), r.handler.Create)
+	group.Get("/", middleware.RequirePermission(r.authz, "view_subscription"), r.handler.List)
+	group.Get("/:id", middleware.RequirePermission(r.authz, "view_subscription"), r.handler.Get)
+	group.Put("/:id", middleware.RequirePermission(r.authz, "update_subscription"), r.handler.Update)
+	group.Delete("/:id", middleware.RequirePermission(r.authz, "delete_subscription"), r.handler.Delete)
+	group.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_subscription"), r.handler.Purge)
+	group.Patch("/:id/status/:status", middleware.RequirePermission(r.authz, "update_subscription"), r.handler.UpdateStatus)
+	group.Patch("/:id/restore", middleware.RequirePermission(r.authz, "update_subscription"), r.handler.Restore)
 }
 
-func NewRoutes(h *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: h,
-		config:  config,
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
@@ -5,7 +5,11 @@ import (
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/tenant/domain"
 	"github.com/umardev500/laundry/internal/feature/tenant/query"
+	"github.com/umardev500/laundry/pkg/ordercode"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/qris"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type Service interface {
@@ -15,6 +19,10 @@ type Service interface {
 	GetByEmail(ctx *appctx.Context, email string) (*domain.Tenant, error)
 	Update(ctx *appctx.Context, tenant *domain.Tenant) (*domain.Tenant, error)
 	UpdateStatus(ctx *appctx.Context, tenant *domain.Tenant) (*domain.Tenant, error)
+	UpdateQRISMerchant(ctx *appctx.Context, id uuid.UUID, merchant *qris.Merchant) (*domain.Tenant, error)
+	UpdateTaxSettings(ctx *appctx.Context, id uuid.UUID, settings tax.Settings) (*domain.Tenant, error)
+	UpdateBusinessCalendar(ctx *appctx.Context, id uuid.UUID, calendar turnaround.Calendar) (*domain.Tenant, error)
+	UpdateOrderCodeFormat(ctx *appctx.Context, id uuid.UUID, format ordercode.Format) (*domain.Tenant, error)
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 	Purge(ctx *appctx.Context, id uuid.UUID) error
 }
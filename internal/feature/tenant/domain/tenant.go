@@ -6,6 +6,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/pkg/errorsx"
+	"github.com/umardev500/laundry/pkg/ordercode"
+	"github.com/umardev500/laundry/pkg/qris"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 )
 
@@ -15,6 +19,10 @@ type Tenant struct {
 	Phone     string
 	Email     string
 	Status    types.TenantStatus
+	QRIS      *qris.Merchant      // nil until the tenant registers for QRIS
+	Tax       tax.Settings        // PPN and service charge on orders
+	Calendar  turnaround.Calendar // business hours and holidays order due dates are worked out with
+	OrderCode ordercode.Format    // how the tenant's order codes look
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -30,6 +38,53 @@ func (t *Tenant) Update(name, email string) {
 	}
 }
 
+// SetQRISMerchant validates and stores the tenant's QRIS merchant registration.
+func (t *Tenant) SetQRISMerchant(m *qris.Merchant) error {
+	m.Normalize()
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	t.QRIS = m
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetTaxSettings validates and stores the tax rules applied to the tenant's orders.
+func (t *Tenant) SetTaxSettings(s tax.Settings) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	t.Tax = s
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetOrderCodeFormat validates and stores how the tenant's order codes look.
+func (t *Tenant) SetOrderCodeFormat(f ordercode.Format) error {
+	f.Normalize()
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	t.OrderCode = f
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetBusinessCalendar validates and stores the business hours and holidays
+// order due dates are worked out with.
+func (t *Tenant) SetBusinessCalendar(c turnaround.Calendar) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	t.Calendar = c
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
 // SoftDelete marks a tenant as deleted without removing the record.
 func (t *Tenant) SoftDelete() {
 	now := time.Now().UTC()
@@ -2,6 +2,8 @@ package dto
 
 import (
 	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/ordercode"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type TenantResponse struct {
@@ -11,4 +13,30 @@ type TenantResponse struct {
 	Phone   string    `json:"phone,omitempty"`
 	Address string    `json:"address,omitempty"`
 	Status  string    `json:"status"`
+
+	QRIS *QRISMerchantResponse `json:"qris,omitempty"`
+	Tax  TaxSettingsResponse   `json:"tax"`
+
+	BusinessCalendar turnaround.Calendar     `json:"business_calendar"`
+	OrderCode        OrderCodeFormatResponse `json:"order_code"`
+}
+
+type OrderCodeFormatResponse struct {
+	Prefix string          `json:"prefix"`
+	Reset  ordercode.Reset `json:"reset"`
+}
+
+type QRISMerchantResponse struct {
+	MerchantName string `json:"merchant_name"`
+	MerchantCity string `json:"merchant_city"`
+	PostalCode   string `json:"postal_code,omitempty"`
+	NMID         string `json:"nmid"`
+	MCC          string `json:"mcc"`
+	Criteria     string `json:"criteria"`
+}
+
+type TaxSettingsResponse struct {
+	TaxRate           float64 `json:"tax_rate"`
+	TaxInclusive      bool    `json:"tax_inclusive"`
+	ServiceChargeRate float64 `json:"service_charge_rate"`
 }
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/turnaround"
+
+// UpdateBusinessCalendarRequest sets the business hours and holidays order due
+// dates are worked out with. Without hours the tenant is open around the clock.
+type UpdateBusinessCalendarRequest struct {
+	Timezone string                     `json:"timezone,omitempty" validate:"omitempty,max=64"`
+	Hours    []turnaround.BusinessHours `json:"hours,omitempty" validate:"omitempty,max=7"`
+	Holidays []string                   `json:"holidays,omitempty" validate:"omitempty,max=366,dive,datetime=2006-01-02"`
+}
+
+func (r *UpdateBusinessCalendarRequest) ToDomain() turnaround.Calendar {
+	return turnaround.Calendar{
+		Timezone: r.Timezone,
+		Hours:    r.Hours,
+		Holidays: r.Holidays,
+	}
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/ordercode"
+
+// UpdateOrderCodeFormatRequest sets how the tenant's order codes look, e.g.
+// prefix LDR with a monthly reset gives LDR-2610-000123.
+type UpdateOrderCodeFormatRequest struct {
+	Prefix string          `json:"prefix" validate:"required,alphanum,max=10"`
+	Reset  ordercode.Reset `json:"reset" validate:"required,oneof=monthly yearly"`
+}
+
+func (r *UpdateOrderCodeFormatRequest) ToDomain() ordercode.Format {
+	return ordercode.Format{
+		Prefix: r.Prefix,
+		Reset:  r.Reset,
+	}
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/qris"
+
+// UpdateQRISMerchantRequest registers the merchant details printed in QRIS codes.
+type UpdateQRISMerchantRequest struct {
+	MerchantName string `json:"merchant_name" validate:"required,max=25"`
+	MerchantCity string `json:"merchant_city" validate:"required,max=15"`
+	PostalCode   string `json:"postal_code,omitempty" validate:"omitempty,numeric,len=5"`
+	NMID         string `json:"nmid" validate:"required"`
+	MCC          string `json:"mcc,omitempty" validate:"omitempty,numeric,len=4"`
+	Criteria     string `json:"criteria,omitempty" validate:"omitempty,oneof=UMI UKE UME UBE"`
+}
+
+func (r *UpdateQRISMerchantRequest) ToDomain() *qris.Merchant {
+	return &qris.Merchant{
+		Name:       r.MerchantName,
+		City:       r.MerchantCity,
+		PostalCode: r.PostalCode,
+		NMID:       r.NMID,
+		MCC:        r.MCC,
+		Criteria:   r.Criteria,
+	}
+}
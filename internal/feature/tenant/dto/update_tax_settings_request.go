@@ -0,0 +1,18 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/tax"
+
+// UpdateTaxSettingsRequest sets the PPN and service charge applied to the tenant's orders.
+type UpdateTaxSettingsRequest struct {
+	TaxRate           float64 `json:"tax_rate" validate:"gte=0,lte=100"`
+	TaxInclusive      bool    `json:"tax_inclusive"`
+	ServiceChargeRate float64 `json:"service_charge_rate" validate:"gte=0,lte=100"`
+}
+
+func (r *UpdateTaxSettingsRequest) ToDomain() tax.Settings {
+	return tax.Settings{
+		Rate:              r.TaxRate,
+		Inclusive:         r.TaxInclusive,
+		ServiceChargeRate: r.ServiceChargeRate,
+	}
+}
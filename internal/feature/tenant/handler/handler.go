@@ -96,6 +96,106 @@ func (h *Handler) UpdateStatus(c *fiber.Ctx) error {
 	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantResponse(result))
 }
 
+// 🏷️ UpdateQRISMerchant registers the tenant's QRIS merchant details
+func (h *Handler) UpdateQRISMerchant(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.UpdateQRISMerchantRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.UpdateQRISMerchant(ctx, id, req.ToDomain())
+	if err != nil {
+		return handleTenantError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantResponse(result))
+}
+
+// 🧾 UpdateTaxSettings sets the PPN and service charge applied to the tenant's orders
+func (h *Handler) UpdateTaxSettings(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.UpdateTaxSettingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.UpdateTaxSettings(ctx, id, req.ToDomain())
+	if err != nil {
+		return handleTenantError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantResponse(result))
+}
+
+// 🕘 UpdateBusinessCalendar sets the business hours and holidays order due dates are worked out with
+func (h *Handler) UpdateBusinessCalendar(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.UpdateBusinessCalendarRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.UpdateBusinessCalendar(ctx, id, req.ToDomain())
+	if err != nil {
+		return handleTenantError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantResponse(result))
+}
+
+// 🔢 UpdateOrderCodeFormat sets the prefix and reset of the tenant's order codes
+func (h *Handler) UpdateOrderCodeFormat(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	var req dto.UpdateOrderCodeFormatRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.UpdateOrderCodeFormat(ctx, id, req.ToDomain())
+	if err != nil {
+		return handleTenantError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantResponse(result))
+}
+
 // 🗑️ Soft Delete a Tenant
 func (h *Handler) Delete(c *fiber.Ctx) error {
 
@@ -6,6 +6,10 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/feature/tenant/domain"
 	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/ordercode"
+	"github.com/umardev500/laundry/pkg/qris"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 	"github.com/umardev500/laundry/pkg/types"
 
 	errorsPkg "github.com/umardev500/laundry/pkg/errorsx"
@@ -33,6 +37,24 @@ func handleTenantError(c *fiber.Ctx, err error) error {
 	case errors.Is(err, domain.ErrTenantAlreadyExists):
 		return httpx.Conflict(c, err.Error())
 
+	case errors.Is(err, qris.ErrMerchantNameRequired),
+		errors.Is(err, qris.ErrMerchantNameTooLong),
+		errors.Is(err, qris.ErrMerchantCityRequired),
+		errors.Is(err, qris.ErrMerchantCityTooLong),
+		errors.Is(err, qris.ErrInvalidNMID),
+		errors.Is(err, qris.ErrInvalidMCC),
+		errors.Is(err, qris.ErrInvalidPostalCode),
+		errors.Is(err, qris.ErrInvalidCriteria),
+		errors.Is(err, tax.ErrInvalidRate),
+		errors.Is(err, tax.ErrInvalidServiceChargeRate),
+		errors.Is(err, turnaround.ErrInvalidTimezone),
+		errors.Is(err, turnaround.ErrInvalidBusinessHours),
+		errors.Is(err, turnaround.ErrDuplicateWeekday),
+		errors.Is(err, turnaround.ErrInvalidHoliday),
+		errors.Is(err, ordercode.ErrInvalidPrefix),
+		errors.Is(err, ordercode.ErrInvalidReset):
+		return httpx.BadRequest(c, err.Error())
+
 	case errors.Is(err, types.ErrStatusUnchanged):
 		return httpx.JSONWithMessage[any](c, fiber.StatusOK, nil, err.Error())
 
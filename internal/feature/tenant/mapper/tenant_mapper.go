@@ -4,8 +4,12 @@ import (
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/internal/feature/tenant/domain"
 	"github.com/umardev500/laundry/internal/feature/tenant/dto"
+	"github.com/umardev500/laundry/pkg/ordercode"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/qris"
+	"github.com/umardev500/laundry/pkg/tax"
 	"github.com/umardev500/laundry/pkg/types"
+	"github.com/umardev500/laundry/pkg/utils/deref"
 )
 
 func FromEntModel(e *ent.Tenant) *domain.Tenant {
@@ -13,11 +17,22 @@ func FromEntModel(e *ent.Tenant) *domain.Tenant {
 		return nil
 	}
 	return &domain.Tenant{
-		ID:        e.ID,
-		Name:      e.Name,
-		Email:     e.Email,
-		Phone:     e.Phone,
-		Status:    types.TenantStatus(*e.Status),
+		ID:     e.ID,
+		Name:   e.Name,
+		Email:  e.Email,
+		Phone:  e.Phone,
+		Status: types.TenantStatus(*e.Status),
+		QRIS:   qrisMerchantFromEnt(e),
+		Tax: tax.Settings{
+			Rate:              e.TaxRate,
+			Inclusive:         e.TaxInclusive,
+			ServiceChargeRate: e.ServiceChargeRate,
+		},
+		Calendar: e.BusinessCalendar,
+		OrderCode: ordercode.Format{
+			Prefix: e.OrderCodePrefix,
+			Reset:  ordercode.Reset(e.OrderCodeReset),
+		},
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
 		DeletedAt: e.DeletedAt,
@@ -44,6 +59,49 @@ func ToTenantResponse(d *domain.Tenant) *dto.TenantResponse {
 		Email:  d.Email,
 		Phone:  d.Phone,
 		Status: string(d.Status),
+		QRIS:   ToQRISMerchantResponse(d.QRIS),
+		Tax: dto.TaxSettingsResponse{
+			TaxRate:           d.Tax.Rate,
+			TaxInclusive:      d.Tax.Inclusive,
+			ServiceChargeRate: d.Tax.ServiceChargeRate,
+		},
+		BusinessCalendar: d.Calendar,
+		OrderCode: dto.OrderCodeFormatResponse{
+			Prefix: d.OrderCode.Prefix,
+			Reset:  d.OrderCode.Reset,
+		},
+	}
+}
+
+// ToQRISMerchantResponse maps the QRIS merchant registration of a tenant.
+func ToQRISMerchantResponse(m *qris.Merchant) *dto.QRISMerchantResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &dto.QRISMerchantResponse{
+		MerchantName: m.Name,
+		MerchantCity: m.City,
+		PostalCode:   m.PostalCode,
+		NMID:         m.NMID,
+		MCC:          m.MCC,
+		Criteria:     m.Criteria,
+	}
+}
+
+// qrisMerchantFromEnt returns the tenant's QRIS registration, or nil when there is none.
+func qrisMerchantFromEnt(e *ent.Tenant) *qris.Merchant {
+	if e.QrisNmid == nil {
+		return nil
+	}
+
+	return &qris.Merchant{
+		Name:       deref.String(e.QrisMerchantName),
+		City:       deref.String(e.QrisMerchantCity),
+		PostalCode: deref.String(e.QrisPostalCode),
+		NMID:       *e.QrisNmid,
+		MCC:        deref.String(e.QrisMcc),
+		Criteria:   deref.String(e.QrisCriteria),
 	}
 }
 
@@ -61,14 +61,31 @@ func (e *entImpl) FindByEmail(ctx *appctx.Context, email string) (*domain.Tenant
 
 func (e *entImpl) Update(ctx *appctx.Context, t *domain.Tenant) (*domain.Tenant, error) {
 	conn := e.client.GetConn(ctx)
-	entTenant, err := conn.Tenant.
+	builder := conn.Tenant.
 		UpdateOneID(t.ID).
 		SetName(t.Name).
 		SetEmail(t.Email).
 		SetPhone(t.Phone).
 		SetStatus(tenant.Status(t.Status)).
-		SetNillableDeletedAt(t.DeletedAt).
-		Save(ctx)
+		SetTaxRate(t.Tax.Rate).
+		SetTaxInclusive(t.Tax.Inclusive).
+		SetServiceChargeRate(t.Tax.ServiceChargeRate).
+		SetBusinessCalendar(t.Calendar).
+		SetOrderCodePrefix(t.OrderCode.Prefix).
+		SetOrderCodeReset(tenant.OrderCodeReset(t.OrderCode.Reset)).
+		SetNillableDeletedAt(t.DeletedAt)
+
+	if t.QRIS != nil {
+		builder.
+			SetQrisMerchantName(t.QRIS.Name).
+			SetQrisMerchantCity(t.QRIS.City).
+			SetQrisPostalCode(t.QRIS.PostalCode).
+			SetQrisNmid(t.QRIS.NMID).
+			SetQrisMcc(t.QRIS.MCC).
+			SetQrisCriteria(t.QRIS.Criteria)
+	}
+
+	entTenant, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -4,14 +4,19 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/tenant/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 // Routes holds the handler for tenant endpoints.
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 // Ensure Routes implements the RouteRegistrar interface
@@ -21,19 +26,25 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	t := router.Group("tenants")
 
-	t.Use(middleware.CheckAuth(r.config))
-	t.Post("/", r.handler.Create)                          // Create a new tenant
-	t.Get("/", r.handler.List)                             // List tenants (with pagination, filters)
-	t.Get("/:id", r.handler.Get)                           // Get tenant by ID
-	t.Delete("/:id", r.handler.Delete)                     // Soft delete
-	t.Delete("/:id/purge", r.handler.Purge)                // Hard delete
-	t.Patch("/:id/status/:status", r.handler.UpdateStatus) // Update tenant status
+	t.Use(middleware.CheckAuth(r.keys, r.sessions))
+	t.Post("/", middleware.RequirePermission(r.authz, "create_tenant"), r.handler.Create)                                  // Create a new tenant
+	t.Get("/", middleware.RequirePermission(r.authz, "view_tenant"), r.handler.List)                                       // List tenants (with pagination, filters)
+	t.Get("/:id", middleware.RequirePermission(r.authz, "view_tenant"), r.handler.Get)                                     // Get tenant by ID
+	t.Delete("/:id", middleware.RequirePermission(r.authz, "delete_tenant"), r.handler.Delete)                             // Soft delete
+	t.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_tenant"), r.handler.Purge)                        // Hard delete
+	t.Patch("/:id/status/:status", middleware.RequirePermission(r.authz, "update_tenant"), r.handler.UpdateStatus)         // Update tenant status
+	t.Put("/:id/qris", middleware.RequirePermission(r.authz, "update_tenant"), r.handler.UpdateQRISMerchant)               // Set QRIS merchant details
+	t.Put("/:id/tax", middleware.RequirePermission(r.authz, "update_tenant"), r.handler.UpdateTaxSettings)                 // Set PPN and service charge
+	t.Put("/:id/business-hours", middleware.RequirePermission(r.authz, "update_tenant"), r.handler.UpdateBusinessCalendar) // Set business hours and holidays
+	t.Put("/:id/order-code", middleware.RequirePermission(r.authz, "update_tenant"), r.handler.UpdateOrderCodeFormat)      // Set order code prefix and reset
 }
 
 // NewRoutes creates a new tenant routes instance.
-func NewRoutes(handler *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(handler *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: handler,
-		config:  config,
+		handler:  handler,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
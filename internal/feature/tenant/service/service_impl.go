@@ -8,7 +8,11 @@ import (
 	"github.com/umardev500/laundry/internal/feature/tenant/domain"
 	"github.com/umardev500/laundry/internal/feature/tenant/query"
 	"github.com/umardev500/laundry/internal/feature/tenant/repository"
+	"github.com/umardev500/laundry/pkg/ordercode"
 	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/qris"
+	"github.com/umardev500/laundry/pkg/tax"
+	"github.com/umardev500/laundry/pkg/turnaround"
 )
 
 type serviceImpl struct {
@@ -68,6 +72,58 @@ func (s *serviceImpl) UpdateStatus(ctx *appctx.Context, t *domain.Tenant) (*doma
 	return s.repo.Update(ctx, tenant)
 }
 
+func (s *serviceImpl) UpdateQRISMerchant(ctx *appctx.Context, id uuid.UUID, m *qris.Merchant) (*domain.Tenant, error) {
+	tenant, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.SetQRISMerchant(m); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, tenant)
+}
+
+func (s *serviceImpl) UpdateTaxSettings(ctx *appctx.Context, id uuid.UUID, settings tax.Settings) (*domain.Tenant, error) {
+	tenant, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.SetTaxSettings(settings); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, tenant)
+}
+
+func (s *serviceImpl) UpdateBusinessCalendar(ctx *appctx.Context, id uuid.UUID, calendar turnaround.Calendar) (*domain.Tenant, error) {
+	tenant, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.SetBusinessCalendar(calendar); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, tenant)
+}
+
+func (s *serviceImpl) UpdateOrderCodeFormat(ctx *appctx.Context, id uuid.UUID, format ordercode.Format) (*domain.Tenant, error) {
+	tenant, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.SetOrderCodeFormat(format); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, tenant)
+}
+
 func (s *serviceImpl) Delete(ctx *appctx.Context, id uuid.UUID) error {
 	tenant, err := s.findExisting(ctx, id)
 	if err != nil {
@@ -14,6 +14,7 @@ type Service interface {
 	GetByUser(ctx *appctx.Context, userID uuid.UUID) ([]*domain.TenantUser, error)
 	List(ctx *appctx.Context, q *query.ListTenantUserQuery) (*pagination.PageData[domain.TenantUser], error)
 	UpdateStatus(ctx *appctx.Context, tu *domain.TenantUser) (*domain.TenantUser, error)
+	AssignRole(ctx *appctx.Context, id, roleID uuid.UUID) (*domain.TenantUser, error)
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 	Purge(ctx *appctx.Context, id uuid.UUID) error
 }
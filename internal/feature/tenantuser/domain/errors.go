@@ -10,4 +10,5 @@ var (
 	ErrInvalidStatusTransition = fmt.Errorf("invalid status transition")
 	ErrTenantIDMismatch        = fmt.Errorf("tenant ID mismatch: cannot create tenant user for another tenant")
 	ErrUnauthorizedUserAccess  = fmt.Errorf("unauthorized access to user")
+	ErrRoleTenantMismatch      = fmt.Errorf("role does not belong to the tenant user's tenant")
 )
@@ -13,6 +13,7 @@ type TenantUser struct {
 	ID        uuid.UUID
 	UserID    uuid.UUID
 	TenantID  uuid.UUID
+	RoleID    *uuid.UUID
 	Status    types.TenantUserStatus
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -69,6 +70,17 @@ func (tu *TenantUser) SetStatus(status types.TenantUserStatus) error {
 	return nil
 }
 
+// AssignRole sets the member's role. The role must be owned by the same tenant.
+func (tu *TenantUser) AssignRole(roleID uuid.UUID, roleTenantID *uuid.UUID) error {
+	if roleTenantID == nil || *roleTenantID != tu.TenantID {
+		return ErrRoleTenantMismatch
+	}
+
+	tu.RoleID = &roleID
+	tu.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
 // BelongsToTenant checks whether the machine belongs to the tenant in context.
 // Returns true if scope is platform or tenant IDs match.
 func (r *TenantUser) BelongsToTenant(ctx *appctx.Context) bool {
@@ -11,6 +11,7 @@ import (
 type CreateTenantUserRequest struct {
 	UserID   uuid.UUID              `json:"user_id" validate:"required"`
 	TenantID *uuid.UUID             `json:"tenant_id,omitempty"`
+	RoleID   *uuid.UUID             `json:"role_id,omitempty"`
 	Status   types.TenantUserStatus `json:"status" validate:"omitempty,oneof=active suspended deleted"`
 }
 
@@ -29,6 +30,7 @@ func (r *CreateTenantUserRequest) ToDomain(ctx *appctx.Context) *domain.TenantUs
 	return &domain.TenantUser{
 		UserID:   r.UserID,
 		TenantID: *tenantID,
+		RoleID:   r.RoleID,
 		Status:   status,
 	}
 }
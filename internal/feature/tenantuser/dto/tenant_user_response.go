@@ -11,6 +11,7 @@ type TenantUserResponse struct {
 	ID        uuid.UUID              `json:"id"`
 	UserID    uuid.UUID              `json:"user_id"`
 	TenantID  uuid.UUID              `json:"tenant_id"`
+	RoleID    *uuid.UUID             `json:"role_id,omitempty"`
 	Status    types.TenantUserStatus `json:"status"`
 	CreatedAt time.Time              `json:"created_at"`
 	UpdatedAt time.Time              `json:"updated_at"`
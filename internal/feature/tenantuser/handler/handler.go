@@ -105,6 +105,27 @@ func (h *Handler) UpdateStatus(c *fiber.Ctx) error {
 	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantUserResponse(result))
 }
 
+// 🛡️ Assign a tenant role to a tenant user
+func (h *Handler) AssignRole(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid id")
+	}
+
+	roleID, err := uuid.Parse(c.Params("role_id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid role id")
+	}
+
+	ctx := appctx.New(c.UserContext())
+	result, err := h.service.AssignRole(ctx, id, roleID)
+	if err != nil {
+		return handleTenantUserError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToTenantUserResponse(result))
+}
+
 // 🗑️ Soft delete a tenant user
 func (h *Handler) Delete(c *fiber.Ctx) error {
 	var q pkgQuery.GetByIDQuery
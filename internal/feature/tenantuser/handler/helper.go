@@ -8,6 +8,7 @@ import (
 	"github.com/umardev500/laundry/pkg/httpx"
 	"github.com/umardev500/laundry/pkg/types"
 
+	rbacDomain "github.com/umardev500/laundry/internal/feature/rbac/domain"
 	errorsPkg "github.com/umardev500/laundry/pkg/errorsx"
 )
 
@@ -27,17 +28,21 @@ func handleTenantUserError(c *fiber.Ctx, err error) error {
 		)
 
 	case errors.Is(err, domain.ErrTenantUserDeleted),
-		errors.Is(err, domain.ErrUnauthorizedUserAccess):
+		errors.Is(err, domain.ErrUnauthorizedUserAccess),
+		errors.Is(err, rbacDomain.ErrUnauthorizedRoleAccess),
+		errors.Is(err, rbacDomain.ErrRoleDeleted):
 		return httpx.Forbidden(c, err.Error())
 
 	case errors.Is(err, domain.ErrTenantUserNotFound),
-		errors.Is(err, domain.ErrTenantOrUserNotFound):
+		errors.Is(err, domain.ErrTenantOrUserNotFound),
+		errors.Is(err, rbacDomain.ErrRoleNotFound):
 		return httpx.NotFound(c, err.Error())
 
 	case errors.Is(err, domain.ErrTenantUserAlreadyExists):
 		return httpx.Conflict(c, err.Error())
 
-	case errors.Is(err, domain.ErrTenantIDMismatch):
+	case errors.Is(err, domain.ErrTenantIDMismatch),
+		errors.Is(err, domain.ErrRoleTenantMismatch):
 		return httpx.BadRequest(c, err.Error())
 
 	case errors.Is(err, domain.ErrInvalidStatusTransition):
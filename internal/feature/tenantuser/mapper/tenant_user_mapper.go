@@ -18,6 +18,7 @@ func FromEntModel(e *ent.TenantUser) *domain.TenantUser {
 		ID:        e.ID,
 		UserID:    e.UserID,
 		TenantID:  e.TenantID,
+		RoleID:    e.RoleID,
 		Status:    types.TenantUserStatus(*e.Status),
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
@@ -46,6 +47,7 @@ func ToTenantUserResponse(d *domain.TenantUser) *dto.TenantUserResponse {
 		ID:        d.ID,
 		UserID:    d.UserID,
 		TenantID:  d.TenantID,
+		RoleID:    d.RoleID,
 		Status:    d.Status,
 		CreatedAt: d.CreatedAt,
 		UpdatedAt: d.UpdatedAt,
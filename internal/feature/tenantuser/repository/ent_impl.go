@@ -35,6 +35,7 @@ func (r *entImpl) Create(ctx *appctx.Context, tu *domain.TenantUser) (*domain.Te
 	entTenantUser, err := conn.TenantUser.Create().
 		SetUserID(tu.UserID).
 		SetTenantID(tu.TenantID).
+		SetNillableRoleID(tu.RoleID).
 		SetStatus(tenantuser.Status(tu.Status)).
 		Save(ctx)
 	if err != nil {
@@ -155,6 +156,7 @@ func (r *entImpl) Update(ctx *appctx.Context, tu *domain.TenantUser) (*domain.Te
 
 	entTenantUser, err := conn.TenantUser.
 		UpdateOneID(tu.ID).
+		SetNillableRoleID(tu.RoleID).
 		SetStatus(tenantuser.Status(tu.Status)).
 		SetUpdatedAt(tu.UpdatedAt).
 		SetNillableDeletedAt(tu.DeletedAt).
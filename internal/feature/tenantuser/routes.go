@@ -4,13 +4,18 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
-	"github.com/umardev500/laundry/internal/config"
 	"github.com/umardev500/laundry/internal/feature/tenantuser/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
-	config  *config.Config
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 var _ router.RouteRegistrar = (*Routes)(nil)
@@ -18,18 +23,21 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	tu := router.Group("tenant-users")
 
-	tu.Use(middleware.CheckAuth(r.config))
-	tu.Get("/", r.handler.List)
-	tu.Post("/", r.handler.Create)
-	tu.Get("/:id", r.handler.Get)
-	tu.Patch("/:id/status/:status", r.handler.UpdateStatus)
-	tu.Delete("/:id", r.handler.Delete)
-	tu.Delete("/:id/purge", r.handler.Purge)
+	tu.Use(middleware.CheckAuth(r.keys, r.sessions))
+	tu.Get("/", middleware.RequirePermission(r.authz, "view_tenant_user"), r.handler.List)
+	tu.Post("/", middleware.RequirePermission(r.authz, "create_tenant_user"), r.handler.Create)
+	tu.Get("/:id", middleware.RequirePermission(r.authz, "view_tenant_user"), r.handler.Get)
+	tu.Patch("/:id/status/:status", middleware.RequirePermission(r.authz, "update_tenant_user"), r.handler.UpdateStatus)
+	tu.Patch("/:id/role/:role_id", middleware.RequirePermission(r.authz, "update_tenant_user"), r.handler.AssignRole)
+	tu.Delete("/:id", middleware.RequirePermission(r.authz, "delete_tenant_user"), r.handler.Delete)
+	tu.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_tenant_user"), r.handler.Purge)
 }
 
-func NewRoutes(handler *handler.Handler, config *config.Config) *Routes {
+func NewRoutes(handler *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: handler,
-		config:  config,
+		handler:  handler,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
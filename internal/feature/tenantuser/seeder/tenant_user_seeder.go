@@ -32,18 +32,21 @@ func (s *TenantUserSeeder) Seed(ctx context.Context) error {
 		ID       uuid.UUID
 		TenantID uuid.UUID
 		UserID   uuid.UUID
+		RoleID   uuid.UUID
 		Status   types.Status
 	}{
 		{
 			ID:       uuid.MustParse("11111111-1111-1111-1111-111111111111"),
 			TenantID: uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
 			UserID:   uuid.MustParse("33333333-1111-1111-1111-111111111111"),
+			RoleID:   uuid.MustParse("44444444-4444-4444-4444-444444444444"),
 			Status:   types.Status(types.TenantUserStatusActive),
 		},
 		{
 			ID:       uuid.MustParse("22222222-2222-2222-2222-222222222222"),
 			TenantID: uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
 			UserID:   uuid.MustParse("22222222-1111-1111-1111-111111111111"),
+			RoleID:   uuid.MustParse("66666666-6666-6666-6666-666666666666"),
 			Status:   types.Status(types.TenantUserStatusActive),
 		},
 	}
@@ -54,6 +57,7 @@ func (s *TenantUserSeeder) Seed(ctx context.Context) error {
 			SetID(d.ID).
 			SetTenantID(d.TenantID).
 			SetUserID(d.UserID).
+			SetRoleID(d.RoleID).
 			SetStatus(tenantuser.Status(d.Status)).
 			OnConflict(
 				sql.ConflictColumns(tenantuser.FieldTenantID, tenantuser.FieldUserID),
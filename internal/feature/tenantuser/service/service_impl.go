@@ -9,14 +9,20 @@ import (
 	"github.com/umardev500/laundry/internal/feature/tenantuser/query"
 	"github.com/umardev500/laundry/internal/feature/tenantuser/repository"
 	"github.com/umardev500/laundry/pkg/pagination"
+
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type serviceImpl struct {
-	repo repository.Repository
+	repo        repository.Repository
+	roleService rbacContract.Service
 }
 
-func NewService(repo repository.Repository) contract.Service {
-	return &serviceImpl{repo: repo}
+func NewService(repo repository.Repository, roleService rbacContract.Service) contract.Service {
+	return &serviceImpl{
+		repo:        repo,
+		roleService: roleService,
+	}
 }
 
 // Create creates a tenant-user mapping if it doesn't already exist.
@@ -30,6 +36,12 @@ func (s *serviceImpl) Create(ctx *appctx.Context, tu *domain.TenantUser) (*domai
 		return nil, domain.ErrTenantUserAlreadyExists
 	}
 
+	if tu.RoleID != nil {
+		if err := s.assignRole(ctx, tu, *tu.RoleID); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.repo.Create(ctx, tu)
 }
 
@@ -59,6 +71,20 @@ func (s *serviceImpl) UpdateStatus(ctx *appctx.Context, tu *domain.TenantUser) (
 	return s.repo.Update(ctx, existing)
 }
 
+// AssignRole implements contract.Service.
+func (s *serviceImpl) AssignRole(ctx *appctx.Context, id, roleID uuid.UUID) (*domain.TenantUser, error) {
+	existing, err := s.findExisting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.assignRole(ctx, existing, roleID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, existing)
+}
+
 func (s *serviceImpl) Delete(ctx *appctx.Context, id uuid.UUID) error {
 	tu, err := s.findExisting(ctx, id)
 	if err != nil {
@@ -79,6 +105,16 @@ func (s *serviceImpl) Purge(ctx *appctx.Context, id uuid.UUID) error {
 	return s.repo.Delete(ctx, tu.ID)
 }
 
+// assignRole loads the role and attaches it to the tenant user when it is owned by the same tenant.
+func (s *serviceImpl) assignRole(ctx *appctx.Context, tu *domain.TenantUser, roleID uuid.UUID) error {
+	role, err := s.roleService.GetByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	return tu.AssignRole(role.ID, role.TenantID)
+}
+
 func (s *serviceImpl) findExisting(ctx *appctx.Context, id uuid.UUID) (*domain.TenantUser, error) {
 	tu, err := s.repo.FindByID(ctx, id)
 	if err != nil {
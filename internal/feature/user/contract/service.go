@@ -1,6 +1,8 @@
 package contract
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/internal/app/appctx"
 	"github.com/umardev500/laundry/internal/feature/user/domain"
@@ -27,6 +29,9 @@ type Service interface {
 	// UpdateStatus updates the status of a user
 	UpdateStatus(ctx *appctx.Context, user *domain.User) (*domain.User, error)
 
+	// Lock temporarily locks a user until the given time
+	Lock(ctx *appctx.Context, id uuid.UUID, until time.Time) (*domain.User, error)
+
 	// Soft delete(or hard delete, depending on repo) a user by ID
 	Delete(ctx *appctx.Context, id uuid.UUID) error
 
@@ -9,13 +9,14 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID
-	Email     string
-	Password  string
-	Status    types.UserStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	ID          uuid.UUID
+	Email       string
+	Password    string
+	Status      types.UserStatus
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
 }
 
 // Update updates the email and password of a user.
@@ -56,7 +57,33 @@ func (u *User) SetStatus(status types.UserStatus) error {
 		)
 	}
 
+	if status != types.UserStatusLocked {
+		u.LockedUntil = nil
+	}
+
 	u.Status = status
 	u.UpdatedAt = time.Now().UTC()
 	return nil
 }
+
+// Lock temporarily locks the account until the given time. Locking an
+// already locked account moves its expiry.
+func (u *User) Lock(until time.Time) error {
+	if u.Status != types.UserStatusLocked {
+		if err := u.SetStatus(types.UserStatusLocked); err != nil {
+			return err
+		}
+	}
+
+	u.LockedUntil = &until
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// IsLocked reports whether the account is locked at the given time.
+func (u *User) IsLocked(now time.Time) bool {
+	if u.Status != types.UserStatusLocked {
+		return false
+	}
+	return u.LockedUntil == nil || now.Before(*u.LockedUntil)
+}
@@ -15,13 +15,14 @@ func ToDomainUser(e *ent.User) *domain.User {
 	}
 
 	return &domain.User{
-		ID:        e.ID,
-		Email:     e.Email,
-		Password:  e.Password,
-		Status:    types.UserStatus(*e.Status),
-		CreatedAt: e.CreatedAt,
-		UpdatedAt: e.UpdatedAt,
-		DeletedAt: e.DeletedAt,
+		ID:          e.ID,
+		Email:       e.Email,
+		Password:    e.Password,
+		Status:      types.UserStatus(*e.Status),
+		LockedUntil: e.LockedUntil,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+		DeletedAt:   e.DeletedAt,
 	}
 }
 
@@ -109,13 +109,20 @@ func (e *entImpl) List(ctx *appctx.Context, q *query.ListUserQuery) (*pagination
 func (e *entImpl) Update(ctx *appctx.Context, u *domain.User) (*domain.User, error) {
 	conn := e.client.GetConn(ctx)
 
-	entUser, err := conn.User.
+	builder := conn.User.
 		UpdateOneID(u.ID).
 		SetEmail(u.Email).
 		SetPassword(u.Password).
 		SetStatus(user.Status(u.Status)).
-		SetNillableDeletedAt(u.DeletedAt).
-		Save(ctx)
+		SetNillableDeletedAt(u.DeletedAt)
+
+	if u.LockedUntil != nil {
+		builder.SetLockedUntil(*u.LockedUntil)
+	} else {
+		builder.ClearLockedUntil()
+	}
+
+	entUser, err := builder.Save(ctx)
 
 	if err != nil {
 		return nil, err
@@ -2,12 +2,20 @@ package user
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
 	"github.com/umardev500/laundry/internal/app/router"
 	"github.com/umardev500/laundry/internal/feature/user/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
 )
 
 type Routes struct {
-	handler *handler.Handler
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
 }
 
 // Ensure Routes implements the RouteRegistrar interface
@@ -17,17 +25,21 @@ var _ router.RouteRegistrar = (*Routes)(nil)
 func (r *Routes) RegisterRoutes(router fiber.Router) {
 	user := router.Group("users")
 
-	user.Post("/", r.handler.Create)
-	user.Get("/", r.handler.List)
-	user.Get("/:id", r.handler.GetUser)
-	user.Delete("/:id", r.handler.Delete)
-	user.Delete("/:id/purge", r.handler.Purge)
-	user.Put("/:id", r.handler.Update)
-	user.Patch("/:id/status/:status", r.handler.UpdateStatus)
+	user.Use(middleware.CheckAuth(r.keys, r.sessions))
+	user.Post("/", middleware.RequirePermission(r.authz, "create_user"), r.handler.Create)
+	user.Get("/", middleware.RequirePermission(r.authz, "view_user"), r.handler.List)
+	user.Get("/:id", middleware.RequirePermission(r.authz, "view_user"), r.handler.GetUser)
+	user.Delete("/:id", middleware.RequirePermission(r.authz, "delete_user"), r.handler.Delete)
+	user.Delete("/:id/purge", middleware.RequirePermission(r.authz, "delete_user"), r.handler.Purge)
+	user.Put("/:id", middleware.RequirePermission(r.authz, "update_user"), r.handler.Update)
+	user.Patch("/:id/status/:status", middleware.RequirePermission(r.authz, "update_user"), r.handler.UpdateStatus)
 }
 
-func NewRoutes(handler *handler.Handler) *Routes {
+func NewRoutes(handler *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
 	return &Routes{
-		handler: handler,
+		handler:  handler,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
 	}
 }
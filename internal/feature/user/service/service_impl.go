@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/umardev500/laundry/ent"
 	"github.com/umardev500/laundry/internal/app/appctx"
@@ -89,6 +91,20 @@ func (s *serviceImpl) UpdateStatus(ctx *appctx.Context, u *domain.User) (*domain
 	return s.repo.Update(ctx, user)
 }
 
+// Lock implements contract.Service.
+func (s *serviceImpl) Lock(ctx *appctx.Context, id uuid.UUID, until time.Time) (*domain.User, error) {
+	user, err := s.findExistingUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.Lock(until); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, user)
+}
+
 // Purge implements contract.Service.
 func (s *serviceImpl) Purge(ctx *appctx.Context, id uuid.UUID) error {
 	user, err := s.findAllowDeleted(ctx, id)
@@ -0,0 +1,36 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/internal/feature/wallet/query"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// Service defines the business logic for customer wallets. Every balance
+// change is written to the ledger in the same transaction.
+type Service interface {
+	// Get returns a customer's wallet at the tenant in context.
+	Get(ctx *appctx.Context, userID uuid.UUID) (*domain.Wallet, error)
+
+	// List retrieves the paginated wallets of the tenant in context
+	List(ctx *appctx.Context, q *query.ListWalletQuery) (*pagination.PageData[domain.Wallet], error)
+
+	// ListTransactions retrieves a page of a customer's ledger at the tenant in context
+	ListTransactions(ctx *appctx.Context, userID uuid.UUID, q *query.ListTransactionQuery) (*pagination.PageData[domain.Transaction], error)
+
+	// TopUp adds prepaid balance for a customer at the tenant in context.
+	TopUp(ctx *appctx.Context, userID uuid.UUID, amount money.Money, note *string) (*domain.Transaction, error)
+
+	// Adjust corrects a customer's balance at the tenant in context by a signed amount.
+	Adjust(ctx *appctx.Context, userID uuid.UUID, amount money.Money, note string) (*domain.Transaction, error)
+
+	// Spend debits a payment from a customer's wallet. It returns
+	// domain.ErrInsufficientBalance when the balance does not cover it.
+	Spend(ctx *appctx.Context, tenantID, userID uuid.UUID, amount money.Money, paymentID uuid.UUID) (*domain.Transaction, error)
+
+	// CreditRefund pays a refund back into a customer's wallet.
+	CreditRefund(ctx *appctx.Context, tenantID, userID uuid.UUID, amount money.Money, refundID uuid.UUID) (*domain.Transaction, error)
+}
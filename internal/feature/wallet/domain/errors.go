@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var (
+	ErrWalletNotFound         = errors.New("wallet not found")
+	ErrInsufficientBalance    = errors.New("insufficient wallet balance")
+	ErrInvalidWalletAmount    = errors.New("wallet amount must be greater than zero")
+	ErrInvalidAdjustment      = errors.New("wallet adjustment cannot be zero")
+	ErrWalletCustomerRequired = errors.New("wallet payments and refunds need a registered customer")
+	ErrWalletTenantRequired   = errors.New("wallets are kept per tenant")
+)
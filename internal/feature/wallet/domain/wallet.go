@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// Wallet is a customer's prepaid balance at one tenant.
+type Wallet struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	Balance   money.Money
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Transaction is an entry in a wallet ledger. Entries are never changed;
+// mistakes are corrected with an adjustment.
+type Transaction struct {
+	ID           uuid.UUID
+	WalletID     uuid.UUID
+	Type         types.WalletTransactionType
+	Amount       money.Money // signed; debits are negative
+	BalanceAfter money.Money
+	PaymentID    *uuid.UUID
+	RefundID     *uuid.UUID
+	Note         *string
+	CreatedBy    *uuid.UUID
+	CreatedAt    time.Time
+}
+
+// IsDebit reports whether the entry takes money out of the wallet.
+func (t *Transaction) IsDebit() bool {
+	return t.Amount < 0
+}
+
+// Validate checks the sign of the amount against the entry type.
+func (t *Transaction) Validate() error {
+	switch t.Type {
+	case types.WalletTransactionTopUp, types.WalletTransactionRefund:
+		if t.Amount <= 0 {
+			return ErrInvalidWalletAmount
+		}
+	case types.WalletTransactionSpend:
+		if t.Amount >= 0 {
+			return ErrInvalidWalletAmount
+		}
+	case types.WalletTransactionAdjustment:
+		if t.Amount == 0 {
+			return ErrInvalidAdjustment
+		}
+	}
+	return nil
+}
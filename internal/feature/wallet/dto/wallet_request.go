@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/umardev500/laundry/pkg/money"
+
+// TopUpWalletRequest adds prepaid balance to a customer's wallet.
+type TopUpWalletRequest struct {
+	Amount money.Money `json:"amount" validate:"required,gt=0"`
+	Note   *string     `json:"note,omitempty" validate:"omitempty,max=255"`
+}
+
+// AdjustWalletRequest corrects a wallet balance. Negative amounts take money
+// out; a note explaining the correction is required.
+type AdjustWalletRequest struct {
+	Amount money.Money `json:"amount" validate:"required,ne=0"`
+	Note   string      `json:"note" validate:"required,max=255"`
+}
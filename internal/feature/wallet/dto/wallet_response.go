@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// WalletResponse represents a Wallet for API responses.
+type WalletResponse struct {
+	ID        uuid.UUID   `json:"id"`
+	TenantID  uuid.UUID   `json:"tenant_id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	Balance   money.Money `json:"balance"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// TransactionResponse represents a wallet ledger entry for API responses.
+type TransactionResponse struct {
+	ID           uuid.UUID                   `json:"id"`
+	WalletID     uuid.UUID                   `json:"wallet_id"`
+	Type         types.WalletTransactionType `json:"type"`
+	Amount       money.Money                 `json:"amount"`
+	BalanceAfter money.Money                 `json:"balance_after"`
+	PaymentID    *uuid.UUID                  `json:"payment_id,omitempty"`
+	RefundID     *uuid.UUID                  `json:"refund_id,omitempty"`
+	Note         *string                     `json:"note,omitempty"`
+	CreatedBy    *uuid.UUID                  `json:"created_by,omitempty"`
+	CreatedAt    time.Time                   `json:"created_at"`
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/wallet/contract"
+	"github.com/umardev500/laundry/internal/feature/wallet/dto"
+	"github.com/umardev500/laundry/internal/feature/wallet/mapper"
+	"github.com/umardev500/laundry/internal/feature/wallet/query"
+	"github.com/umardev500/laundry/pkg/httpx"
+	"github.com/umardev500/laundry/pkg/validator"
+)
+
+type Handler struct {
+	service   contract.Service
+	validator *validator.Validator
+}
+
+func NewHandler(s contract.Service, v *validator.Validator) *Handler {
+	return &Handler{
+		service:   s,
+		validator: v,
+	}
+}
+
+// List GET /api/wallets
+func (h *Handler) List(c *fiber.Ctx) error {
+	var q query.ListWalletQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	q.Normalize()
+	ctx := appctx.New(c.UserContext())
+
+	page, err := h.service.List(ctx, &q)
+	if err != nil {
+		return handleWalletError(c, err)
+	}
+
+	return httpx.JSONPaginated(
+		c,
+		fiber.StatusOK,
+		mapper.ToResponsePage(page).Data,
+		httpx.NewPagination(q.Page, q.Limit, page.Total),
+	)
+}
+
+// Get GET /api/wallets/:user_id
+func (h *Handler) Get(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("user_id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid user ID")
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	w, err := h.service.Get(ctx, userID)
+	if err != nil {
+		return handleWalletError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusOK, mapper.ToResponse(w))
+}
+
+// Transactions GET /api/wallets/:user_id/transactions
+func (h *Handler) Transactions(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("user_id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid user ID")
+	}
+
+	var q query.ListTransactionQuery
+	if err := c.QueryParser(&q); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	q.Normalize()
+	ctx := appctx.New(c.UserContext())
+
+	page, err := h.service.ListTransactions(ctx, userID, &q)
+	if err != nil {
+		return handleWalletError(c, err)
+	}
+
+	return httpx.JSONPaginated(
+		c,
+		fiber.StatusOK,
+		mapper.ToTransactionResponsePage(page).Data,
+		httpx.NewPagination(q.Page, q.Limit, page.Total),
+	)
+}
+
+// TopUp POST /api/wallets/:user_id/top-up
+func (h *Handler) TopUp(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("user_id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid user ID")
+	}
+
+	var req dto.TopUpWalletRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	t, err := h.service.TopUp(ctx, userID, req.Amount, req.Note)
+	if err != nil {
+		return handleWalletError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToTransactionResponse(t))
+}
+
+// Adjust POST /api/wallets/:user_id/adjust
+func (h *Handler) Adjust(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("user_id"))
+	if err != nil {
+		return httpx.BadRequest(c, "invalid user ID")
+	}
+
+	var req dto.AdjustWalletRequest
+	if err := c.BodyParser(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return httpx.BadRequest(c, err.Error())
+	}
+
+	ctx := appctx.New(c.UserContext())
+
+	t, err := h.service.Adjust(ctx, userID, req.Amount, req.Note)
+	if err != nil {
+		return handleWalletError(c, err)
+	}
+
+	return httpx.JSON(c, fiber.StatusCreated, mapper.ToTransactionResponse(t))
+}
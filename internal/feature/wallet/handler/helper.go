@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/pkg/httpx"
+
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+// handleWalletError centralizes HTTP error mapping for wallet module
+func handleWalletError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrWalletNotFound),
+		errors.Is(err, userDomain.ErrUserNotFound),
+		errors.Is(err, userDomain.ErrUserDeleted):
+		return httpx.NotFound(c, err.Error())
+
+	case errors.Is(err, domain.ErrWalletTenantRequired),
+		errors.Is(err, userDomain.ErrUnauthorizedUserAccess):
+		return httpx.Forbidden(c, err.Error())
+
+	case errors.Is(err, domain.ErrInsufficientBalance),
+		errors.Is(err, domain.ErrInvalidWalletAmount),
+		errors.Is(err, domain.ErrInvalidAdjustment):
+		return httpx.BadRequest(c, err.Error())
+
+	default:
+		return httpx.InternalServerError(c, err.Error())
+	}
+}
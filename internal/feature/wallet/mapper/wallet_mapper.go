@@ -0,0 +1,125 @@
+package mapper
+
+import (
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/internal/feature/wallet/dto"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// FromEnt converts an Ent Wallet to a domain Wallet
+func FromEnt(e *ent.Wallet) *domain.Wallet {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.Wallet{
+		ID:        e.ID,
+		TenantID:  e.TenantID,
+		UserID:    e.UserID,
+		Balance:   e.Balance,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+// FromEntList converts a slice of Ent Wallets to domain Wallets
+func FromEntList(ents []*ent.Wallet) []*domain.Wallet {
+	wallets := make([]*domain.Wallet, len(ents))
+	for i, e := range ents {
+		wallets[i] = FromEnt(e)
+	}
+	return wallets
+}
+
+// TransactionFromEnt converts an Ent WalletTransaction to a domain Transaction
+func TransactionFromEnt(e *ent.WalletTransaction) *domain.Transaction {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.Transaction{
+		ID:           e.ID,
+		WalletID:     e.WalletID,
+		Type:         types.WalletTransactionType(e.Type),
+		Amount:       e.Amount,
+		BalanceAfter: e.BalanceAfter,
+		PaymentID:    e.PaymentID,
+		RefundID:     e.RefundID,
+		Note:         e.Note,
+		CreatedBy:    e.CreatedBy,
+		CreatedAt:    e.CreatedAt,
+	}
+}
+
+// TransactionsFromEnt converts a slice of Ent WalletTransactions to domain Transactions
+func TransactionsFromEnt(ents []*ent.WalletTransaction) []*domain.Transaction {
+	txs := make([]*domain.Transaction, len(ents))
+	for i, e := range ents {
+		txs[i] = TransactionFromEnt(e)
+	}
+	return txs
+}
+
+// ToResponse converts a domain Wallet to a WalletResponse DTO
+func ToResponse(d *domain.Wallet) *dto.WalletResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.WalletResponse{
+		ID:        d.ID,
+		TenantID:  d.TenantID,
+		UserID:    d.UserID,
+		Balance:   d.Balance,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+// ToResponsePage converts paginated domain Wallets to paginated DTOs
+func ToResponsePage(data *pagination.PageData[domain.Wallet]) *pagination.PageData[dto.WalletResponse] {
+	res := make([]*dto.WalletResponse, len(data.Data))
+	for i, w := range data.Data {
+		res[i] = ToResponse(w)
+	}
+
+	return &pagination.PageData[dto.WalletResponse]{
+		Data:  res,
+		Total: data.Total,
+	}
+}
+
+// ToTransactionResponse converts a domain Transaction to a TransactionResponse DTO
+func ToTransactionResponse(d *domain.Transaction) *dto.TransactionResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &dto.TransactionResponse{
+		ID:           d.ID,
+		WalletID:     d.WalletID,
+		Type:         d.Type,
+		Amount:       d.Amount,
+		BalanceAfter: d.BalanceAfter,
+		PaymentID:    d.PaymentID,
+		RefundID:     d.RefundID,
+		Note:         d.Note,
+		CreatedBy:    d.CreatedBy,
+		CreatedAt:    d.CreatedAt,
+	}
+}
+
+// ToTransactionResponsePage converts a paginated ledger to paginated DTOs
+func ToTransactionResponsePage(data *pagination.PageData[domain.Transaction]) *pagination.PageData[dto.TransactionResponse] {
+	res := make([]*dto.TransactionResponse, len(data.Data))
+	for i, t := range data.Data {
+		res[i] = ToTransactionResponse(t)
+	}
+
+	return &pagination.PageData[dto.TransactionResponse]{
+		Data:  res,
+		Total: data.Total,
+	}
+}
@@ -0,0 +1,16 @@
+package wallet
+
+import (
+	"github.com/google/wire"
+	"github.com/umardev500/laundry/internal/feature/wallet/handler"
+	"github.com/umardev500/laundry/internal/feature/wallet/repository"
+	"github.com/umardev500/laundry/internal/feature/wallet/service"
+)
+
+// ProviderSet wires Wallet module dependencies
+var ProviderSet = wire.NewSet(
+	handler.NewHandler,
+	repository.NewEntWalletRepository,
+	service.NewWalletService,
+	NewRoutes,
+)
@@ -0,0 +1,17 @@
+package query
+
+import (
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// ListTransactionQuery defines filters for listing a wallet ledger, newest first
+type ListTransactionQuery struct {
+	pagination.Query
+	Type types.WalletTransactionType `query:"type"` // Filter by entry type (optional)
+}
+
+// Normalize sets default pagination values
+func (q *ListTransactionQuery) Normalize() {
+	q.Query.Normalize(1, 20) // Default page 1, 20 items per page
+}
@@ -0,0 +1,17 @@
+package query
+
+import (
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// ListWalletQuery defines filters for listing the wallets of a tenant
+type ListWalletQuery struct {
+	pagination.Query
+	MinBalance *money.Money `query:"min_balance"` // Only wallets holding at least this much (optional)
+}
+
+// Normalize sets default pagination values
+func (q *ListWalletQuery) Normalize() {
+	q.Query.Normalize(1, 10) // Default page 1, 10 items per page
+}
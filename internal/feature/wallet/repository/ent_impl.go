@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/ent/wallet"
+	"github.com/umardev500/laundry/ent/wallettransaction"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/internal/feature/wallet/mapper"
+	"github.com/umardev500/laundry/internal/feature/wallet/query"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+// EntWalletRepository implements domain.Wallet repository using Ent
+type EntWalletRepository struct {
+	client *entdb.Client
+}
+
+// NewEntWalletRepository creates a new repository instance
+func NewEntWalletRepository(client *entdb.Client) Repository {
+	return &EntWalletRepository{
+		client: client,
+	}
+}
+
+// Find returns the wallet of a customer at a tenant
+func (r *EntWalletRepository) Find(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error) {
+	conn := r.client.GetConn(ctx)
+	entWallet, err := conn.Wallet.
+		Query().
+		Where(
+			wallet.TenantIDEQ(tenantID),
+			wallet.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entWallet), nil
+}
+
+// FindOrCreate returns the wallet of a customer at a tenant, opening an empty one if needed
+func (r *EntWalletRepository) FindOrCreate(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error) {
+	conn := r.client.GetConn(ctx)
+
+	// Concurrent first credits race on the unique index; both end up with the same row
+	id, err := conn.Wallet.
+		Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		OnConflictColumns(wallet.FieldTenantID, wallet.FieldUserID).
+		Ignore().
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entWallet, err := conn.Wallet.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entWallet), nil
+}
+
+// AddBalance changes the balance by a signed amount in a single statement
+func (r *EntWalletRepository) AddBalance(ctx *appctx.Context, walletID uuid.UUID, amount money.Money) (*domain.Wallet, error) {
+	conn := r.client.GetConn(ctx)
+
+	qb := conn.Wallet.
+		Update().
+		Where(wallet.IDEQ(walletID)).
+		AddBalance(amount)
+
+	// The balance check is part of the update so concurrent debits cannot overdraw
+	if amount < 0 {
+		qb = qb.Where(wallet.BalanceGTE(-amount))
+	}
+
+	affected, err := qb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, domain.ErrInsufficientBalance
+	}
+
+	entWallet, err := conn.Wallet.Get(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.FromEnt(entWallet), nil
+}
+
+// CreateTransaction appends an entry to the ledger
+func (r *EntWalletRepository) CreateTransaction(ctx *appctx.Context, t *domain.Transaction) (*domain.Transaction, error) {
+	conn := r.client.GetConn(ctx)
+	entTx, err := conn.WalletTransaction.
+		Create().
+		SetWalletID(t.WalletID).
+		SetType(wallettransaction.Type(t.Type)).
+		SetAmount(t.Amount).
+		SetBalanceAfter(t.BalanceAfter).
+		SetNillablePaymentID(t.PaymentID).
+		SetNillableRefundID(t.RefundID).
+		SetNillableNote(t.Note).
+		SetNillableCreatedBy(t.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.TransactionFromEnt(entTx), nil
+}
+
+// ListTransactions returns a page of a wallet ledger, newest first
+func (r *EntWalletRepository) ListTransactions(ctx *appctx.Context, walletID uuid.UUID, q *query.ListTransactionQuery) (*pagination.PageData[domain.Transaction], error) {
+	q.Normalize()
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.WalletTransaction.
+		Query().
+		Where(wallettransaction.WalletIDEQ(walletID))
+
+	// Filter by type
+	if q.Type != "" {
+		qb = qb.Where(wallettransaction.TypeEQ(wallettransaction.Type(q.Type)))
+	}
+
+	total, err := qb.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ents, err := qb.
+		Order(ent.Desc(wallettransaction.FieldCreatedAt)).
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagination.PageData[domain.Transaction]{
+		Data:  mapper.TransactionsFromEnt(ents),
+		Total: total,
+	}, nil
+}
+
+// List retrieves paginated wallets with tenant scoping
+func (r *EntWalletRepository) List(ctx *appctx.Context, q *query.ListWalletQuery) (*pagination.PageData[domain.Wallet], error) {
+	q.Normalize()
+
+	conn := r.client.GetConn(ctx)
+	qb := conn.Wallet.Query()
+	qb = r.applyScope(ctx, qb)
+
+	if q.MinBalance != nil {
+		qb = qb.Where(wallet.BalanceGTE(*q.MinBalance))
+	}
+
+	total, err := qb.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ents, err := qb.
+		Order(ent.Desc(wallet.FieldUpdatedAt)).
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagination.PageData[domain.Wallet]{
+		Data:  mapper.FromEntList(ents),
+		Total: total,
+	}, nil
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// applyScope ensures tenant-level filtering.
+func (r *EntWalletRepository) applyScope(ctx *appctx.Context, qb *ent.WalletQuery) *ent.WalletQuery {
+	switch ctx.Scope() {
+	case appctx.ScopeTenant:
+		qb = qb.Where(wallet.TenantIDEQ(*ctx.TenantID()))
+	case appctx.ScopeUser:
+		qb = qb.Where(wallet.UserIDEQ(*ctx.UserID()))
+	case appctx.ScopeAdmin:
+		// no filtering for admin
+	}
+
+	return qb
+}
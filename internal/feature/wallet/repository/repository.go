@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/internal/feature/wallet/query"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+)
+
+type Repository interface {
+	// Find returns the wallet of a customer at a tenant.
+	Find(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error)
+
+	// FindOrCreate returns the wallet of a customer at a tenant, opening an empty one if needed.
+	FindOrCreate(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error)
+
+	// AddBalance changes the balance by a signed amount in a single statement.
+	// A debit larger than the balance changes nothing and returns
+	// domain.ErrInsufficientBalance.
+	AddBalance(ctx *appctx.Context, walletID uuid.UUID, amount money.Money) (*domain.Wallet, error)
+
+	// CreateTransaction appends an entry to the ledger.
+	CreateTransaction(ctx *appctx.Context, t *domain.Transaction) (*domain.Transaction, error)
+
+	// ListTransactions returns a page of a wallet ledger.
+	ListTransactions(ctx *appctx.Context, walletID uuid.UUID, q *query.ListTransactionQuery) (*pagination.PageData[domain.Transaction], error)
+
+	List(ctx *appctx.Context, q *query.ListWalletQuery) (*pagination.PageData[domain.Wallet], error)
+}
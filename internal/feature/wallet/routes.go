@@ -0,0 +1,41 @@
+package wallet
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/umardev500/laundry/internal/app/middleware"
+	"github.com/umardev500/laundry/internal/app/router"
+	"github.com/umardev500/laundry/internal/feature/wallet/handler"
+	"github.com/umardev500/laundry/internal/infra/jwtkeys"
+
+	authContract "github.com/umardev500/laundry/internal/feature/auth/contract"
+	rbacContract "github.com/umardev500/laundry/internal/feature/rbac/contract"
+)
+
+type Routes struct {
+	handler  *handler.Handler
+	keys     *jwtkeys.KeySet
+	sessions authContract.SessionService
+	authz    rbacContract.AuthorizationService
+}
+
+var _ router.RouteRegistrar = (*Routes)(nil)
+
+func (r *Routes) RegisterRoutes(router fiber.Router) {
+	group := router.Group("wallets")
+	group.Use(middleware.CheckAuth(r.keys, r.sessions))
+
+	group.Get("/", middleware.RequirePermission(r.authz, "view_wallet"), r.handler.List)
+	group.Get("/:user_id", middleware.RequirePermission(r.authz, "view_wallet"), r.handler.Get)
+	group.Get("/:user_id/transactions", middleware.RequirePermission(r.authz, "view_wallet"), r.handler.Transactions)
+	group.Post("/:user_id/top-up", middleware.RequirePermission(r.authz, "top_up_wallet"), r.handler.TopUp)
+	group.Post("/:user_id/adjust", middleware.RequirePermission(r.authz, "adjust_wallet"), r.handler.Adjust)
+}
+
+func NewRoutes(h *handler.Handler, keys *jwtkeys.KeySet, sessions authContract.SessionService, authz rbacContract.AuthorizationService) *Routes {
+	return &Routes{
+		handler:  h,
+		keys:     keys,
+		sessions: sessions,
+		authz:    authz,
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/wallet/contract"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/internal/feature/wallet/query"
+	"github.com/umardev500/laundry/internal/feature/wallet/repository"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/pagination"
+	"github.com/umardev500/laundry/pkg/types"
+
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+)
+
+type walletService struct {
+	client      *entdb.Client
+	repo        repository.Repository
+	userService userContract.Service
+}
+
+// NewWalletService creates a new wallet service
+func NewWalletService(client *entdb.Client, repo repository.Repository, userService userContract.Service) contract.Service {
+	return &walletService{
+		client:      client,
+		repo:        repo,
+		userService: userService,
+	}
+}
+
+// Get implements contract.Service.
+func (s *walletService) Get(ctx *appctx.Context, userID uuid.UUID) (*domain.Wallet, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, domain.ErrWalletTenantRequired
+	}
+
+	return s.find(ctx, *tenantID, userID)
+}
+
+// List implements contract.Service.
+func (s *walletService) List(ctx *appctx.Context, q *query.ListWalletQuery) (*pagination.PageData[domain.Wallet], error) {
+	if q == nil {
+		q = &query.ListWalletQuery{}
+	}
+	q.Normalize()
+
+	return s.repo.List(ctx, q)
+}
+
+// ListTransactions implements contract.Service.
+func (s *walletService) ListTransactions(ctx *appctx.Context, userID uuid.UUID, q *query.ListTransactionQuery) (*pagination.PageData[domain.Transaction], error) {
+	w, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if q == nil {
+		q = &query.ListTransactionQuery{}
+	}
+	q.Normalize()
+
+	return s.repo.ListTransactions(ctx, w.ID, q)
+}
+
+// TopUp implements contract.Service.
+func (s *walletService) TopUp(ctx *appctx.Context, userID uuid.UUID, amount money.Money, note *string) (*domain.Transaction, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, domain.ErrWalletTenantRequired
+	}
+
+	// Only registered customers get a wallet
+	if _, err := s.userService.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, *tenantID, userID, &domain.Transaction{
+		Type:      types.WalletTransactionTopUp,
+		Amount:    amount,
+		Note:      note,
+		CreatedBy: ctx.UserID(),
+	})
+}
+
+// Adjust implements contract.Service.
+func (s *walletService) Adjust(ctx *appctx.Context, userID uuid.UUID, amount money.Money, note string) (*domain.Transaction, error) {
+	tenantID := ctx.TenantID()
+	if tenantID == nil {
+		return nil, domain.ErrWalletTenantRequired
+	}
+
+	if _, err := s.userService.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, *tenantID, userID, &domain.Transaction{
+		Type:      types.WalletTransactionAdjustment,
+		Amount:    amount,
+		Note:      &note,
+		CreatedBy: ctx.UserID(),
+	})
+}
+
+// Spend implements contract.Service.
+func (s *walletService) Spend(ctx *appctx.Context, tenantID, userID uuid.UUID, amount money.Money, paymentID uuid.UUID) (*domain.Transaction, error) {
+	return s.post(ctx, tenantID, userID, &domain.Transaction{
+		Type:      types.WalletTransactionSpend,
+		Amount:    -amount,
+		PaymentID: &paymentID,
+		CreatedBy: ctx.UserID(),
+	})
+}
+
+// CreditRefund implements contract.Service.
+func (s *walletService) CreditRefund(ctx *appctx.Context, tenantID, userID uuid.UUID, amount money.Money, refundID uuid.UUID) (*domain.Transaction, error) {
+	return s.post(ctx, tenantID, userID, &domain.Transaction{
+		Type:      types.WalletTransactionRefund,
+		Amount:    amount,
+		RefundID:  &refundID,
+		CreatedBy: ctx.UserID(),
+	})
+}
+
+// -------------------------
+// Helpers
+// -------------------------
+
+// post applies a signed ledger entry to the customer's wallet and records it.
+func (s *walletService) post(ctx *appctx.Context, tenantID, userID uuid.UUID, t *domain.Transaction) (*domain.Transaction, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	var created *domain.Transaction
+	err := s.client.WithTransaction(ctx, func(txCtx context.Context) error {
+		newCtx := appctx.New(txCtx)
+
+		var w *domain.Wallet
+		var err error
+		if t.IsDebit() {
+			// Nothing to take from a wallet that was never opened
+			w, err = s.repo.Find(newCtx, tenantID, userID)
+			if ent.IsNotFound(err) {
+				return domain.ErrInsufficientBalance
+			}
+		} else {
+			w, err = s.repo.FindOrCreate(newCtx, tenantID, userID)
+		}
+		if err != nil {
+			return err
+		}
+
+		w, err = s.repo.AddBalance(newCtx, w.ID, t.Amount)
+		if err != nil {
+			return err
+		}
+
+		t.WalletID = w.ID
+		t.BalanceAfter = w.Balance
+
+		created, err = s.repo.CreateTransaction(newCtx, t)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *walletService) find(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error) {
+	w, err := s.repo.Find(ctx, tenantID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrWalletNotFound
+		}
+		return nil, err
+	}
+
+	return w, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/internal/app/appctx"
+	"github.com/umardev500/laundry/internal/feature/wallet/domain"
+	"github.com/umardev500/laundry/internal/feature/wallet/repository"
+	"github.com/umardev500/laundry/internal/infra/database/entdb"
+	"github.com/umardev500/laundry/pkg/money"
+
+	userContract "github.com/umardev500/laundry/internal/feature/user/contract"
+	userDomain "github.com/umardev500/laundry/internal/feature/user/domain"
+)
+
+// memoryWallets is an in-memory wallet repository that keeps the balance
+// check of AddBalance.
+type memoryWallets struct {
+	repository.Repository
+	wallets map[uuid.UUID]*domain.Wallet
+	ledger  []*domain.Transaction
+}
+
+func (m *memoryWallets) Find(_ *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error) {
+	for _, w := range m.wallets {
+		if w.TenantID == tenantID && w.UserID == userID {
+			found := *w
+			return &found, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (m *memoryWallets) FindOrCreate(ctx *appctx.Context, tenantID, userID uuid.UUID) (*domain.Wallet, error) {
+	w, err := m.Find(ctx, tenantID, userID)
+	if !ent.IsNotFound(err) {
+		return w, err
+	}
+
+	w = &domain.Wallet{ID: uuid.New(), TenantID: tenantID, UserID: userID}
+	m.wallets[w.ID] = w
+	created := *w
+	return &created, nil
+}
+
+func (m *memoryWallets) AddBalance(_ *appctx.Context, walletID uuid.UUID, amount money.Money) (*domain.Wallet, error) {
+	w := m.wallets[walletID]
+	if amount < 0 && w.Balance < -amount {
+		return nil, domain.ErrInsufficientBalance
+	}
+
+	w.Balance += amount
+	updated := *w
+	return &updated, nil
+}
+
+func (m *memoryWallets) CreateTransaction(_ *appctx.Context, t *domain.Transaction) (*domain.Transaction, error) {
+	t.ID = uuid.New()
+	m.ledger = append(m.ledger, t)
+	return t, nil
+}
+
+// stubUsers knows every user.
+type stubUsers struct {
+	userContract.Service
+}
+
+func (stubUsers) GetByID(_ *appctx.Context, id uuid.UUID) (*userDomain.User, error) {
+	return &userDomain.User{ID: id}, nil
+}
+
+func TestDebitCannotOverdraw(t *testing.T) {
+	tenantID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		opened      bool
+		balance     money.Money
+		debit       func(s *walletService, ctx *appctx.Context, userID uuid.UUID, amount money.Money) (*domain.Transaction, error)
+		amount      money.Money
+		wantBalance money.Money
+		wantErr     error
+	}{
+		{name: "spend part of the balance", opened: true, balance: 50_000, debit: spend, amount: 20_000, wantBalance: 30_000},
+		{name: "spend the whole balance", opened: true, balance: 50_000, debit: spend, amount: 50_000, wantBalance: 0},
+		{name: "spend more than the balance", opened: true, balance: 50_000, debit: spend, amount: 50_001, wantBalance: 50_000, wantErr: domain.ErrInsufficientBalance},
+		{name: "spend from an empty wallet", opened: true, debit: spend, amount: 1, wantErr: domain.ErrInsufficientBalance},
+		{name: "spend without a wallet", debit: spend, amount: 1, wantErr: domain.ErrInsufficientBalance},
+		{name: "spend nothing", opened: true, balance: 50_000, debit: spend, amount: 0, wantBalance: 50_000, wantErr: domain.ErrInvalidWalletAmount},
+		{name: "adjust down within the balance", opened: true, balance: 50_000, debit: adjust, amount: 10_000, wantBalance: 40_000},
+		{name: "adjust down below zero", opened: true, balance: 50_000, debit: adjust, amount: 60_000, wantBalance: 50_000, wantErr: domain.ErrInsufficientBalance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryWallets{wallets: map[uuid.UUID]*domain.Wallet{}}
+			if tt.opened {
+				w := &domain.Wallet{ID: uuid.New(), TenantID: tenantID, UserID: userID, Balance: tt.balance}
+				repo.wallets[w.ID] = w
+			}
+
+			s := &walletService{client: entdb.NewNopClient(), repo: repo, userService: stubUsers{}}
+			ctx := appctx.New(context.Background()).WithTenantID(&tenantID)
+
+			got, err := tt.debit(s, ctx, userID, tt.amount)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("debit error = %v, want %v", err, tt.wantErr)
+				}
+				if len(repo.ledger) != 0 {
+					t.Errorf("ledger has %d entries, want none", len(repo.ledger))
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("debit unexpected error: %v", err)
+				}
+				if got.Amount != -tt.amount || got.BalanceAfter != tt.wantBalance {
+					t.Errorf("debit entry = %s leaving %s, want %s leaving %s", got.Amount, got.BalanceAfter, -tt.amount, tt.wantBalance)
+				}
+			}
+
+			if !tt.opened {
+				if len(repo.wallets) != 0 {
+					t.Errorf("debit opened a wallet")
+				}
+				return
+			}
+
+			w, err := repo.Find(ctx, tenantID, userID)
+			if err != nil {
+				t.Fatalf("Find: %v", err)
+			}
+			if w.Balance != tt.wantBalance {
+				t.Errorf("balance = %s, want %s", w.Balance, tt.wantBalance)
+			}
+		})
+	}
+}
+
+func spend(s *walletService, ctx *appctx.Context, userID uuid.UUID, amount money.Money) (*domain.Transaction, error) {
+	return s.Spend(ctx, *ctx.TenantID(), userID, amount, uuid.New())
+}
+
+func adjust(s *walletService, ctx *appctx.Context, userID uuid.UUID, amount money.Money) (*domain.Transaction, error) {
+	return s.Adjust(ctx, userID, -amount, "correction")
+}
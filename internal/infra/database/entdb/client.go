@@ -3,6 +3,8 @@ package entdb
 import (
 	"context"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/rs/zerolog/log"
 	"github.com/umardev500/laundry/ent"
@@ -18,10 +20,18 @@ type Client struct {
 type contextKeyTx struct{}
 
 func NewEntClient(cfg *config.Config) *Client {
-	client, err := ent.Open(cfg.Database.Driver, cfg.Database.Dsn)
+	drv, err := entsql.Open(cfg.Database.Driver, cfg.Database.Dsn)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to connect to database")
 	}
+	client := ent.NewClient(ent.Driver(drv))
+
+	// Scale float money columns before Ent gets to change their type
+	if drv.Dialect() == dialect.Postgres {
+		if err := migrateMoneyColumns(context.Background(), drv.DB()); err != nil {
+			log.Fatal().Err(err).Msg("Failed to migrate money columns")
+		}
+	}
 
 	dropUnsafe := cfg.App.Env == "development"
 
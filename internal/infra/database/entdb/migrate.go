@@ -2,9 +2,13 @@ package entdb
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 
 	"github.com/rs/zerolog/log"
 	"github.com/umardev500/laundry/ent"
+	"github.com/umardev500/laundry/pkg/money"
 )
 
 func RunMigration(ctx context.Context, client *ent.Client) error {
@@ -12,3 +16,67 @@ func RunMigration(ctx context.Context, client *ent.Client) error {
 
 	return client.Schema.Create(ctx)
 }
+
+// moneyColumns are the columns that used to hold major units as double
+// precision and now hold money.Money minor units as bigint.
+var moneyColumns = map[string][]string{
+	"orders":              {"total_amount"},
+	"order_items":         {"price", "subtotal", "total_amount"},
+	"payments":            {"amount", "received_amount", "change_amount"},
+	"refunds":             {"requested_amount", "approved_amount"},
+	"plans":               {"price"},
+	"services":            {"base_price"},
+	"cash_shifts":         {"opening_float", "cash_sales", "cash_refunds", "expected_cash", "counted_cash", "discrepancy"},
+	"wallets":             {"balance"},
+	"wallet_transactions": {"amount", "balance_after"},
+}
+
+// migrateMoneyColumns converts the float money columns still around from
+// before money.Money to minor units. It must run before the Ent migration,
+// which would otherwise change the column type without scaling the values.
+// Columns that are already bigint are left alone, so it is safe to run on
+// every start.
+func migrateMoneyColumns(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for table, columns := range moneyColumns {
+		for _, column := range columns {
+			var dataType string
+			err := tx.QueryRowContext(ctx,
+				`SELECT data_type FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2`,
+				table, column,
+			).Scan(&dataType)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if dataType != "double precision" {
+				continue
+			}
+
+			log.Info().Msgf("🌿 Converting %s.%s to money minor units...", table, column)
+
+			if _, err := tx.ExecContext(ctx, convertMoneyColumnSQL(table, column)); err != nil {
+				return fmt.Errorf("convert %s.%s: %w", table, column, err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// convertMoneyColumnSQL turns a double precision column of major units into a
+// bigint column of minor units, rounding to the nearest minor unit.
+func convertMoneyColumnSQL(table, column string) string {
+	return fmt.Sprintf(
+		`ALTER TABLE %q ALTER COLUMN %q DROP DEFAULT, ALTER COLUMN %q TYPE bigint USING round(%q * %d)::bigint`,
+		table, column, column, column, money.Scale,
+	)
+}
@@ -0,0 +1,46 @@
+package entdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/umardev500/laundry/ent"
+)
+
+// errNoDatabase is returned by every query sent through a nop client.
+var errNoDatabase = errors.New("entdb: nop client has no database")
+
+// NewNopClient returns a client whose transactions begin, commit and roll
+// back without a database, while any query fails. It lets services that wrap
+// their repositories in WithTransaction run against in-memory repositories in
+// tests.
+func NewNopClient() *Client {
+	db := sql.OpenDB(nopConnector{})
+	return &Client{
+		Client: ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db))),
+	}
+}
+
+type nopConnector struct{}
+
+func (nopConnector) Connect(context.Context) (driver.Conn, error) { return nopConn{}, nil }
+func (nopConnector) Driver() driver.Driver                        { return nopDriver{} }
+
+type nopDriver struct{}
+
+func (nopDriver) Open(string) (driver.Conn, error) { return nopConn{}, nil }
+
+type nopConn struct{}
+
+func (nopConn) Prepare(string) (driver.Stmt, error) { return nil, errNoDatabase }
+func (nopConn) Close() error                        { return nil }
+func (nopConn) Begin() (driver.Tx, error)           { return nopTx{}, nil }
+
+type nopTx struct{}
+
+func (nopTx) Commit() error   { return nil }
+func (nopTx) Rollback() error { return nil }
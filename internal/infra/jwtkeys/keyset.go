@@ -0,0 +1,184 @@
+package jwtkeys
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/umardev500/laundry/internal/config"
+)
+
+var (
+	// ErrNoSigningKey is returned when no configured key is inside its signing window.
+	ErrNoSigningKey = errors.New("no active jwt signing key")
+)
+
+type signingKey struct {
+	id        string
+	alg       jwa.SignatureAlgorithm
+	private   jwk.Key
+	public    jwk.Key
+	notBefore time.Time
+	notAfter  time.Time
+}
+
+// canVerify reports whether tokens signed with the key are still accepted.
+func (k *signingKey) canVerify(now time.Time) bool {
+	return k.notAfter.IsZero() || now.Before(k.notAfter)
+}
+
+// canSign reports whether the key may sign new tokens.
+func (k *signingKey) canSign(now time.Time) bool {
+	return !now.Before(k.notBefore) && k.canVerify(now)
+}
+
+// KeySet signs and verifies JWTs with the keys configured in config.JWT.
+// Without asymmetric keys it falls back to HS256 with the shared secret.
+type KeySet struct {
+	secret []byte
+	keys   []*signingKey
+}
+
+// NewKeySet loads the configured signing keys.
+func NewKeySet(cfg *config.Config) (*KeySet, error) {
+	set := &KeySet{secret: []byte(cfg.JWT.Secret)}
+
+	seen := make(map[string]bool, len(cfg.JWT.Keys))
+	for _, kc := range cfg.JWT.Keys {
+		if kc.ID == "" {
+			return nil, fmt.Errorf("jwt key: id is required")
+		}
+		if seen[kc.ID] {
+			return nil, fmt.Errorf("jwt key %q: duplicate id", kc.ID)
+		}
+		seen[kc.ID] = true
+
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", kc.ID, err)
+		}
+		set.keys = append(set.keys, key)
+	}
+
+	if len(set.keys) == 0 && len(set.secret) == 0 {
+		return nil, fmt.Errorf("jwt: either a secret or signing keys must be configured")
+	}
+
+	return set, nil
+}
+
+// Sign signs the token with the newest key inside its signing window, and
+// records that key's kid in the protected header.
+func (s *KeySet) Sign(token jwt.Token) ([]byte, error) {
+	if len(s.keys) == 0 {
+		return jwt.Sign(token, jwt.WithKey(jwa.HS256(), s.secret))
+	}
+
+	key := s.signingKey(time.Now())
+	if key == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	headers := jws.NewHeaders()
+	if err := headers.Set(jws.KeyIDKey, key.id); err != nil {
+		return nil, err
+	}
+
+	return jwt.Sign(token, jwt.WithKey(key.alg, key.private, jws.WithProtectedHeaders(headers)))
+}
+
+// Parse verifies the token with the key named by its kid and validates its claims.
+func (s *KeySet) Parse(raw []byte) (jwt.Token, error) {
+	if len(s.keys) == 0 {
+		return jwt.Parse(raw, jwt.WithKey(jwa.HS256(), s.secret))
+	}
+
+	set, err := s.PublicSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return jwt.Parse(raw, jwt.WithKeySet(set, jws.WithRequireKid(true)))
+}
+
+// PublicSet returns the public keys that are currently accepted, including
+// keys scheduled to start signing so verifiers can cache them ahead of rotation.
+// It is empty when tokens are signed with the shared secret.
+func (s *KeySet) PublicSet() (jwk.Set, error) {
+	now := time.Now()
+	set := jwk.NewSet()
+
+	for _, key := range s.keys {
+		if !key.canVerify(now) {
+			continue
+		}
+		if err := set.AddKey(key.public); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// signingKey returns the key with the latest NotBefore that may sign at now.
+func (s *KeySet) signingKey(now time.Time) *signingKey {
+	var active *signingKey
+	for _, key := range s.keys {
+		if !key.canSign(now) {
+			continue
+		}
+		if active == nil || key.notBefore.After(active.notBefore) {
+			active = key
+		}
+	}
+	return active
+}
+
+// loadKey reads a PEM private key and prepares its private and public JWKs.
+func loadKey(kc config.JWTKey) (*signingKey, error) {
+	alg, ok := jwa.LookupSignatureAlgorithm(kc.Algorithm)
+	if !ok || (alg != jwa.RS256() && alg != jwa.EdDSA()) {
+		return nil, fmt.Errorf("unsupported algorithm %q, expected RS256 or EdDSA", kc.Algorithm)
+	}
+
+	data, err := os.ReadFile(kc.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read private key: %w", err)
+	}
+
+	private, err := jwk.ParseKey(data, jwk.WithPEM(true))
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	public, err := jwk.PublicKeyOf(private)
+	if err != nil {
+		return nil, fmt.Errorf("derive public key: %w", err)
+	}
+
+	for _, key := range []jwk.Key{private, public} {
+		if err := key.Set(jwk.KeyIDKey, kc.ID); err != nil {
+			return nil, err
+		}
+		if err := key.Set(jwk.AlgorithmKey, alg); err != nil {
+			return nil, err
+		}
+	}
+	if err := public.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+		return nil, err
+	}
+
+	return &signingKey{
+		id:        kc.ID,
+		alg:       alg,
+		private:   private,
+		public:    public,
+		notBefore: kc.NotBefore,
+		notAfter:  kc.NotAfter,
+	}, nil
+}
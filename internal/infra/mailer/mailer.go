@@ -0,0 +1,30 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/umardev500/laundry/internal/config"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers outgoing email.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer returns an SMTP mailer, or an in-memory mailer when no SMTP host is configured.
+func NewMailer(cfg *config.Config) Mailer {
+	if cfg.Email.SmtpHost == "" {
+		log.Warn().Msg("SMTP host is not configured, outgoing mail is kept in memory")
+		return NewMemoryMailer()
+	}
+
+	return NewSMTPMailer(cfg)
+}
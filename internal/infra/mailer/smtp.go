@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/umardev500/laundry/internal/config"
+)
+
+type smtpMailer struct {
+	addr   string
+	auth   smtp.Auth
+	sender string
+}
+
+// NewSMTPMailer returns a Mailer that sends through the configured SMTP server.
+func NewSMTPMailer(cfg *config.Config) Mailer {
+	return &smtpMailer{
+		addr:   net.JoinHostPort(cfg.Email.SmtpHost, cfg.Email.SmtpPort),
+		auth:   smtp.PlainAuth("", cfg.Email.Sender, cfg.Email.AppPassword, cfg.Email.SmtpHost),
+		sender: cfg.Email.Sender,
+	}
+}
+
+// Send implements Mailer.
+func (m *smtpMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, m.sender, msg.To, m.build(msg)); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+
+	return nil
+}
+
+// build renders the message headers and body.
+func (m *smtpMailer) build(msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.sender)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	return []byte(b.String())
+}
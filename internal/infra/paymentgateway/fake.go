@@ -0,0 +1,294 @@
+package paymentgateway
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// FakeServer is an in-memory gateway served over HTTP. It implements the API
+// used by NewHTTPGateway and sends signed webhooks when a charge changes. It
+// is meant for tests and local development.
+type FakeServer struct {
+	server     *httptest.Server
+	secret     string
+	webhookURL string
+	client     *http.Client
+
+	mu      sync.Mutex
+	charges map[string]*Charge
+	replays map[string]any // responses by idempotency key
+}
+
+// NewFakeServer starts a fake gateway that notifies webhookURL, signing with secret.
+func NewFakeServer(secret, webhookURL string) *FakeServer {
+	f := &FakeServer{
+		secret:     secret,
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: defaultTimeout},
+		charges:    map[string]*Charge{},
+		replays:    map[string]any{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/charges", f.handleCreateCharge)
+	mux.HandleFunc("GET /v1/charges/{id}", f.handleGetCharge)
+	mux.HandleFunc("POST /v1/charges/{id}/refunds", f.handleRefund)
+	mux.HandleFunc("POST /v1/charges/{id}/simulate", f.handleSimulate)
+	f.server = httptest.NewServer(mux)
+
+	return f
+}
+
+// URL returns the base URL of the fake gateway.
+func (f *FakeServer) URL() string {
+	return f.server.URL
+}
+
+// Close shuts the server down.
+func (f *FakeServer) Close() {
+	f.server.Close()
+}
+
+// Charge returns a copy of a charge, or nil if it does not exist.
+func (f *FakeServer) Charge(id string) *Charge {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.charges[id]
+	if !ok {
+		return nil
+	}
+	clone := *c
+	return &clone
+}
+
+// SetStatus changes a charge, as if the customer paid or the charge expired,
+// and delivers the webhook.
+func (f *FakeServer) SetStatus(id string, status ChargeStatus) error {
+	f.mu.Lock()
+	c, ok := f.charges[id]
+	if !ok {
+		f.mu.Unlock()
+		return ErrChargeNotFound
+	}
+	c.Status = status
+	if status == ChargeStatusPaid {
+		now := time.Now().UTC()
+		c.PaidAt = &now
+	}
+	charge := *c
+	f.mu.Unlock()
+
+	return f.notify(charge)
+}
+
+// notify posts a signed charge event to the webhook URL.
+func (f *FakeServer) notify(charge Charge) error {
+	payload, err := json.Marshal(Event{
+		ID:        "evt_" + uuid.NewString(),
+		Type:      "charge." + string(charge.Status),
+		CreatedAt: time.Now().UTC(),
+		Charge:    charge,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, f.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(f.secret, payload))
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver webhook: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("deliver webhook: unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (f *FakeServer) handleCreateCharge(w http.ResponseWriter, r *http.Request) {
+	var req ChargeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	if req.Amount <= 0 || !req.Channel.IsValid() {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "amount and a supported channel are required")
+		return
+	}
+
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if replay, ok := f.replay("charge:" + key); ok {
+		writeJSON(w, http.StatusOK, replay)
+		return
+	}
+
+	id := "ch_" + uuid.NewString()
+	charge := &Charge{
+		ID:          id,
+		ReferenceID: req.ReferenceID,
+		Channel:     req.Channel,
+		Amount:      req.Amount,
+		Status:      ChargeStatusPending,
+	}
+	if !req.ExpiresAt.IsZero() {
+		charge.ExpiresAt = &req.ExpiresAt
+	}
+
+	if strings.HasSuffix(string(req.Channel), "_va") {
+		charge.VANumber = fakeVANumber()
+	} else {
+		charge.PaymentURL = f.server.URL + "/pay/" + id
+	}
+
+	f.mu.Lock()
+	f.charges[id] = charge
+	clone := *charge
+	if key != "" {
+		f.replays["charge:"+key] = clone
+	}
+	f.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, clone)
+}
+
+func (f *FakeServer) handleGetCharge(w http.ResponseWriter, r *http.Request) {
+	charge := f.Charge(r.PathValue("id"))
+	if charge == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "charge not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, charge)
+}
+
+func (f *FakeServer) handleRefund(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if replay, ok := f.replay("refund:" + key); ok {
+		writeJSON(w, http.StatusOK, replay)
+		return
+	}
+
+	var req RefundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	c, ok := f.charges[id]
+	if !ok {
+		f.mu.Unlock()
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "charge not found")
+		return
+	}
+	if c.Status != ChargeStatusPaid || req.Amount <= 0 || req.Amount > c.Amount {
+		f.mu.Unlock()
+		writeError(w, http.StatusConflict, "REFUND_NOT_ALLOWED", "only paid charges can be refunded, up to the charged amount")
+		return
+	}
+	c.Status = ChargeStatusRefunded
+	charge := *c
+	refund := Refund{
+		ID:       "rf_" + uuid.NewString(),
+		ChargeID: id,
+		Amount:   req.Amount,
+		Status:   ChargeStatusPending,
+	}
+	if key != "" {
+		f.replays["refund:"+key] = refund
+	}
+	f.mu.Unlock()
+
+	// Real gateways confirm refunds asynchronously.
+	go func() {
+		if err := f.notify(charge); err != nil {
+			log.Error().Err(err).Str("charge_id", id).Msg("Fake gateway failed to deliver refund webhook")
+		}
+	}()
+
+	writeJSON(w, http.StatusCreated, refund)
+}
+
+// handleSimulate lets developers pay, fail or expire a charge by hand.
+func (f *FakeServer) handleSimulate(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Status ChargeStatus `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	switch req.Status {
+	case ChargeStatusPaid, ChargeStatusFailed, ChargeStatusExpired:
+	default:
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "status must be paid, failed or expired")
+		return
+	}
+
+	if err := f.SetStatus(r.PathValue("id"), req.Status); err != nil {
+		if errors.Is(err, ErrChargeNotFound) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", "charge not found")
+			return
+		}
+		writeError(w, http.StatusBadGateway, "WEBHOOK_FAILED", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, f.Charge(r.PathValue("id")))
+}
+
+// replay returns the response stored for an idempotency key. Requests without
+// a key are never replayed.
+func (f *FakeServer) replay(key string) (any, bool) {
+	if strings.HasSuffix(key, ":") {
+		return nil, false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.replays[key]
+	return res, ok
+}
+
+// fakeVANumber returns a random 16-digit virtual account number.
+func fakeVANumber() string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1e14))
+	if err != nil {
+		return "8800000000000000"
+	}
+	return fmt.Sprintf("88%014d", n.Int64())
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, APIError{Code: code, Message: message})
+}
@@ -0,0 +1,174 @@
+package paymentgateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+const testWebhookSecret = "whsec_test"
+
+// webhook is a notification as the fake gateway delivered it.
+type webhook struct {
+	payload   []byte
+	signature string
+}
+
+// newTestGateway starts a fake gateway and a receiver for its webhooks, and
+// returns a client for the gateway that trusts the fake's signing secret.
+func newTestGateway(t *testing.T) (PaymentGateway, *FakeServer, <-chan webhook) {
+	t.Helper()
+
+	received := make(chan webhook, 8)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		select {
+		case received <- webhook{payload: payload, signature: r.Header.Get(SignatureHeader)}:
+		default:
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(receiver.Close)
+
+	fake := NewFakeServer(testWebhookSecret, receiver.URL)
+	t.Cleanup(fake.Close)
+
+	gateway := NewHTTPGateway(config.PaymentGateway{
+		BaseURL:       fake.URL(),
+		ServerKey:     "server-key",
+		WebhookSecret: testWebhookSecret,
+	})
+
+	return gateway, fake, received
+}
+
+func createTestCharge(t *testing.T, gateway PaymentGateway, key string) *Charge {
+	t.Helper()
+
+	charge, err := gateway.CreateCharge(context.Background(), ChargeRequest{
+		ReferenceID:    "payment-1",
+		Amount:         money.FromMajor(50000),
+		Channel:        types.PaymentChannelBCAVA,
+		ExpiresAt:      time.Now().Add(time.Hour),
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		t.Fatalf("CreateCharge: %v", err)
+	}
+	return charge
+}
+
+func TestWebhookSignature(t *testing.T) {
+	gateway, fake, received := newTestGateway(t)
+	charge := createTestCharge(t, gateway, "")
+
+	if err := fake.SetStatus(charge.ID, ChargeStatusPaid); err != nil {
+		t.Fatalf("SetStatus: %v", err)
+	}
+
+	var delivered webhook
+	select {
+	case delivered = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no webhook delivered")
+	}
+
+	otherSecret := NewHTTPGateway(config.PaymentGateway{BaseURL: fake.URL(), WebhookSecret: "whsec_other"})
+	tampered := append([]byte(nil), delivered.payload...)
+	tampered[len(tampered)-2] ^= 1
+
+	tests := []struct {
+		name      string
+		gateway   PaymentGateway
+		payload   []byte
+		signature string
+		wantErr   error
+	}{
+		{name: "as delivered", gateway: gateway, payload: delivered.payload, signature: delivered.signature},
+		{name: "tampered payload", gateway: gateway, payload: tampered, signature: delivered.signature, wantErr: ErrInvalidSignature},
+		{name: "missing signature", gateway: gateway, payload: delivered.payload, signature: "", wantErr: ErrInvalidSignature},
+		{name: "signed with another secret", gateway: otherSecret, payload: delivered.payload, signature: delivered.signature, wantErr: ErrInvalidSignature},
+		{
+			name:      "signed but not an event",
+			gateway:   gateway,
+			payload:   []byte(`{"id":"evt_1"}`),
+			signature: Sign(testWebhookSecret, []byte(`{"id":"evt_1"}`)),
+			wantErr:   ErrInvalidEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := tt.gateway.ParseEvent(tt.payload, tt.signature)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseEvent() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseEvent() unexpected error: %v", err)
+			}
+
+			if event.Charge.ID != charge.ID || event.Charge.Status != ChargeStatusPaid {
+				t.Errorf("ParseEvent() charge = %s %s, want %s %s", event.Charge.ID, event.Charge.Status, charge.ID, ChargeStatusPaid)
+			}
+			if event.Type != "charge.paid" {
+				t.Errorf("ParseEvent() type = %q, want %q", event.Type, "charge.paid")
+			}
+		})
+	}
+}
+
+func TestIdempotentRequests(t *testing.T) {
+	ctx := context.Background()
+	gateway, fake, _ := newTestGateway(t)
+
+	first := createTestCharge(t, gateway, "payment-1")
+	if again := createTestCharge(t, gateway, "payment-1"); again.ID != first.ID {
+		t.Errorf("CreateCharge with the same key opened %s, want %s", again.ID, first.ID)
+	}
+	if other := createTestCharge(t, gateway, "payment-2"); other.ID == first.ID {
+		t.Errorf("CreateCharge with another key reused %s", first.ID)
+	}
+	if unkeyed := createTestCharge(t, gateway, ""); unkeyed.ID == first.ID {
+		t.Errorf("CreateCharge without a key reused %s", first.ID)
+	}
+
+	if err := fake.SetStatus(first.ID, ChargeStatusPaid); err != nil {
+		t.Fatalf("SetStatus: %v", err)
+	}
+
+	req := RefundRequest{ChargeID: first.ID, Amount: first.Amount, IdempotencyKey: "refund-1"}
+	refund, err := gateway.Refund(ctx, req)
+	if err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+
+	// The charge is refunded now, so only the replay can succeed
+	again, err := gateway.Refund(ctx, req)
+	if err != nil {
+		t.Fatalf("Refund retry: %v", err)
+	}
+	if again.ID != refund.ID {
+		t.Errorf("Refund with the same key issued %s, want %s", again.ID, refund.ID)
+	}
+
+	req.IdempotencyKey = "refund-2"
+	var apiErr *APIError
+	if _, err := gateway.Refund(ctx, req); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		t.Errorf("Refund with another key error = %v, want a %d", err, http.StatusConflict)
+	}
+}
@@ -0,0 +1,144 @@
+package paymentgateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/umardev500/laundry/internal/config"
+	"github.com/umardev500/laundry/pkg/money"
+	"github.com/umardev500/laundry/pkg/types"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of a webhook body.
+const SignatureHeader = "X-Callback-Signature"
+
+// IdempotencyKeyHeader makes a retried request return the result of the first
+// one instead of charging or refunding again.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// WebhookPath is where the API receives gateway notifications, relative to the app base URL.
+const WebhookPath = "/api/payments/webhook"
+
+var (
+	ErrChargeNotFound   = errors.New("gateway charge not found")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidEvent     = errors.New("invalid webhook event")
+
+	ErrGatewayNotConfigured = errors.New("payment_gateway.base_url is required outside development")
+)
+
+// ChargeStatus is the state of a charge as reported by the gateway.
+type ChargeStatus string
+
+const (
+	ChargeStatusPending  ChargeStatus = "pending"
+	ChargeStatusPaid     ChargeStatus = "paid"
+	ChargeStatusFailed   ChargeStatus = "failed"
+	ChargeStatusExpired  ChargeStatus = "expired"
+	ChargeStatusRefunded ChargeStatus = "refunded"
+)
+
+// ChargeRequest asks the gateway to collect an amount over a channel.
+type ChargeRequest struct {
+	// ReferenceID is our own identifier for the charge, echoed back in notifications.
+	ReferenceID string               `json:"reference_id"`
+	Amount      money.Money          `json:"amount"`
+	Channel     types.PaymentChannel `json:"channel"`
+	Description string               `json:"description,omitempty"`
+	ExpiresAt   time.Time            `json:"expires_at"`
+
+	// IdempotencyKey identifies the charge across retries, e.g. the payment ID.
+	IdempotencyKey string `json:"-"`
+}
+
+// Charge is a payment collected by the gateway. VANumber is set for virtual
+// accounts; PaymentURL is the e-wallet deeplink or the hosted card page.
+type Charge struct {
+	ID          string               `json:"id"`
+	ReferenceID string               `json:"reference_id"`
+	Channel     types.PaymentChannel `json:"channel"`
+	Amount      money.Money          `json:"amount"`
+	Status      ChargeStatus         `json:"status"`
+	VANumber    string               `json:"va_number,omitempty"`
+	PaymentURL  string               `json:"payment_url,omitempty"`
+	ExpiresAt   *time.Time           `json:"expires_at,omitempty"`
+	PaidAt      *time.Time           `json:"paid_at,omitempty"`
+}
+
+// RefundRequest returns an amount of a paid charge to the payer.
+type RefundRequest struct {
+	ChargeID string      `json:"-"`
+	Amount   money.Money `json:"amount"`
+	Reason   string      `json:"reason,omitempty"`
+
+	// IdempotencyKey identifies the refund across retries, e.g. the refund ID.
+	IdempotencyKey string `json:"-"`
+}
+
+// Refund is a refund issued by the gateway.
+type Refund struct {
+	ID       string       `json:"id"`
+	ChargeID string       `json:"charge_id"`
+	Amount   money.Money  `json:"amount"`
+	Status   ChargeStatus `json:"status"`
+}
+
+// Event is a webhook notification about a charge. The gateway retries until
+// it gets a 2xx response, so the same ID can arrive more than once.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Charge    Charge    `json:"data"`
+}
+
+// PaymentGateway talks to an online payment processor.
+type PaymentGateway interface {
+	// CreateCharge opens a virtual account, e-wallet or card charge. Repeating
+	// it with the same idempotency key returns the charge opened the first time.
+	CreateCharge(ctx context.Context, req ChargeRequest) (*Charge, error)
+
+	// GetCharge returns the current state of a charge.
+	GetCharge(ctx context.Context, id string) (*Charge, error)
+
+	// Refund returns money of a paid charge. Repeating it with the same
+	// idempotency key returns the refund issued the first time.
+	Refund(ctx context.Context, req RefundRequest) (*Refund, error)
+
+	// ParseEvent verifies the signature of a webhook payload and decodes it.
+	ParseEvent(payload []byte, signature string) (*Event, error)
+}
+
+// APIError is a non-2xx response from the gateway.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("payment gateway: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// NewPaymentGateway returns an HTTP gateway client. In development it falls
+// back to a local fake gateway when no base URL is configured; anywhere else a
+// base URL is required, since the fake settles charges for anyone who asks.
+func NewPaymentGateway(cfg *config.Config) (PaymentGateway, error) {
+	gwCfg := cfg.Payment
+	if gwCfg.BaseURL == "" {
+		if cfg.App.Env != "development" {
+			return nil, ErrGatewayNotConfigured
+		}
+
+		webhookURL := strings.TrimRight(cfg.App.BaseURL, "/") + WebhookPath
+		fake := NewFakeServer(gwCfg.WebhookSecret, webhookURL)
+		log.Warn().Str("url", fake.URL()).Msg("Payment gateway is not configured, using a local fake gateway")
+		gwCfg.BaseURL = fake.URL()
+	}
+
+	return NewHTTPGateway(gwCfg), nil
+}
@@ -0,0 +1,131 @@
+package paymentgateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/umardev500/laundry/internal/config"
+)
+
+const defaultTimeout = 15 * time.Second
+
+type httpGateway struct {
+	baseURL       string
+	serverKey     string
+	webhookSecret string
+	client        *http.Client
+}
+
+// NewHTTPGateway returns a client for a gateway with a virtual account and
+// e-wallet charge API in the style of Midtrans and Xendit. Requests are
+// authenticated with the server key as the basic auth username.
+func NewHTTPGateway(cfg config.PaymentGateway) PaymentGateway {
+	timeout := defaultTimeout
+	if cfg.TimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+
+	return &httpGateway{
+		baseURL:       strings.TrimRight(cfg.BaseURL, "/"),
+		serverKey:     cfg.ServerKey,
+		webhookSecret: cfg.WebhookSecret,
+		client:        &http.Client{Timeout: timeout},
+	}
+}
+
+// CreateCharge implements PaymentGateway.
+func (g *httpGateway) CreateCharge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	var charge Charge
+	if err := g.do(ctx, http.MethodPost, "/v1/charges", req.IdempotencyKey, req, &charge); err != nil {
+		return nil, err
+	}
+	return &charge, nil
+}
+
+// GetCharge implements PaymentGateway.
+func (g *httpGateway) GetCharge(ctx context.Context, id string) (*Charge, error) {
+	var charge Charge
+	if err := g.do(ctx, http.MethodGet, "/v1/charges/"+url.PathEscape(id), "", nil, &charge); err != nil {
+		return nil, err
+	}
+	return &charge, nil
+}
+
+// Refund implements PaymentGateway.
+func (g *httpGateway) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	var refund Refund
+	if err := g.do(ctx, http.MethodPost, "/v1/charges/"+url.PathEscape(req.ChargeID)+"/refunds", req.IdempotencyKey, req, &refund); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// ParseEvent implements PaymentGateway.
+func (g *httpGateway) ParseEvent(payload []byte, signature string) (*Event, error) {
+	if !VerifySignature(g.webhookSecret, payload, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	if event.ID == "" || event.Charge.ID == "" {
+		return nil, ErrInvalidEvent
+	}
+
+	return &event, nil
+}
+
+// do sends a JSON request and decodes the JSON response into out. A non-empty
+// idempotencyKey is passed on so the gateway can recognise retries.
+func (g *httpGateway) do(ctx context.Context, method, path, idempotencyKey string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, g.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(g.serverKey, "")
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("payment gateway: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrChargeNotFound
+	}
+
+	if res.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: res.StatusCode}
+		if err := json.NewDecoder(res.Body).Decode(apiErr); err != nil {
+			apiErr.Message = http.StatusText(res.StatusCode)
+		}
+		return apiErr
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -0,0 +1,33 @@
+package paymentgateway
+
+import "testing"
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "whsec_test"
+	payload := []byte(`{"id":"evt_1","type":"charge.paid"}`)
+	signature := Sign(secret, payload)
+
+	tests := []struct {
+		name      string
+		secret    string
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, payload: payload, signature: signature, want: true},
+		{name: "other secret", secret: "whsec_other", payload: payload, signature: signature},
+		{name: "changed payload", secret: secret, payload: []byte(`{"id":"evt_1","type":"charge.failed"}`), signature: signature},
+		{name: "changed signature", secret: secret, payload: payload, signature: Sign(secret, []byte("other"))},
+		{name: "not hex", secret: secret, payload: payload, signature: "not-a-signature"},
+		{name: "no signature", secret: secret, payload: payload, signature: ""},
+		{name: "no secret configured", secret: "", payload: payload, signature: Sign("", payload)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.payload, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
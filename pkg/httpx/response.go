@@ -91,6 +91,10 @@ func Forbidden(c *fiber.Ctx, msg string) error {
 	return c.Status(fiber.StatusForbidden).JSON(Error(msg))
 }
 
+func TooManyRequests(c *fiber.Ctx, msg string) error {
+	return c.Status(fiber.StatusTooManyRequests).JSON(Error(msg))
+}
+
 func NotFound(c *fiber.Ctx, msg string) error {
 	return c.Status(fiber.StatusNotFound).JSON(Error(msg))
 }
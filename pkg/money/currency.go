@@ -0,0 +1,40 @@
+package money
+
+import (
+	"errors"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const (
+	IDR Currency = "IDR"
+)
+
+// DefaultCurrency is the currency every amount is in.
+const DefaultCurrency = IDR
+
+var ErrUnsupportedCurrency = errors.New("only " + string(DefaultCurrency) + " amounts are supported")
+
+// Normalize upper-cases the code and falls back to DefaultCurrency when empty.
+func (c Currency) Normalize() Currency {
+	if c == "" {
+		return DefaultCurrency
+	}
+	return Currency(strings.ToUpper(string(c)))
+}
+
+// Validate reports whether amounts in the currency can be handled, which is
+// only the case for DefaultCurrency. An empty code stands for it.
+func (c Currency) Validate() error {
+	if c.Normalize() != DefaultCurrency {
+		return ErrUnsupportedCurrency
+	}
+	return nil
+}
+
+// String returns the currency code.
+func (c Currency) String() string {
+	return string(c)
+}
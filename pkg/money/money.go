@@ -0,0 +1,300 @@
+// Package money represents amounts of money as integer minor units so sums,
+// change and refunds always add up exactly.
+//
+// Money does not carry a currency. The system is single-currency: every
+// amount is in DefaultCurrency, and the currency stored on orders and plans
+// only states it. Currencies coming in from outside are checked with
+// Currency.Validate, so amounts in different currencies never meet.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in minor units, hundredths of the currency's major unit.
+//
+// Being an integer, amounts can be added, subtracted and compared with the
+// usual operators. Anything that can produce a fraction of a minor unit goes
+// through a method that rounds half away from zero.
+type Money int64
+
+// Scale is the number of minor units in one major unit.
+const Scale = 100
+
+// Decimals is the number of decimal places an amount is written with.
+const Decimals = 2
+
+var (
+	ErrInvalidAmount = errors.New("money amount must be a decimal number")
+	ErrTooPrecise    = errors.New("money amount must have at most two decimal places")
+	ErrOverflow      = errors.New("money amount is out of range")
+)
+
+// Zero is no money.
+const Zero Money = 0
+
+// FromMinor returns an amount of minor units.
+func FromMinor(minor int64) Money {
+	return Money(minor)
+}
+
+// FromMajor returns an amount of whole major units, e.g. rupiah.
+func FromMajor(major int64) Money {
+	return Money(major * Scale)
+}
+
+// FromFloat converts a major-unit float, rounding half away from zero to the
+// nearest minor unit. It is meant for data coming from outside the system;
+// calculations should stay in Money.
+func FromFloat(f float64) Money {
+	return Money(math.Round(f * Scale))
+}
+
+// Parse reads a decimal amount such as "15000", "-2500.5" or "99.95".
+// Amounts with more than two decimal places are rejected rather than rounded.
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, ErrInvalidAmount
+	}
+	if len(frac) > Decimals {
+		if strings.Trim(frac[Decimals:], "0") != "" {
+			return 0, ErrTooPrecise
+		}
+		frac = frac[:Decimals]
+	}
+	frac += strings.Repeat("0", Decimals-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	if !isDigits(whole) || !isDigits(frac) {
+		return 0, ErrInvalidAmount
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > math.MaxInt64/Scale {
+		return 0, ErrOverflow
+	}
+	minor, _ := strconv.ParseInt(frac, 10, 64)
+	if major == math.MaxInt64/Scale && minor > math.MaxInt64%Scale {
+		return 0, ErrOverflow
+	}
+
+	m := Money(major*Scale + minor)
+	if neg {
+		m = -m
+	}
+	return m, nil
+}
+
+// MustParse is like Parse but panics on error. It is meant for constants
+// and seed data.
+func MustParse(s string) Money {
+	m, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: %q: %v", s, err))
+	}
+	return m
+}
+
+// Minor returns the amount in minor units.
+func (m Money) Minor() int64 {
+	return int64(m)
+}
+
+// Float64 returns the amount in major units. Only use it at the edges, e.g.
+// for third parties that expect a float.
+func (m Money) Float64() float64 {
+	return float64(m) / Scale
+}
+
+// Mul multiplies the amount by a quantity such as a weight in kg.
+func (m Money) Mul(qty float64) Money {
+	return Money(math.Round(float64(m) * qty))
+}
+
+// Div divides the amount by a factor, e.g. to take a rate back out of a
+// gross amount.
+func (m Money) Div(factor float64) Money {
+	return Money(math.Round(float64(m) / factor))
+}
+
+// Percent returns pct percent of the amount, e.g. Percent(12.5) for 12.5%.
+func (m Money) Percent(pct float64) Money {
+	return Money(math.Round(float64(m) * pct / 100))
+}
+
+// Allocate splits the amount across the weights in proportion to them. The
+// parts always add up to the amount; the minor units lost to rounding go to
+// the largest weights first. Without positive weights everything goes to the
+// first part.
+func (m Money) Allocate(weights []Money) []Money {
+	parts := make([]Money, len(weights))
+	if len(weights) == 0 {
+		return parts
+	}
+
+	total := Sum(weights...)
+	if total <= 0 {
+		parts[0] = m
+		return parts
+	}
+
+	var allocated Money
+	for i, w := range weights {
+		parts[i] = Money(math.Trunc(float64(m) * float64(w) / float64(total)))
+		allocated += parts[i]
+	}
+
+	// Hand out the remainder one minor unit at a time
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return weights[order[a]] > weights[order[b]] })
+
+	step := Money(1)
+	if m < 0 {
+		step = -1
+	}
+	for i := 0; allocated != m; i = (i + 1) % len(order) {
+		parts[order[i]] += step
+		allocated += step
+	}
+
+	return parts
+}
+
+// MulInt multiplies the amount by a whole number.
+func (m Money) MulInt(n int64) Money {
+	return m * Money(n)
+}
+
+// Neg returns the amount with its sign flipped.
+func (m Money) Neg() Money {
+	return -m
+}
+
+// Abs returns the amount without its sign.
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+// IsPositive reports whether the amount is above zero.
+func (m Money) IsPositive() bool {
+	return m > 0
+}
+
+// IsNegative reports whether the amount is below zero.
+func (m Money) IsNegative() bool {
+	return m < 0
+}
+
+// Min returns the smaller of two amounts.
+func Min(a, b Money) Money {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Max returns the larger of two amounts.
+func Max(a, b Money) Money {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Sum adds up amounts.
+func Sum(amounts ...Money) Money {
+	var total Money
+	for _, a := range amounts {
+		total += a
+	}
+	return total
+}
+
+// String renders the amount in major units, whole amounts without decimals
+// and anything else with two, e.g. "15000" or "15000.50".
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+
+	major, minor := v/Scale, v%Scale
+	if minor == 0 {
+		return fmt.Sprintf("%s%d", sign, major)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, major, minor)
+}
+
+// MarshalJSON writes the amount as a JSON number in major units.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number or numeric string in major units.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	// Numbers are parsed from their text so no float rounding sneaks in
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalText writes the amount in major units.
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText reads an amount in major units, e.g. from a query string.
+func (m *Money) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
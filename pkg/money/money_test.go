@@ -0,0 +1,210 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr error
+	}{
+		{in: "15000", want: 1500000},
+		{in: "99.95", want: 9995},
+		{in: "0.5", want: 50},
+		{in: ".5", want: 50},
+		{in: "5.", want: 500},
+		{in: " 7.1 ", want: 710},
+		{in: "+12", want: 1200},
+		{in: "-2500.5", want: -250050},
+		{in: "-0.01", want: -1},
+		{in: "1.230", want: 123},
+		{in: "92233720368547758.07", want: 9223372036854775807},
+
+		// More precision than a minor unit is rejected, never rounded
+		{in: "1.234", wantErr: ErrTooPrecise},
+		{in: "-0.005", wantErr: ErrTooPrecise},
+
+		{in: "", wantErr: ErrInvalidAmount},
+		{in: "-", wantErr: ErrInvalidAmount},
+		{in: ".", wantErr: ErrInvalidAmount},
+		{in: "abc", wantErr: ErrInvalidAmount},
+		{in: "12a", wantErr: ErrInvalidAmount},
+		{in: "1,5", wantErr: ErrInvalidAmount},
+		{in: "--5", wantErr: ErrInvalidAmount},
+		{in: "1e3", wantErr: ErrInvalidAmount},
+
+		{in: "92233720368547758.08", wantErr: ErrOverflow},
+		{in: "92233720368547759", wantErr: ErrOverflow},
+		{in: "99999999999999999999", wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		qty  float64
+		want Money
+	}{
+		{name: "whole quantity", m: 700000, qty: 3, want: 2100000},
+		{name: "fractional weight", m: 700000, qty: 1.25, want: 875000},
+		{name: "half rounds up", m: 333, qty: 0.5, want: 167},
+		{name: "negative half rounds away from zero", m: -333, qty: 0.5, want: -167},
+		{name: "below half rounds down", m: 100, qty: 0.333, want: 33},
+		{name: "zero quantity", m: 700000, qty: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Mul(tt.qty); got != tt.want {
+				t.Errorf("%d.Mul(%v) = %d, want %d", tt.m, tt.qty, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		pct  float64
+		want Money
+	}{
+		{name: "ppn", m: 1000000, pct: 11, want: 110000},
+		{name: "fractional rate", m: 12345, pct: 12.5, want: 1543},
+		{name: "half rounds up", m: 5, pct: 50, want: 3},
+		{name: "negative half rounds away from zero", m: -5, pct: 50, want: -3},
+		{name: "whole amount", m: 4200, pct: 100, want: 4200},
+		{name: "zero rate", m: 4200, pct: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Percent(tt.pct); got != tt.want {
+				t.Errorf("%d.Percent(%v) = %d, want %d", tt.m, tt.pct, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Money
+		weights []Money
+		want    []Money
+	}{
+		{name: "even split", m: 300, weights: []Money{1, 1, 1}, want: []Money{100, 100, 100}},
+		{name: "remainder to first of equal weights", m: 100, weights: []Money{1, 1, 1}, want: []Money{34, 33, 33}},
+		{name: "remainder to largest weight", m: 100, weights: []Money{1, 2}, want: []Money{33, 67}},
+		{name: "remainder across largest weights", m: 101, weights: []Money{1, 3, 3, 3}, want: []Money{10, 31, 30, 30}},
+		{name: "proportional", m: 1000, weights: []Money{300, 700}, want: []Money{300, 700}},
+		{name: "negative amount", m: -100, weights: []Money{1, 1, 1}, want: []Money{-34, -33, -33}},
+		{name: "zero weights go to first part", m: 1000, weights: []Money{0, 0}, want: []Money{1000, 0}},
+		{name: "no weights", m: 1000, weights: nil, want: []Money{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.m.Allocate(tt.weights)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Allocate(%v) = %v, want %v", tt.weights, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Allocate(%v) = %v, want %v", tt.weights, got, tt.want)
+				}
+			}
+			if len(got) > 0 && Sum(got...) != tt.m {
+				t.Errorf("Allocate(%v) parts add up to %d, want %d", tt.weights, Sum(got...), tt.m)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{m: 1500000, want: "15000"},
+		{m: 1500050, want: "15000.50"},
+		{m: 9995, want: "99.95"},
+		{m: -5, want: "-0.05"},
+		{m: 0, want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.m.String(); got != tt.want {
+				t.Errorf("Money(%d).String() = %q, want %q", tt.m, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Money
+	}{
+		{in: `15000.5`, want: 1500050},
+		{in: `"15000.5"`, want: 1500050},
+		{in: `-20`, want: -2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var got Money
+			if err := got.UnmarshalJSON([]byte(tt.in)); err != nil {
+				t.Fatalf("UnmarshalJSON(%s) unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyValidate(t *testing.T) {
+	tests := []struct {
+		c       Currency
+		wantErr bool
+	}{
+		{c: "", wantErr: false},
+		{c: "IDR", wantErr: false},
+		{c: "idr", wantErr: false},
+		{c: "USD", wantErr: true},
+		{c: "SGD", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.c), func(t *testing.T) {
+			err := tt.c.Validate()
+			if tt.wantErr != errors.Is(err, ErrUnsupportedCurrency) {
+				t.Errorf("Currency(%q).Validate() = %v, wantErr %v", tt.c, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Package ordercode formats the human-readable order codes tenants print on
+// receipts and bag tags, e.g. LDR-2610-000123.
+package ordercode
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPrefix = errors.New("order code prefix must be 1 to 10 letters or digits")
+	ErrInvalidReset  = errors.New("order code reset must be monthly or yearly")
+)
+
+// DefaultPrefix is used by tenants that have not picked their own.
+const DefaultPrefix = "LDR"
+
+// digits is how wide the sequence number is padded.
+const digits = 6
+
+var prefixPattern = regexp.MustCompile(`^[A-Z0-9]{1,10}$`)
+
+// Reset is how often the sequence starts over at 1.
+type Reset string
+
+const (
+	ResetMonthly Reset = "monthly"
+	ResetYearly  Reset = "yearly"
+)
+
+func (r Reset) Normalize() Reset {
+	return Reset(strings.ToLower(strings.TrimSpace(string(r))))
+}
+
+// IsValid reports whether the reset is one of the known resets.
+func (r Reset) IsValid() bool {
+	return r == ResetMonthly || r == ResetYearly
+}
+
+// Format is how a tenant's order codes look.
+type Format struct {
+	Prefix string
+	Reset  Reset
+}
+
+// Default is the format of tenants that have not set their own.
+var Default = Format{Prefix: DefaultPrefix, Reset: ResetMonthly}
+
+// Normalize tidies the prefix and reset, falling back to the defaults when empty.
+func (f *Format) Normalize() {
+	f.Prefix = strings.ToUpper(strings.TrimSpace(f.Prefix))
+	if f.Prefix == "" {
+		f.Prefix = DefaultPrefix
+	}
+
+	f.Reset = f.Reset.Normalize()
+	if f.Reset == "" {
+		f.Reset = ResetMonthly
+	}
+}
+
+// Validate checks the prefix and reset.
+func (f Format) Validate() error {
+	if !prefixPattern.MatchString(f.Prefix) {
+		return ErrInvalidPrefix
+	}
+	if !f.Reset.IsValid() {
+		return ErrInvalidReset
+	}
+	return nil
+}
+
+// Period returns the sequence period t falls in: YYMM when codes reset
+// monthly, YY when they reset yearly.
+func (f Format) Period(t time.Time) string {
+	if f.Reset == ResetYearly {
+		return t.Format("06")
+	}
+	return t.Format("0601")
+}
+
+// Code returns the code of the seq-th order of the period.
+func (f Format) Code(period string, seq int) string {
+	return fmt.Sprintf("%s-%s-%0*d", f.Prefix, period, digits, seq)
+}
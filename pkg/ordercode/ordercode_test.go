@@ -0,0 +1,78 @@
+package ordercode
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	october := time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format Format
+		at     time.Time
+		seq    int
+		want   string
+	}{
+		{name: "default", format: Default, at: october, seq: 123, want: "LDR-2610-000123"},
+		{name: "yearly", format: Format{Prefix: "AB", Reset: ResetYearly}, at: october, seq: 7, want: "AB-26-000007"},
+		{name: "first of the month", format: Default, at: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), seq: 1, want: "LDR-2701-000001"},
+		{name: "wider than the padding", format: Default, at: october, seq: 1234567, want: "LDR-2610-1234567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.format.Code(tt.format.Period(tt.at), tt.seq); got != tt.want {
+				t.Errorf("Code() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Format
+		want Format
+	}{
+		{name: "empty", in: Format{}, want: Default},
+		{name: "tidied", in: Format{Prefix: " ldr2 ", Reset: " Yearly "}, want: Format{Prefix: "LDR2", Reset: ResetYearly}},
+		{name: "kept", in: Format{Prefix: "WASH", Reset: ResetMonthly}, want: Format{Prefix: "WASH", Reset: ResetMonthly}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.in
+			got.Normalize()
+			if got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		wantErr error
+	}{
+		{name: "default", format: Default},
+		{name: "longest prefix", format: Format{Prefix: "ABCDEFGHIJ", Reset: ResetYearly}},
+		{name: "empty prefix", format: Format{Reset: ResetMonthly}, wantErr: ErrInvalidPrefix},
+		{name: "prefix too long", format: Format{Prefix: "ABCDEFGHIJK", Reset: ResetMonthly}, wantErr: ErrInvalidPrefix},
+		{name: "prefix with a dash", format: Format{Prefix: "LD-R", Reset: ResetMonthly}, wantErr: ErrInvalidPrefix},
+		{name: "lowercase prefix", format: Format{Prefix: "ldr", Reset: ResetMonthly}, wantErr: ErrInvalidPrefix},
+		{name: "unknown reset", format: Format{Prefix: "LDR", Reset: "daily"}, wantErr: ErrInvalidReset},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.format.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
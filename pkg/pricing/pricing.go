@@ -0,0 +1,160 @@
+// Package pricing works out what a quantity of a service costs under the
+// rules of a price list, such as minimum weights and volume tiers.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+var (
+	ErrInvalidMinQuantity  = errors.New("minimum quantity cannot be negative")
+	ErrInvalidQuantityStep = errors.New("quantity step cannot be negative")
+	ErrInvalidMinCharge    = errors.New("minimum charge cannot be negative")
+	ErrInvalidTierPrice    = errors.New("tier price cannot be negative")
+	ErrInvalidTierOrder    = errors.New("tiers must run to increasing quantities, only the last one may be open-ended")
+)
+
+// Rules are the pricing rules of a service. Without them a service costs its
+// base price times the quantity.
+type Rules struct {
+	MinQuantity  float64     `json:"min_quantity,omitempty"`  // bill at least this much, e.g. 3 kg
+	QuantityStep float64     `json:"quantity_step,omitempty"` // round the quantity up to a multiple of it, e.g. 0.5 kg
+	Tiers        []Tier      `json:"tiers,omitempty"`         // volume tiers replacing the base price
+	MinCharge    money.Money `json:"min_charge,omitempty"`    // charge at least this much
+}
+
+// Tier prices the part of the quantity up to UpTo that earlier tiers do not
+// cover, e.g. the first 5 kg at 7000 and the rest at 6000.
+type Tier struct {
+	UpTo  float64     `json:"up_to,omitempty"` // 0 for no limit; the last tier always takes the rest
+	Price money.Money `json:"price"`           // per unit
+}
+
+// Rule names a pricing rule.
+type Rule string
+
+const (
+	RuleQuantityStep Rule = "quantity_step"
+	RuleMinQuantity  Rule = "min_quantity"
+	RuleTiers        Rule = "tiers"
+	RuleMinCharge    Rule = "min_charge"
+)
+
+// Applied explains a rule that changed the price.
+type Applied struct {
+	Rule        Rule   `json:"rule"`
+	Description string `json:"description"`
+}
+
+// Quote is the price of a quantity.
+type Quote struct {
+	Quantity float64 // quantity billed after rounding and the minimum
+	Amount   money.Money
+	Applied  []Applied
+}
+
+// Validate checks the rules make sense.
+func (r *Rules) Validate() error {
+	if r.MinQuantity < 0 {
+		return ErrInvalidMinQuantity
+	}
+	if r.QuantityStep < 0 {
+		return ErrInvalidQuantityStep
+	}
+	if r.MinCharge < 0 {
+		return ErrInvalidMinCharge
+	}
+
+	var last float64
+	for i, t := range r.Tiers {
+		if t.Price < 0 {
+			return ErrInvalidTierPrice
+		}
+		if t.UpTo == 0 && i < len(r.Tiers)-1 {
+			return ErrInvalidTierOrder
+		}
+		if t.UpTo != 0 && t.UpTo <= last {
+			return ErrInvalidTierOrder
+		}
+		last = t.UpTo
+	}
+
+	return nil
+}
+
+// IsZero reports whether there are no rules.
+func (r *Rules) IsZero() bool {
+	return r == nil || (r.MinQuantity == 0 && r.QuantityStep == 0 && len(r.Tiers) == 0 && r.MinCharge == 0)
+}
+
+// Quote prices a quantity of a service with the given base price. The rules
+// apply in order: rounding, minimum quantity, tiers, then minimum charge.
+func (r *Rules) Quote(basePrice money.Money, quantity float64) Quote {
+	if r.IsZero() {
+		return Quote{Quantity: quantity, Amount: basePrice.Mul(quantity)}
+	}
+
+	q := Quote{Quantity: quantity}
+
+	if r.QuantityStep > 0 {
+		// The epsilon keeps float noise such as 1.1/0.1 = 11.000000000000002 from rounding up a step
+		steps := math.Ceil(quantity/r.QuantityStep - 1e-9)
+		billed := math.Round(steps*r.QuantityStep*1e6) / 1e6
+		if billed != q.Quantity {
+			q.Applied = append(q.Applied, Applied{
+				Rule:        RuleQuantityStep,
+				Description: fmt.Sprintf("%g rounded up to %g in steps of %g", q.Quantity, billed, r.QuantityStep),
+			})
+			q.Quantity = billed
+		}
+	}
+
+	if q.Quantity < r.MinQuantity {
+		q.Applied = append(q.Applied, Applied{
+			Rule:        RuleMinQuantity,
+			Description: fmt.Sprintf("minimum of %g charged for %g", r.MinQuantity, q.Quantity),
+		})
+		q.Quantity = r.MinQuantity
+	}
+
+	if len(r.Tiers) == 0 {
+		q.Amount = basePrice.Mul(q.Quantity)
+	} else {
+		var parts []string
+		var from float64
+		for i, t := range r.Tiers {
+			if q.Quantity <= from {
+				break
+			}
+
+			to := q.Quantity
+			if t.UpTo != 0 && t.UpTo < to && i < len(r.Tiers)-1 {
+				to = t.UpTo
+			}
+
+			q.Amount += t.Price.Mul(to - from)
+			parts = append(parts, fmt.Sprintf("%g at %s", to-from, t.Price))
+			from = to
+		}
+
+		q.Applied = append(q.Applied, Applied{
+			Rule:        RuleTiers,
+			Description: strings.Join(parts, ", then "),
+		})
+	}
+
+	if q.Amount < r.MinCharge {
+		q.Applied = append(q.Applied, Applied{
+			Rule:        RuleMinCharge,
+			Description: fmt.Sprintf("minimum charge of %s instead of %s", r.MinCharge, q.Amount),
+		})
+		q.Amount = r.MinCharge
+	}
+
+	return q
+}
@@ -0,0 +1,185 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+func TestQuote(t *testing.T) {
+	basePrice := money.FromMajor(7000)
+	volumeTiers := []Tier{
+		{UpTo: 5, Price: money.FromMajor(7000)},
+		{Price: money.FromMajor(6000)},
+	}
+
+	tests := []struct {
+		name         string
+		rules        *Rules
+		quantity     float64
+		wantQuantity float64
+		wantAmount   money.Money
+		wantApplied  []Rule
+	}{
+		{
+			name:         "no rules",
+			rules:        nil,
+			quantity:     2.5,
+			wantQuantity: 2.5,
+			wantAmount:   money.FromMajor(17500),
+		},
+		{
+			name:         "fraction of a minor unit rounds half up",
+			rules:        &Rules{},
+			quantity:     0.5,
+			wantQuantity: 0.5,
+			wantAmount:   money.FromMajor(3500),
+		},
+		{
+			name:         "quantity rounded up to the step",
+			rules:        &Rules{QuantityStep: 0.5},
+			quantity:     2.2,
+			wantQuantity: 2.5,
+			wantAmount:   money.FromMajor(17500),
+			wantApplied:  []Rule{RuleQuantityStep},
+		},
+		{
+			name:         "float noise does not round up a step",
+			rules:        &Rules{QuantityStep: 0.1},
+			quantity:     1.1,
+			wantQuantity: 1.1,
+			wantAmount:   money.FromMajor(7700),
+		},
+		{
+			name:         "minimum quantity",
+			rules:        &Rules{MinQuantity: 3},
+			quantity:     2,
+			wantQuantity: 3,
+			wantAmount:   money.FromMajor(21000),
+			wantApplied:  []Rule{RuleMinQuantity},
+		},
+		{
+			name:         "within the first tier",
+			rules:        &Rules{Tiers: volumeTiers},
+			quantity:     4,
+			wantQuantity: 4,
+			wantAmount:   money.FromMajor(28000),
+			wantApplied:  []Rule{RuleTiers},
+		},
+		{
+			name:         "across tiers",
+			rules:        &Rules{Tiers: volumeTiers},
+			quantity:     8,
+			wantQuantity: 8,
+			wantAmount:   money.FromMajor(5*7000 + 3*6000),
+			wantApplied:  []Rule{RuleTiers},
+		},
+		{
+			name: "last tier takes the rest past its limit",
+			rules: &Rules{Tiers: []Tier{
+				{UpTo: 5, Price: money.FromMajor(7000)},
+				{UpTo: 10, Price: money.FromMajor(6000)},
+			}},
+			quantity:     12,
+			wantQuantity: 12,
+			wantAmount:   money.FromMajor(5*7000 + 7*6000),
+			wantApplied:  []Rule{RuleTiers},
+		},
+		{
+			name:         "minimum charge",
+			rules:        &Rules{MinCharge: money.FromMajor(25000)},
+			quantity:     1,
+			wantQuantity: 1,
+			wantAmount:   money.FromMajor(25000),
+			wantApplied:  []Rule{RuleMinCharge},
+		},
+		{
+			name:         "minimum charge below the price",
+			rules:        &Rules{MinCharge: money.FromMajor(25000)},
+			quantity:     4,
+			wantQuantity: 4,
+			wantAmount:   money.FromMajor(28000),
+		},
+		{
+			name: "rules apply in order",
+			rules: &Rules{
+				QuantityStep: 0.5,
+				MinQuantity:  3,
+				Tiers:        volumeTiers,
+				MinCharge:    money.FromMajor(25000),
+			},
+			quantity:     2.3,
+			wantQuantity: 3,
+			wantAmount:   money.FromMajor(25000),
+			wantApplied:  []Rule{RuleQuantityStep, RuleMinQuantity, RuleTiers, RuleMinCharge},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rules.Quote(basePrice, tt.quantity)
+
+			if got.Quantity != tt.wantQuantity {
+				t.Errorf("Quantity = %g, want %g", got.Quantity, tt.wantQuantity)
+			}
+			if got.Amount != tt.wantAmount {
+				t.Errorf("Amount = %s, want %s", got.Amount, tt.wantAmount)
+			}
+
+			var applied []Rule
+			for _, a := range got.Applied {
+				applied = append(applied, a.Rule)
+			}
+			if len(applied) != len(tt.wantApplied) {
+				t.Fatalf("Applied = %v, want %v", applied, tt.wantApplied)
+			}
+			for i := range applied {
+				if applied[i] != tt.wantApplied[i] {
+					t.Fatalf("Applied = %v, want %v", applied, tt.wantApplied)
+				}
+			}
+		})
+	}
+}
+
+func TestRulesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   Rules
+		wantErr error
+	}{
+		{
+			name: "valid",
+			rules: Rules{
+				MinQuantity:  3,
+				QuantityStep: 0.5,
+				Tiers:        []Tier{{UpTo: 5, Price: 700000}, {Price: 600000}},
+				MinCharge:    2500000,
+			},
+		},
+		{name: "negative minimum quantity", rules: Rules{MinQuantity: -1}, wantErr: ErrInvalidMinQuantity},
+		{name: "negative step", rules: Rules{QuantityStep: -0.5}, wantErr: ErrInvalidQuantityStep},
+		{name: "negative minimum charge", rules: Rules{MinCharge: -1}, wantErr: ErrInvalidMinCharge},
+		{name: "negative tier price", rules: Rules{Tiers: []Tier{{Price: -1}}}, wantErr: ErrInvalidTierPrice},
+		{
+			name:    "open-ended tier before the last",
+			rules:   Rules{Tiers: []Tier{{Price: 700000}, {UpTo: 10, Price: 600000}}},
+			wantErr: ErrInvalidTierOrder,
+		},
+		{
+			name:    "tiers not increasing",
+			rules:   Rules{Tiers: []Tier{{UpTo: 5, Price: 700000}, {UpTo: 5, Price: 600000}}},
+			wantErr: ErrInvalidTierOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
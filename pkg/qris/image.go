@@ -0,0 +1,51 @@
+package qris
+
+import (
+	"fmt"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// DefaultImageSize is the rendered width and height in pixels.
+const DefaultImageSize = 320
+
+// PNG renders the payload as a square PNG of size pixels.
+func PNG(payload string, size int) ([]byte, error) {
+	qr, err := qrcode.New(payload, qrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("encode qr code: %w", err)
+	}
+
+	return qr.PNG(size)
+}
+
+// SVG renders the payload as a square SVG of size pixels. Each dark module
+// becomes part of a single path so the image scales without blurring.
+func SVG(payload string, size int) ([]byte, error) {
+	qr, err := qrcode.New(payload, qrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("encode qr code: %w", err)
+	}
+
+	// Bitmap includes the quiet zone.
+	bitmap := qr.Bitmap()
+	modules := len(bitmap)
+
+	var path strings.Builder
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&path, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, modules, modules)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/>`, modules, modules)
+	fmt.Fprintf(&b, `<path d="%s" fill="#000"/>`, path.String())
+	b.WriteString(`</svg>`)
+
+	return []byte(b.String()), nil
+}
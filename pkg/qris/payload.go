@@ -0,0 +1,212 @@
+package qris
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// EMVCo merchant-presented QR tags used by QRIS.
+const (
+	tagPayloadFormat     = "00"
+	tagInitiationMethod  = "01"
+	tagMerchantQRIS      = "51"
+	tagMerchantCategory  = "52"
+	tagCurrency          = "53"
+	tagAmount            = "54"
+	tagCountry           = "58"
+	tagMerchantName      = "59"
+	tagMerchantCity      = "60"
+	tagPostalCode        = "61"
+	tagAdditionalData    = "62"
+	tagCRC               = "63"
+	subtagGUID           = "00"
+	subtagNMID           = "02"
+	subtagCriteria       = "03"
+	subtagReferenceLabel = "05"
+)
+
+const (
+	payloadFormat    = "01"
+	dynamicQR        = "12"
+	qrisGUID         = "ID.CO.QRIS.WWW"
+	currencyIDR      = "360"
+	countryIndonesia = "ID"
+
+	// DefaultMCC is the merchant category code for laundry services.
+	DefaultMCC = "7210"
+	// DefaultCriteria is the micro business (UMI) merchant criteria.
+	DefaultCriteria = "UMI"
+
+	maxNameLength   = 25
+	maxCityLength   = 15
+	maxAmountLength = 13
+)
+
+var (
+	ErrMerchantNameRequired = errors.New("qris merchant name is required")
+	ErrMerchantNameTooLong  = errors.New("qris merchant name must be at most 25 characters")
+	ErrMerchantCityRequired = errors.New("qris merchant city is required")
+	ErrMerchantCityTooLong  = errors.New("qris merchant city must be at most 15 characters")
+	ErrInvalidNMID          = errors.New("qris NMID must be ID followed by 10 to 13 digits")
+	ErrInvalidMCC           = errors.New("qris merchant category code must be 4 digits")
+	ErrInvalidPostalCode    = errors.New("qris postal code must be 5 digits")
+	ErrInvalidCriteria      = errors.New("qris merchant criteria must be UMI, UKE, UME or UBE")
+	ErrInvalidAmount        = errors.New("qris amount must be greater than zero")
+	ErrReferenceTooLong     = errors.New("qris reference must be at most 25 characters")
+)
+
+var (
+	nmidPattern   = regexp.MustCompile(`^ID\d{10,13}$`)
+	digitsPattern = regexp.MustCompile(`^\d+$`)
+	criteria      = []string{"UMI", "UKE", "UME", "UBE"}
+)
+
+// Merchant is a merchant registered with the QRIS network.
+type Merchant struct {
+	Name       string
+	City       string
+	PostalCode string // optional
+	NMID       string // National Merchant ID issued by the acquirer
+	MCC        string // merchant category code, DefaultMCC when empty
+	Criteria   string // business size, DefaultCriteria when empty
+}
+
+// Normalize fills in defaults and tidies the fields.
+func (m *Merchant) Normalize() {
+	m.Name = strings.TrimSpace(m.Name)
+	m.City = strings.TrimSpace(m.City)
+	m.PostalCode = strings.TrimSpace(m.PostalCode)
+	m.NMID = strings.ToUpper(strings.TrimSpace(m.NMID))
+	m.Criteria = strings.ToUpper(strings.TrimSpace(m.Criteria))
+
+	if m.MCC == "" {
+		m.MCC = DefaultMCC
+	}
+	if m.Criteria == "" {
+		m.Criteria = DefaultCriteria
+	}
+}
+
+// Validate checks the fields against the QRIS length and format rules.
+func (m *Merchant) Validate() error {
+	switch {
+	case m.Name == "":
+		return ErrMerchantNameRequired
+	case utf8.RuneCountInString(m.Name) > maxNameLength:
+		return ErrMerchantNameTooLong
+	case m.City == "":
+		return ErrMerchantCityRequired
+	case utf8.RuneCountInString(m.City) > maxCityLength:
+		return ErrMerchantCityTooLong
+	case !nmidPattern.MatchString(m.NMID):
+		return ErrInvalidNMID
+	case len(m.MCC) != 4 || !digitsPattern.MatchString(m.MCC):
+		return ErrInvalidMCC
+	case m.PostalCode != "" && (len(m.PostalCode) != 5 || !digitsPattern.MatchString(m.PostalCode)):
+		return ErrInvalidPostalCode
+	}
+
+	if !slices.Contains(criteria, m.Criteria) {
+		return ErrInvalidCriteria
+	}
+	return nil
+}
+
+// Payload is a dynamic QRIS code for a single amount.
+type Payload struct {
+	Merchant  Merchant
+	Amount    money.Money
+	Reference string // reference label echoed back by the acquirer
+}
+
+// Encode returns the EMVCo payload string, ending with its CRC.
+func (p Payload) Encode() (string, error) {
+	m := p.Merchant
+	m.Normalize()
+	if err := m.Validate(); err != nil {
+		return "", err
+	}
+
+	amount, err := formatAmount(p.Amount)
+	if err != nil {
+		return "", err
+	}
+
+	if len(p.Reference) > maxNameLength {
+		return "", ErrReferenceTooLong
+	}
+
+	var b strings.Builder
+	writeTLV(&b, tagPayloadFormat, payloadFormat)
+	writeTLV(&b, tagInitiationMethod, dynamicQR)
+	writeTLV(&b, tagMerchantQRIS, tlv(subtagGUID, qrisGUID)+tlv(subtagNMID, m.NMID)+tlv(subtagCriteria, m.Criteria))
+	writeTLV(&b, tagMerchantCategory, m.MCC)
+	writeTLV(&b, tagCurrency, currencyIDR)
+	writeTLV(&b, tagAmount, amount)
+	writeTLV(&b, tagCountry, countryIndonesia)
+	writeTLV(&b, tagMerchantName, m.Name)
+	writeTLV(&b, tagMerchantCity, m.City)
+	if m.PostalCode != "" {
+		writeTLV(&b, tagPostalCode, m.PostalCode)
+	}
+	if p.Reference != "" {
+		writeTLV(&b, tagAdditionalData, tlv(subtagReferenceLabel, p.Reference))
+	}
+
+	// The checksum covers its own tag and length.
+	b.WriteString(tagCRC + "04")
+	b.WriteString(Checksum(b.String()))
+
+	return b.String(), nil
+}
+
+// Checksum returns the CRC-16/CCITT-FALSE of s as four uppercase hex digits.
+func Checksum(s string) string {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
+
+// VerifyChecksum reports whether payload ends with a valid CRC.
+func VerifyChecksum(payload string) bool {
+	if len(payload) < 8 || payload[len(payload)-8:len(payload)-4] != tagCRC+"04" {
+		return false
+	}
+	return Checksum(payload[:len(payload)-4]) == payload[len(payload)-4:]
+}
+
+// formatAmount renders whole rupiah without decimals and anything else with two.
+func formatAmount(amount money.Money) (string, error) {
+	if !amount.IsPositive() {
+		return "", ErrInvalidAmount
+	}
+
+	s := amount.String()
+	if len(s) > maxAmountLength {
+		return "", fmt.Errorf("%w: %s is too large", ErrInvalidAmount, s)
+	}
+	return s, nil
+}
+
+func tlv(tag, value string) string {
+	return fmt.Sprintf("%s%02d%s", tag, utf8.RuneCountInString(value), value)
+}
+
+func writeTLV(b *strings.Builder, tag, value string) {
+	b.WriteString(tlv(tag, value))
+}
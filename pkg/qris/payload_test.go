@@ -0,0 +1,232 @@
+package qris
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+func TestChecksum(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		// CRC-16/CCITT-FALSE check value
+		{in: "123456789", want: "29B1"},
+		{in: "", want: "FFFF"},
+		{in: "A", want: "B915"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Checksum(tt.in); got != tt.want {
+				t.Errorf("Checksum(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTLV(t *testing.T) {
+	tests := []struct {
+		tag, value string
+		want       string
+	}{
+		{tag: "00", value: "01", want: "000201"},
+		{tag: "59", value: "Laundry Bersih", want: "5914Laundry Bersih"},
+		// Lengths count characters, not bytes
+		{tag: "59", value: "Café", want: "5904Café"},
+		{tag: "62", value: "", want: "6200"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tlv(tt.tag, tt.value); got != tt.want {
+				t.Errorf("tlv(%q, %q) = %q, want %q", tt.tag, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	merchant := Merchant{
+		Name:       "Laundry Bersih",
+		City:       "Jakarta",
+		PostalCode: "12190",
+		NMID:       "ID1020021181745",
+	}
+
+	tests := []struct {
+		name    string
+		payload Payload
+		want    string // everything before the CRC value
+		wantErr error
+	}{
+		{
+			name:    "whole rupiah with reference",
+			payload: Payload{Merchant: merchant, Amount: money.FromMajor(15000), Reference: "ABC123"},
+			want: "000201" + "010212" +
+				"5144" + "0014ID.CO.QRIS.WWW" + "0215ID1020021181745" + "0303UMI" +
+				"52047210" + "5303360" + "540515000" + "5802ID" +
+				"5914Laundry Bersih" + "6007Jakarta" + "610512190" +
+				"6210" + "0506ABC123" +
+				"6304",
+		},
+		{
+			name: "amount with cents, no postal code or reference",
+			payload: Payload{
+				Merchant: Merchant{Name: " Laundry Bersih ", City: "Jakarta", NMID: "id1020021181745", Criteria: "ube"},
+				Amount:   money.MustParse("15000.5"),
+			},
+			want: "000201" + "010212" +
+				"5144" + "0014ID.CO.QRIS.WWW" + "0215ID1020021181745" + "0303UBE" +
+				"52047210" + "5303360" + "540815000.50" + "5802ID" +
+				"5914Laundry Bersih" + "6007Jakarta" +
+				"6304",
+		},
+		{name: "zero amount", payload: Payload{Merchant: merchant}, wantErr: ErrInvalidAmount},
+		{name: "negative amount", payload: Payload{Merchant: merchant, Amount: -100}, wantErr: ErrInvalidAmount},
+		{
+			name:    "amount too long",
+			payload: Payload{Merchant: merchant, Amount: money.FromMajor(10_000_000_000_000)},
+			wantErr: ErrInvalidAmount,
+		},
+		{
+			name:    "reference too long",
+			payload: Payload{Merchant: merchant, Amount: 100, Reference: "12345678901234567890123456"},
+			wantErr: ErrReferenceTooLong,
+		},
+		{
+			name:    "invalid merchant",
+			payload: Payload{Merchant: Merchant{Name: "Laundry Bersih", City: "Jakarta"}, Amount: 100},
+			wantErr: ErrInvalidNMID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.payload.Encode()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Encode() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Encode() unexpected error: %v", err)
+			}
+
+			if want := tt.want + Checksum(tt.want); got != want {
+				t.Errorf("Encode() =\n%s\nwant\n%s", got, want)
+			}
+			if !VerifyChecksum(got) {
+				t.Errorf("VerifyChecksum(%q) = false", got)
+			}
+			assertWellFormedTLV(t, got)
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	valid, err := Payload{
+		Merchant: Merchant{Name: "Laundry Bersih", City: "Jakarta", NMID: "ID1020021181745"},
+		Amount:   money.FromMajor(15000),
+	}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrongCRC := valid[:len(valid)-4] + "0000"
+	if wrongCRC == valid {
+		wrongCRC = valid[:len(valid)-4] + "FFFF"
+	}
+
+	tests := []struct {
+		name    string
+		payload string
+		want    bool
+	}{
+		{name: "valid", payload: valid, want: true},
+		{name: "merchant name changed", payload: strings.Replace(valid, "Bersih", "Bersin", 1), want: false},
+		{name: "checksum changed", payload: wrongCRC, want: false},
+		{name: "no checksum tag", payload: valid[:len(valid)-8], want: false},
+		{name: "too short", payload: "6304", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyChecksum(tt.payload); got != tt.want {
+				t.Errorf("VerifyChecksum(%q) = %v, want %v", tt.payload, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerchantValidate(t *testing.T) {
+	valid := Merchant{Name: "Laundry Bersih", City: "Jakarta", NMID: "ID1020021181745", MCC: DefaultMCC, Criteria: DefaultCriteria}
+
+	tests := []struct {
+		name    string
+		modify  func(m *Merchant)
+		wantErr error
+	}{
+		{name: "valid", modify: func(m *Merchant) {}},
+		{name: "no name", modify: func(m *Merchant) { m.Name = "" }, wantErr: ErrMerchantNameRequired},
+		{name: "long name", modify: func(m *Merchant) { m.Name = "Laundry Bersih Wangi Cemerlang" }, wantErr: ErrMerchantNameTooLong},
+		{name: "no city", modify: func(m *Merchant) { m.City = "" }, wantErr: ErrMerchantCityRequired},
+		{name: "long city", modify: func(m *Merchant) { m.City = "Kota Jakarta Selatan" }, wantErr: ErrMerchantCityTooLong},
+		{name: "nmid without prefix", modify: func(m *Merchant) { m.NMID = "1020021181745" }, wantErr: ErrInvalidNMID},
+		{name: "short nmid", modify: func(m *Merchant) { m.NMID = "ID123" }, wantErr: ErrInvalidNMID},
+		{name: "mcc letters", modify: func(m *Merchant) { m.MCC = "72AB" }, wantErr: ErrInvalidMCC},
+		{name: "postal code letters", modify: func(m *Merchant) { m.PostalCode = "1219A" }, wantErr: ErrInvalidPostalCode},
+		{name: "unknown criteria", modify: func(m *Merchant) { m.Criteria = "BIG" }, wantErr: ErrInvalidCriteria},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := valid
+			tt.modify(&m)
+			if err := m.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// assertWellFormedTLV walks the top-level tags of a payload and checks each
+// length matches its value and the tags come in ascending order.
+func assertWellFormedTLV(t *testing.T, payload string) {
+	t.Helper()
+
+	runes := []rune(payload)
+	last := -1
+	for i := 0; i < len(runes); {
+		if i+4 > len(runes) {
+			t.Fatalf("truncated tag at %d in %q", i, payload)
+		}
+
+		tag, err := strconv.Atoi(string(runes[i : i+2]))
+		if err != nil {
+			t.Fatalf("bad tag %q at %d", string(runes[i:i+2]), i)
+		}
+		length, err := strconv.Atoi(string(runes[i+2 : i+4]))
+		if err != nil {
+			t.Fatalf("bad length %q at %d", string(runes[i+2:i+4]), i)
+		}
+		if i+4+length > len(runes) {
+			t.Fatalf("tag %02d runs past the end of %q", tag, payload)
+		}
+		if tag <= last {
+			t.Fatalf("tag %02d follows tag %02d", tag, last)
+		}
+
+		last = tag
+		i += 4 + length
+	}
+
+	if last != 63 {
+		t.Errorf("payload ends with tag %02d, want the CRC tag 63", last)
+	}
+}
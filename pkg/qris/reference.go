@@ -0,0 +1,56 @@
+package qris
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	referenceAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	referenceLength   = 22 // base62 digits needed for 128 bits
+)
+
+var ErrInvalidReference = errors.New("invalid qris reference")
+
+// EncodeReference turns an ID into a 22-character alphanumeric reference
+// label, short enough for the 25-character QRIS limit.
+func EncodeReference(id uuid.UUID) string {
+	n := new(big.Int).SetBytes(id[:])
+	base := big.NewInt(int64(len(referenceAlphabet)))
+	mod := new(big.Int)
+
+	out := make([]byte, referenceLength)
+	for i := referenceLength - 1; i >= 0; i-- {
+		n.DivMod(n, base, mod)
+		out[i] = referenceAlphabet[mod.Int64()]
+	}
+	return string(out)
+}
+
+// DecodeReference returns the ID a reference label was made from.
+func DecodeReference(ref string) (uuid.UUID, error) {
+	if len(ref) != referenceLength {
+		return uuid.Nil, ErrInvalidReference
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(int64(len(referenceAlphabet)))
+	for i := 0; i < len(ref); i++ {
+		d := strings.IndexByte(referenceAlphabet, ref[i])
+		if d < 0 {
+			return uuid.Nil, ErrInvalidReference
+		}
+		n.Mul(n, base).Add(n, big.NewInt(int64(d)))
+	}
+
+	if n.BitLen() > 128 {
+		return uuid.Nil, ErrInvalidReference
+	}
+
+	var id uuid.UUID
+	n.FillBytes(id[:])
+	return id, nil
+}
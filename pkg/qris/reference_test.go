@@ -0,0 +1,53 @@
+package qris
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestReferenceRoundTrip(t *testing.T) {
+	tests := []uuid.UUID{
+		uuid.Nil,
+		uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+		uuid.MustParse("0b0aa61c-4bca-4f43-9f2a-147f4cf64916"),
+	}
+
+	for _, id := range tests {
+		t.Run(id.String(), func(t *testing.T) {
+			ref := EncodeReference(id)
+			if len(ref) != referenceLength || len(ref) > maxNameLength {
+				t.Fatalf("EncodeReference(%s) = %q, want %d characters", id, ref, referenceLength)
+			}
+
+			got, err := DecodeReference(ref)
+			if err != nil {
+				t.Fatalf("DecodeReference(%q) unexpected error: %v", ref, err)
+			}
+			if got != id {
+				t.Errorf("DecodeReference(EncodeReference(%s)) = %s", id, got)
+			}
+		})
+	}
+}
+
+func TestDecodeReferenceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  string
+	}{
+		{name: "empty", ref: ""},
+		{name: "too short", ref: "0000000000000000000000"[:21]},
+		{name: "not alphanumeric", ref: "000000000000000000000-"},
+		{name: "more than 128 bits", ref: "zzzzzzzzzzzzzzzzzzzzzz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeReference(tt.ref); !errors.Is(err, ErrInvalidReference) {
+				t.Errorf("DecodeReference(%q) error = %v, want %v", tt.ref, err, ErrInvalidReference)
+			}
+		})
+	}
+}
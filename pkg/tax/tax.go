@@ -0,0 +1,78 @@
+// Package tax works out PPN (Indonesian VAT) and service charges on sales.
+package tax
+
+import (
+	"errors"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// MaxRate caps the rates that can be configured, in percent.
+const MaxRate = 100
+
+var (
+	ErrInvalidRate              = errors.New("tax rate must be between 0 and 100 percent")
+	ErrInvalidServiceChargeRate = errors.New("service charge rate must be between 0 and 100 percent")
+)
+
+// Settings are the tax rules a tenant sells under. The zero value charges
+// neither tax nor service charge.
+type Settings struct {
+	Rate              float64 // PPN in percent, e.g. 11
+	Inclusive         bool    // prices already include the service charge and PPN
+	ServiceChargeRate float64 // in percent, 0 for none
+}
+
+// Breakdown splits an amount into what the tenant earns and what is charged on top.
+type Breakdown struct {
+	Taxable       money.Money // DPP: the amount before service charge and PPN
+	ServiceCharge money.Money
+	Tax           money.Money // PPN over the taxable amount and the service charge
+	Total         money.Money // what the customer pays
+}
+
+// Validate checks the rates are within range.
+func (s Settings) Validate() error {
+	if s.Rate < 0 || s.Rate > MaxRate {
+		return ErrInvalidRate
+	}
+	if s.ServiceChargeRate < 0 || s.ServiceChargeRate > MaxRate {
+		return ErrInvalidServiceChargeRate
+	}
+	return nil
+}
+
+// IsZero reports whether nothing is charged on top of prices.
+func (s Settings) IsZero() bool {
+	return s.Rate == 0 && s.ServiceChargeRate == 0
+}
+
+// Apply breaks down an amount at the listed price. With exclusive pricing the
+// service charge and PPN are added on top, with inclusive pricing they are
+// taken out of it and the total stays the same.
+func (s Settings) Apply(amount money.Money) Breakdown {
+	if s.IsZero() {
+		return Breakdown{Taxable: amount, Total: amount}
+	}
+
+	if !s.Inclusive {
+		serviceCharge := amount.Percent(s.ServiceChargeRate)
+		tax := (amount + serviceCharge).Percent(s.Rate)
+		return Breakdown{
+			Taxable:       amount,
+			ServiceCharge: serviceCharge,
+			Tax:           tax,
+			Total:         amount + serviceCharge + tax,
+		}
+	}
+
+	// The PPN is the remainder, so the parts always add up to the price
+	taxable := amount.Div((1 + s.ServiceChargeRate/100) * (1 + s.Rate/100))
+	serviceCharge := taxable.Percent(s.ServiceChargeRate)
+	return Breakdown{
+		Taxable:       taxable,
+		ServiceCharge: serviceCharge,
+		Tax:           amount - taxable - serviceCharge,
+		Total:         amount,
+	}
+}
@@ -0,0 +1,102 @@
+package tax
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		amount   money.Money
+		want     Breakdown
+	}{
+		{
+			name:     "nothing charged",
+			settings: Settings{},
+			amount:   100000,
+			want:     Breakdown{Taxable: 100000, Total: 100000},
+		},
+		{
+			name:     "exclusive ppn",
+			settings: Settings{Rate: 11},
+			amount:   100000,
+			want:     Breakdown{Taxable: 100000, Tax: 11000, Total: 111000},
+		},
+		{
+			name:     "exclusive ppn over the service charge",
+			settings: Settings{Rate: 11, ServiceChargeRate: 5},
+			amount:   100000,
+			want:     Breakdown{Taxable: 100000, ServiceCharge: 5000, Tax: 11550, Total: 116550},
+		},
+		{
+			name:     "exclusive ppn rounds to the minor unit",
+			settings: Settings{Rate: 11},
+			amount:   12345,
+			want:     Breakdown{Taxable: 12345, Tax: 1358, Total: 13703},
+		},
+		{
+			name:     "inclusive ppn",
+			settings: Settings{Rate: 11, Inclusive: true},
+			amount:   111000,
+			want:     Breakdown{Taxable: 100000, Tax: 11000, Total: 111000},
+		},
+		{
+			name:     "inclusive ppn and service charge",
+			settings: Settings{Rate: 11, ServiceChargeRate: 5, Inclusive: true},
+			amount:   116550,
+			want:     Breakdown{Taxable: 100000, ServiceCharge: 5000, Tax: 11550, Total: 116550},
+		},
+		{
+			name:     "inclusive ppn takes the rounding remainder",
+			settings: Settings{Rate: 11, Inclusive: true},
+			amount:   10000,
+			want:     Breakdown{Taxable: 9009, Tax: 991, Total: 10000},
+		},
+		{
+			name:     "inclusive service charge only",
+			settings: Settings{ServiceChargeRate: 10, Inclusive: true},
+			amount:   110000,
+			want:     Breakdown{Taxable: 100000, ServiceCharge: 10000, Total: 110000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.settings.Apply(tt.amount)
+			if got != tt.want {
+				t.Errorf("Apply(%d) = %+v, want %+v", tt.amount, got, tt.want)
+			}
+			if got.Taxable+got.ServiceCharge+got.Tax != got.Total {
+				t.Errorf("Apply(%d) parts add up to %d, want %d", tt.amount, got.Taxable+got.ServiceCharge+got.Tax, got.Total)
+			}
+		})
+	}
+}
+
+func TestSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		wantErr  error
+	}{
+		{name: "zero", settings: Settings{}},
+		{name: "ppn and service charge", settings: Settings{Rate: 11, ServiceChargeRate: 5}},
+		{name: "full rate", settings: Settings{Rate: MaxRate}},
+		{name: "negative rate", settings: Settings{Rate: -1}, wantErr: ErrInvalidRate},
+		{name: "rate above maximum", settings: Settings{Rate: 101}, wantErr: ErrInvalidRate},
+		{name: "negative service charge", settings: Settings{ServiceChargeRate: -1}, wantErr: ErrInvalidServiceChargeRate},
+		{name: "service charge above maximum", settings: Settings{ServiceChargeRate: 150}, wantErr: ErrInvalidServiceChargeRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.settings.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package turnaround
+
+import (
+	"errors"
+	"time"
+	_ "time/tzdata" // business hours are in the tenant's zone, whatever the host has installed
+)
+
+var (
+	ErrInvalidTimezone      = errors.New("timezone must be an IANA zone such as Asia/Jakarta")
+	ErrInvalidBusinessHours = errors.New("business hours need a weekday from 0 (Sunday) to 6 and an open time before the close time, as HH:MM")
+	ErrDuplicateWeekday     = errors.New("each weekday can only have one set of business hours")
+	ErrInvalidHoliday       = errors.New("holidays must be dates formatted as YYYY-MM-DD")
+)
+
+// DefaultTimezone is used when a calendar does not name one.
+const DefaultTimezone = "Asia/Jakarta"
+
+const (
+	clockLayout = "15:04"
+	dateLayout  = "2006-01-02"
+
+	// maxSearchDays bounds the search for an open day, so a calendar that
+	// closes for good cannot loop forever.
+	maxSearchDays = 366
+)
+
+// Calendar is when a laundry is open. The zero value is open around the
+// clock, every day.
+type Calendar struct {
+	Timezone string          `json:"timezone,omitempty"`
+	Hours    []BusinessHours `json:"hours,omitempty"`    // weekdays without hours are closed; no hours at all means always open
+	Holidays []string        `json:"holidays,omitempty"` // closed dates, YYYY-MM-DD
+}
+
+// BusinessHours are the opening hours of a weekday.
+type BusinessHours struct {
+	Weekday time.Weekday `json:"weekday"` // 0 is Sunday
+	Open    string       `json:"open"`    // HH:MM
+	Close   string       `json:"close"`   // HH:MM
+}
+
+// Validate checks the zone, hours and holidays can be read.
+func (c Calendar) Validate() error {
+	if c.Timezone != "" {
+		if _, err := time.LoadLocation(c.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+	}
+
+	seen := make(map[time.Weekday]bool, len(c.Hours))
+	for _, h := range c.Hours {
+		if h.Weekday < time.Sunday || h.Weekday > time.Saturday {
+			return ErrInvalidBusinessHours
+		}
+		if seen[h.Weekday] {
+			return ErrDuplicateWeekday
+		}
+		seen[h.Weekday] = true
+
+		open, errOpen := time.Parse(clockLayout, h.Open)
+		closing, errClose := time.Parse(clockLayout, h.Close)
+		if errOpen != nil || errClose != nil || !open.Before(closing) {
+			return ErrInvalidBusinessHours
+		}
+	}
+
+	for _, d := range c.Holidays {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return ErrInvalidHoliday
+		}
+	}
+
+	return nil
+}
+
+// IsZero reports whether the calendar is open around the clock.
+func (c Calendar) IsZero() bool {
+	return c.Timezone == "" && len(c.Hours) == 0 && len(c.Holidays) == 0
+}
+
+// Location returns the calendar's time zone.
+func (c Calendar) Location() *time.Location {
+	name := c.Timezone
+	if name == "" {
+		name = DefaultTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// DueAt returns when an order taken at from is ready under the option. Work
+// starts at the next opening; the days move on by whole business days, keeping
+// the time of day within that day's hours, and the hours count open time only.
+func (c Calendar) DueAt(from time.Time, opt Option) time.Time {
+	t := c.nextOpen(from.In(c.Location()))
+
+	if opt.Days > 0 {
+		day := t
+		for n, i := 0, 0; n < opt.Days && i < maxSearchDays; i++ {
+			day = day.AddDate(0, 0, 1)
+			if _, _, open := c.openingHours(day); open {
+				n++
+			}
+		}
+		t = c.clamp(day)
+	}
+
+	return c.addHours(t, time.Duration(opt.Hours)*time.Hour)
+}
+
+// openingHours returns when the laundry opens and closes on the day of t.
+func (c Calendar) openingHours(t time.Time) (time.Time, time.Time, bool) {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+
+	for _, h := range c.Holidays {
+		if h == midnight.Format(dateLayout) {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+
+	if len(c.Hours) == 0 {
+		return midnight, midnight.AddDate(0, 0, 1), true
+	}
+
+	for _, h := range c.Hours {
+		if h.Weekday != t.Weekday() {
+			continue
+		}
+		open, errOpen := time.Parse(clockLayout, h.Open)
+		closing, errClose := time.Parse(clockLayout, h.Close)
+		if errOpen != nil || errClose != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		return midnight.Add(clockOffset(open)), midnight.Add(clockOffset(closing)), true
+	}
+
+	return time.Time{}, time.Time{}, false
+}
+
+// nextOpen returns t if the laundry is open then, otherwise its next opening.
+func (c Calendar) nextOpen(t time.Time) time.Time {
+	for i := 0; i < maxSearchDays; i++ {
+		open, closing, ok := c.openingHours(t)
+		if ok && t.Before(closing) {
+			if t.Before(open) {
+				return open
+			}
+			return t
+		}
+
+		y, m, d := t.Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+	}
+	return t
+}
+
+// clamp moves t into the opening hours of its day.
+func (c Calendar) clamp(t time.Time) time.Time {
+	open, closing, ok := c.openingHours(t)
+	switch {
+	case !ok:
+		return c.nextOpen(t)
+	case t.Before(open):
+		return open
+	case t.After(closing):
+		return closing
+	}
+	return t
+}
+
+// addHours adds d of open time to t.
+func (c Calendar) addHours(t time.Time, d time.Duration) time.Time {
+	for i := 0; d > 0 && i < maxSearchDays; i++ {
+		t = c.nextOpen(t)
+		_, closing, _ := c.openingHours(t)
+
+		if left := closing.Sub(t); d > left {
+			d -= left
+			t = closing
+			continue
+		}
+		return t.Add(d)
+	}
+	return t
+}
+
+// clockOffset returns how far into the day a parsed HH:MM is.
+func clockOffset(clock time.Time) time.Duration {
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+}
@@ -0,0 +1,159 @@
+package turnaround
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDueAt(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, jakarta)
+	}
+
+	// Open Monday to Saturday from 08:00 to 20:00, closed on Tuesday the 20th
+	var hours []BusinessHours
+	for wd := time.Monday; wd <= time.Saturday; wd++ {
+		hours = append(hours, BusinessHours{Weekday: wd, Open: "08:00", Close: "20:00"})
+	}
+	shop := Calendar{Timezone: "Asia/Jakarta", Hours: hours, Holidays: []string{"2026-10-20"}}
+
+	regular := Option{Speed: SpeedRegular, Days: 3}
+	sameDay := Option{Speed: SpeedSameDay, Hours: 6}
+
+	tests := []struct {
+		name     string
+		calendar Calendar
+		from     time.Time
+		opt      Option
+		want     time.Time
+	}{
+		{
+			name:     "always open counts calendar days",
+			calendar: Calendar{},
+			from:     at(19, 10, 0),
+			opt:      regular,
+			want:     at(22, 10, 0),
+		},
+		{
+			name:     "hours within the day",
+			calendar: shop,
+			from:     at(19, 10, 0),
+			opt:      sameDay,
+			want:     at(19, 16, 0),
+		},
+		{
+			name:     "hours carry over past closing and the holiday",
+			calendar: shop,
+			from:     at(19, 17, 0),
+			opt:      sameDay,
+			want:     at(21, 11, 0),
+		},
+		{
+			name:     "taken while closed starts at the next opening",
+			calendar: shop,
+			from:     at(18, 12, 0),
+			opt:      sameDay,
+			want:     at(19, 14, 0),
+		},
+		{
+			name:     "taken after closing starts at the next opening",
+			calendar: shop,
+			from:     at(19, 21, 0),
+			opt:      Option{Speed: SpeedExpress, Hours: 2},
+			want:     at(21, 10, 0),
+		},
+		{
+			name:     "days skip holidays",
+			calendar: shop,
+			from:     at(19, 10, 0),
+			opt:      regular,
+			want:     at(23, 10, 0),
+		},
+		{
+			name:     "days skip closed weekdays",
+			calendar: shop,
+			from:     at(24, 19, 0),
+			opt:      Option{Speed: SpeedExpress, Days: 1},
+			want:     at(26, 19, 0),
+		},
+		{
+			name:     "days then hours",
+			calendar: shop,
+			from:     at(23, 18, 0),
+			opt:      Option{Speed: SpeedExpress, Days: 1, Hours: 4},
+			want:     at(26, 10, 0),
+		},
+		{
+			name:     "time taken in another zone",
+			calendar: shop,
+			from:     at(19, 10, 0).UTC(),
+			opt:      sameDay,
+			want:     at(19, 16, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.calendar.DueAt(tt.from, tt.opt)
+			if !got.Equal(tt.want) {
+				t.Errorf("DueAt(%s, %+v) = %s, want %s", tt.from, tt.opt, got.In(jakarta), tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		wantErr  error
+	}{
+		{name: "zero", calendar: Calendar{}},
+		{
+			name: "valid",
+			calendar: Calendar{
+				Timezone: "Asia/Makassar",
+				Hours:    []BusinessHours{{Weekday: time.Monday, Open: "08:00", Close: "17:30"}},
+				Holidays: []string{"2026-12-25"},
+			},
+		},
+		{name: "unknown zone", calendar: Calendar{Timezone: "Mars/Olympus"}, wantErr: ErrInvalidTimezone},
+		{
+			name:     "weekday out of range",
+			calendar: Calendar{Hours: []BusinessHours{{Weekday: 7, Open: "08:00", Close: "17:00"}}},
+			wantErr:  ErrInvalidBusinessHours,
+		},
+		{
+			name:     "closing before opening",
+			calendar: Calendar{Hours: []BusinessHours{{Weekday: time.Monday, Open: "17:00", Close: "08:00"}}},
+			wantErr:  ErrInvalidBusinessHours,
+		},
+		{
+			name:     "unreadable time",
+			calendar: Calendar{Hours: []BusinessHours{{Weekday: time.Monday, Open: "8am", Close: "17:00"}}},
+			wantErr:  ErrInvalidBusinessHours,
+		},
+		{
+			name: "weekday twice",
+			calendar: Calendar{Hours: []BusinessHours{
+				{Weekday: time.Monday, Open: "08:00", Close: "12:00"},
+				{Weekday: time.Monday, Open: "13:00", Close: "17:00"},
+			}},
+			wantErr: ErrDuplicateWeekday,
+		},
+		{name: "unreadable holiday", calendar: Calendar{Holidays: []string{"25/12/2026"}}, wantErr: ErrInvalidHoliday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.calendar.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Package turnaround works out when an order is promised to be ready, from
+// the turnaround a customer picks and the business hours of the laundry.
+package turnaround
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+var (
+	ErrUnknownSpeed       = errors.New("turnaround speed must be regular, express or same_day")
+	ErrDuplicateSpeed     = errors.New("each turnaround speed can only be offered once")
+	ErrInvalidDuration    = errors.New("turnaround needs a positive number of days or hours")
+	ErrInvalidSurcharge   = errors.New("turnaround surcharge must be between 0 and 1000 percent")
+	ErrRegularSpeedNeeded = errors.New("turnaround options must include the regular speed")
+)
+
+// MaxSurchargePercent caps the surcharge of a turnaround option.
+const MaxSurchargePercent = 1000
+
+// Speed is how fast a customer wants an order back.
+type Speed string
+
+const (
+	SpeedRegular Speed = "regular"
+	SpeedExpress Speed = "express"
+	SpeedSameDay Speed = "same_day"
+)
+
+func (s Speed) Normalize() Speed {
+	return Speed(strings.ToLower(strings.TrimSpace(string(s))))
+}
+
+// IsValid reports whether the speed is one of the known speeds.
+func (s Speed) IsValid() bool {
+	switch s {
+	case SpeedRegular, SpeedExpress, SpeedSameDay:
+		return true
+	}
+	return false
+}
+
+// Option is a turnaround a service is offered with. Days count whole business
+// days and hours count business hours on top of them, e.g. 3 days for
+// regular or 6 hours for same-day.
+type Option struct {
+	Speed            Speed   `json:"speed"`
+	Days             int     `json:"days,omitempty"`
+	Hours            int     `json:"hours,omitempty"`
+	SurchargePercent float64 `json:"surcharge_percent,omitempty"` // on top of the service price, e.g. 50
+}
+
+// Surcharge returns what the option adds to a service price.
+func (o Option) Surcharge(amount money.Money) money.Money {
+	return amount.Percent(o.SurchargePercent)
+}
+
+// Options are the turnarounds a service is offered with.
+type Options []Option
+
+// Default is what services without their own options are offered with.
+var Default = Options{{Speed: SpeedRegular, Days: 3}}
+
+// Validate checks every option is complete and each speed is offered once,
+// regular included.
+func (o Options) Validate() error {
+	seen := make(map[Speed]bool, len(o))
+	for _, opt := range o {
+		if !opt.Speed.IsValid() {
+			return ErrUnknownSpeed
+		}
+		if seen[opt.Speed] {
+			return ErrDuplicateSpeed
+		}
+		seen[opt.Speed] = true
+
+		if opt.Days < 0 || opt.Hours < 0 || opt.Days+opt.Hours == 0 {
+			return ErrInvalidDuration
+		}
+		if opt.SurchargePercent < 0 || opt.SurchargePercent > MaxSurchargePercent {
+			return ErrInvalidSurcharge
+		}
+	}
+
+	if len(o) > 0 && !seen[SpeedRegular] {
+		return ErrRegularSpeedNeeded
+	}
+	return nil
+}
+
+// Normalize tidies the speeds.
+func (o Options) Normalize() {
+	for i := range o {
+		o[i].Speed = o[i].Speed.Normalize()
+	}
+}
+
+// OrDefault returns the options, or Default when there are none.
+func (o Options) OrDefault() Options {
+	if len(o) == 0 {
+		return Default
+	}
+	return o
+}
+
+// Find returns the option of the speed.
+func (o Options) Find(speed Speed) (Option, bool) {
+	for _, opt := range o {
+		if opt.Speed == speed {
+			return opt, true
+		}
+	}
+	return Option{}, false
+}
@@ -0,0 +1,98 @@
+package turnaround
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		wantErr error
+	}{
+		{name: "none", options: nil},
+		{name: "default", options: Default},
+		{
+			name: "all speeds",
+			options: Options{
+				{Speed: SpeedRegular, Days: 3},
+				{Speed: SpeedExpress, Days: 1, SurchargePercent: 50},
+				{Speed: SpeedSameDay, Hours: 6, SurchargePercent: 100},
+			},
+		},
+		{name: "unknown speed", options: Options{{Speed: "overnight", Days: 1}}, wantErr: ErrUnknownSpeed},
+		{
+			name:    "speed twice",
+			options: Options{{Speed: SpeedRegular, Days: 3}, {Speed: SpeedRegular, Days: 2}},
+			wantErr: ErrDuplicateSpeed,
+		},
+		{name: "no duration", options: Options{{Speed: SpeedRegular}}, wantErr: ErrInvalidDuration},
+		{name: "negative duration", options: Options{{Speed: SpeedRegular, Days: 2, Hours: -1}}, wantErr: ErrInvalidDuration},
+		{
+			name:    "surcharge above maximum",
+			options: Options{{Speed: SpeedRegular, Days: 3, SurchargePercent: 1001}},
+			wantErr: ErrInvalidSurcharge,
+		},
+		{name: "regular missing", options: Options{{Speed: SpeedExpress, Days: 1}}, wantErr: ErrRegularSpeedNeeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOptionsFind(t *testing.T) {
+	options := Options{
+		{Speed: SpeedRegular, Days: 3},
+		{Speed: SpeedExpress, Days: 1, SurchargePercent: 50},
+	}
+
+	tests := []struct {
+		name    string
+		options Options
+		speed   Speed
+		want    Option
+		wantOK  bool
+	}{
+		{name: "offered", options: options, speed: SpeedExpress, want: options[1], wantOK: true},
+		{name: "not offered", options: options, speed: SpeedSameDay},
+		{name: "default when none", options: Options(nil).OrDefault(), speed: SpeedRegular, want: Default[0], wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.options.Find(tt.speed)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Find(%s) = %+v, %v, want %+v, %v", tt.speed, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSurcharge(t *testing.T) {
+	tests := []struct {
+		name   string
+		opt    Option
+		amount money.Money
+		want   money.Money
+	}{
+		{name: "none", opt: Option{Speed: SpeedRegular, Days: 3}, amount: 2000000, want: 0},
+		{name: "half", opt: Option{Speed: SpeedExpress, Days: 1, SurchargePercent: 50}, amount: 2000000, want: 1000000},
+		{name: "rounded", opt: Option{Speed: SpeedExpress, Days: 1, SurchargePercent: 33}, amount: 1005, want: 332},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opt.Surcharge(tt.amount); got != tt.want {
+				t.Errorf("Surcharge(%d) = %d, want %d", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/umardev500/laundry/pkg/money"
+)
+
+// CashShiftStatus is the state of a cashier's drawer shift.
+type CashShiftStatus string
+
+const (
+	CashShiftStatusOpen   CashShiftStatus = "OPEN"   // drawer in use
+	CashShiftStatusClosed CashShiftStatus = "CLOSED" // drawer counted and reconciled
+)
+
+func (s CashShiftStatus) Normalize() CashShiftStatus {
+	return CashShiftStatus(strings.ToUpper(string(s)))
+}
+
+// CashDenomination is a count of one note or coin in the drawer.
+type CashDenomination struct {
+	Value money.Money `json:"value"`
+	Count int         `json:"count"`
+}
+
+// IDRDenominations are the rupiah notes and coins in circulation.
+var IDRDenominations = []money.Money{
+	money.FromMajor(100000), money.FromMajor(75000), money.FromMajor(50000), money.FromMajor(20000), // notes
+	money.FromMajor(10000), money.FromMajor(5000), money.FromMajor(2000), money.FromMajor(1000),
+	money.FromMajor(500), money.FromMajor(200), money.FromMajor(100), // coins
+}
+
+// IsValid reports whether the value is a rupiah note or coin and the count is not negative.
+func (d CashDenomination) IsValid() bool {
+	return d.Count >= 0 && slices.Contains(IDRDenominations, d.Value)
+}
+
+// Total returns the cash the count adds up to.
+func (d CashDenomination) Total() money.Money {
+	return d.Value.MulInt(int64(d.Count))
+}
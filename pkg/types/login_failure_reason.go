@@ -0,0 +1,10 @@
+package types
+
+// LoginFailureReason explains why a login attempt was rejected.
+type LoginFailureReason string
+
+const (
+	LoginFailureInvalidCredentials LoginFailureReason = "INVALID_CREDENTIALS" // Unknown email or wrong password
+	LoginFailureAccountLocked      LoginFailureReason = "ACCOUNT_LOCKED"      // Attempt made while the account was locked
+	LoginFailureInvalidMFACode     LoginFailureReason = "INVALID_MFA_CODE"    // Right password but a wrong second factor
+)
@@ -0,0 +1,28 @@
+package types
+
+import "strings"
+
+// ModifierType is how a service modifier is priced.
+type ModifierType string
+
+const (
+	ModifierTypeFixed   ModifierType = "fixed"   // a fixed price, once per item or per billed unit
+	ModifierTypePercent ModifierType = "percent" // a percentage of the item's service price
+)
+
+func (t ModifierType) Normalize() ModifierType {
+	return ModifierType(strings.ToLower(string(t)))
+}
+
+// ModifierScope is which order items a modifier can be chosen for.
+type ModifierScope string
+
+const (
+	ModifierScopeAll      ModifierScope = "all"      // items of every service
+	ModifierScopeService  ModifierScope = "service"  // items of one service
+	ModifierScopeCategory ModifierScope = "category" // items of services in one category
+)
+
+func (s ModifierScope) Normalize() ModifierScope {
+	return ModifierScope(strings.ToLower(string(s)))
+}
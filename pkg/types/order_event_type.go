@@ -0,0 +1,8 @@
+package types
+
+// OrderEventType represents the type of an OrderEvent
+type OrderEventType string
+
+const (
+	OrderEventSLABreached OrderEventType = "SLA_BREACHED" // Order was not ready by its due time
+)
@@ -0,0 +1,12 @@
+package types
+
+// OrderPaymentState summarizes how much of an order has been paid, based on
+// the sum of its settled payments.
+type OrderPaymentState string
+
+const (
+	OrderPaymentStateUnpaid        OrderPaymentState = "UNPAID"         // Nothing settled yet
+	OrderPaymentStatePartiallyPaid OrderPaymentState = "PARTIALLY_PAID" // Deposit or part of a split payment settled
+	OrderPaymentStatePaid          OrderPaymentState = "PAID"           // Settled payments match the total
+	OrderPaymentStateOverpaid      OrderPaymentState = "OVERPAID"       // Settled payments exceed the total
+)
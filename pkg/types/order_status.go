@@ -55,6 +55,22 @@ var AllowedOrderTransitions = map[OrderStatus][]OrderStatus{
 	OrderStatusRefunded: {},
 }
 
+// InProgressOrderStatuses are the statuses of orders still being worked on,
+// before the laundry is ready.
+var InProgressOrderStatuses = []OrderStatus{
+	OrderStatusPending,
+	OrderStatusConfirmed,
+	OrderStatusPickedUp,
+	OrderStatusInWashing,
+	OrderStatusInDrying,
+	OrderStatusInIroning,
+}
+
+// IsInProgress reports whether the order is still being worked on.
+func (s OrderStatus) IsInProgress() bool {
+	return slices.Contains(InProgressOrderStatuses, s)
+}
+
 func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
 	nextNormalize := next.Normalize()
 	allowedNext, ok := AllowedOrderTransitions[s]
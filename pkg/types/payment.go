@@ -20,6 +20,7 @@ const (
 	PaymentMethodCash     PaymentMethod = "cash"
 	PaymentMethodCard     PaymentMethod = "card"
 	PaymentMethodTransfer PaymentMethod = "transfer"
+	PaymentMethodWallet   PaymentMethod = "wallet" // paid from the customer's prepaid balance
 )
 
 type PaymentStatus string
@@ -78,6 +79,36 @@ func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
 	return slices.Contains(allowedNext, next.Normalize())
 }
 
+// PathTo returns the shortest sequence of transitions that leads to target,
+// excluding the current status, or nil when target cannot be reached.
+func (s PaymentStatus) PathTo(target PaymentStatus) []PaymentStatus {
+	target = target.Normalize()
+	prev := map[PaymentStatus]PaymentStatus{s: ""}
+	queue := []PaymentStatus{s}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == target && current != s {
+			var path []PaymentStatus
+			for step := current; step != s; step = prev[step] {
+				path = append([]PaymentStatus{step}, path...)
+			}
+			return path
+		}
+
+		for _, next := range AllowedPaymentTransitions[current] {
+			if _, seen := prev[next]; !seen {
+				prev[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s PaymentStatus) AllowedNextStatuses() []PaymentStatus {
 	return AllowedPaymentTransitions[s]
 }
@@ -123,3 +154,47 @@ func MapPaymentToOrderStatus(paymentStatus PaymentStatus, current OrderStatus) O
 		return current
 	}
 }
+
+// PaymentChannel is how an online payment is collected by the payment gateway.
+type PaymentChannel string
+
+const (
+	PaymentChannelBCAVA     PaymentChannel = "bca_va"
+	PaymentChannelBNIVA     PaymentChannel = "bni_va"
+	PaymentChannelBRIVA     PaymentChannel = "bri_va"
+	PaymentChannelMandiriVA PaymentChannel = "mandiri_va"
+	PaymentChannelPermataVA PaymentChannel = "permata_va"
+	PaymentChannelGoPay     PaymentChannel = "gopay"
+	PaymentChannelOVO       PaymentChannel = "ovo"
+	PaymentChannelDANA      PaymentChannel = "dana"
+	PaymentChannelShopeePay PaymentChannel = "shopeepay"
+	PaymentChannelCard      PaymentChannel = "card"
+)
+
+// PaymentChannels lists every supported channel.
+var PaymentChannels = []PaymentChannel{
+	PaymentChannelBCAVA,
+	PaymentChannelBNIVA,
+	PaymentChannelBRIVA,
+	PaymentChannelMandiriVA,
+	PaymentChannelPermataVA,
+	PaymentChannelGoPay,
+	PaymentChannelOVO,
+	PaymentChannelDANA,
+	PaymentChannelShopeePay,
+	PaymentChannelCard,
+}
+
+// IsValid reports whether the channel is supported.
+func (c PaymentChannel) IsValid() bool {
+	return slices.Contains(PaymentChannels, c)
+}
+
+// Method returns the payment method type the channel belongs to. Virtual
+// accounts and e-wallets are transfers; cards go through a hosted page.
+func (c PaymentChannel) Method() PaymentMethod {
+	if c == PaymentChannelCard {
+		return PaymentMethodCard
+	}
+	return PaymentMethodTransfer
+}
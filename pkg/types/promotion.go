@@ -0,0 +1,28 @@
+package types
+
+import "strings"
+
+// PromotionType is how a promotion takes money off.
+type PromotionType string
+
+const (
+	PromotionTypePercent PromotionType = "percent" // a percentage of what it applies to
+	PromotionTypeFixed   PromotionType = "fixed"   // a fixed amount, never more than what it applies to
+)
+
+func (t PromotionType) Normalize() PromotionType {
+	return PromotionType(strings.ToLower(string(t)))
+}
+
+// PromotionScope is what part of an order a promotion applies to.
+type PromotionScope string
+
+const (
+	PromotionScopeOrder    PromotionScope = "order"    // the whole order
+	PromotionScopeService  PromotionScope = "service"  // items of one service
+	PromotionScopeCategory PromotionScope = "category" // items of services in one category
+)
+
+func (s PromotionScope) Normalize() PromotionScope {
+	return PromotionScope(strings.ToLower(string(s)))
+}
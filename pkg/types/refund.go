@@ -0,0 +1,55 @@
+package types
+
+import (
+	"slices"
+	"strings"
+)
+
+// RefundStatus is the state of a refund request.
+type RefundStatus string
+
+const (
+	RefundStatusPending  RefundStatus = "PENDING"  // requested, waiting for approval
+	RefundStatusApproved RefundStatus = "APPROVED" // approved, money not returned yet
+	RefundStatusRejected RefundStatus = "REJECTED" // turned down by an approver
+	RefundStatusRefunded RefundStatus = "REFUNDED" // money returned to the customer
+)
+
+// AllowedRefundTransitions defines valid refund state changes.
+var AllowedRefundTransitions = map[RefundStatus][]RefundStatus{
+	RefundStatusPending:  {RefundStatusApproved, RefundStatusRejected},
+	RefundStatusApproved: {RefundStatusRefunded},
+	RefundStatusRejected: {}, // terminal
+	RefundStatusRefunded: {}, // terminal
+}
+
+func (s RefundStatus) CanTransitionTo(next RefundStatus) bool {
+	allowedNext, ok := AllowedRefundTransitions[s]
+	if !ok {
+		return false
+	}
+	return slices.Contains(allowedNext, next.Normalize())
+}
+
+func (s RefundStatus) AllowedNextStatuses() []RefundStatus {
+	return AllowedRefundTransitions[s]
+}
+
+func (e RefundStatus) Normalize() RefundStatus {
+	return RefundStatus(strings.ToUpper(string(e)))
+}
+
+// IsOpen reports whether the refund still holds part of the payment.
+func (s RefundStatus) IsOpen() bool {
+	return s == RefundStatusPending || s == RefundStatusApproved
+}
+
+// RefundMethod is how the money goes back to the customer.
+type RefundMethod string
+
+const (
+	RefundMethodOriginal RefundMethod = "original" // back through the payment gateway
+	RefundMethodCash     RefundMethod = "cash"     // handed over at the counter
+	RefundMethodTransfer RefundMethod = "transfer" // sent to the customer's bank account by staff
+	RefundMethodWallet   RefundMethod = "wallet"   // credited to the customer's prepaid wallet
+)
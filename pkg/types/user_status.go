@@ -9,15 +9,19 @@ import (
 type UserStatus string
 
 const (
+	UserStatusPending   UserStatus = "PENDING"
 	UserStatusActive    UserStatus = "ACTIVE"
 	UserStatusSuspended UserStatus = "SUSPENDED"
+	UserStatusLocked    UserStatus = "LOCKED" // temporarily locked after repeated failed logins
 	UserStatusDeleted   UserStatus = "DELETED"
 )
 
 // AllowedUserTransitions defines which User statuses can transition to which.
 var AllowedUserTransitions = map[UserStatus][]UserStatus{
-	UserStatusActive:    {UserStatusSuspended, UserStatusDeleted},
+	UserStatusPending:   {UserStatusActive, UserStatusDeleted},
+	UserStatusActive:    {UserStatusSuspended, UserStatusLocked, UserStatusDeleted},
 	UserStatusSuspended: {UserStatusActive, UserStatusDeleted},
+	UserStatusLocked:    {UserStatusActive, UserStatusSuspended, UserStatusDeleted},
 	UserStatusDeleted:   {}, // terminal state
 }
 
@@ -0,0 +1,11 @@
+package types
+
+// WalletTransactionType is the kind of entry in a wallet ledger.
+type WalletTransactionType string
+
+const (
+	WalletTransactionTopUp      WalletTransactionType = "top_up"     // customer paid in
+	WalletTransactionSpend      WalletTransactionType = "spend"      // paid for an order
+	WalletTransactionRefund     WalletTransactionType = "refund"     // refund credited back
+	WalletTransactionAdjustment WalletTransactionType = "adjustment" // manual correction by staff
+)
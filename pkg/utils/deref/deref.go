@@ -1,5 +1,7 @@
 package deref
 
+import "github.com/umardev500/laundry/pkg/money"
+
 // String returns the value of *s or an empty string if nil.
 func String(s *string) string {
 	if s != nil {
@@ -39,3 +41,11 @@ func Enum[T any](e *T, defaultValue T) T {
 	}
 	return defaultValue
 }
+
+// Money returns the value of *m or zero if nil.
+func Money(m *money.Money) money.Money {
+	if m != nil {
+		return *m
+	}
+	return 0
+}